@@ -1627,6 +1627,37 @@ provider "aws" {
 `, tag1, value1, tag2, value2))
 }
 
+// ConfigDefaultTags_Rule1 configures default tags with a rule which, for resourceType,
+// excludes tag1 and adds ruleTag1.
+func ConfigDefaultTags_Rule1(tag1, value1, resourceType, ruleTag1, ruleValue1 string) string {
+	//lintignore:AT004
+	return ConfigCompose(
+		testAccProviderConfigBase,
+		fmt.Sprintf(`
+provider "aws" {
+  default_tags {
+    tags = {
+      %[1]q = %[2]q
+    }
+
+    rule {
+      resource_types = [%[3]q]
+      exclude_keys   = [%[1]q]
+
+      tags = {
+        %[4]q = %[5]q
+      }
+    }
+  }
+
+  skip_credentials_validation = true
+  skip_get_ec2_platforms      = true
+  skip_metadata_api_check     = true
+  skip_requesting_account_id  = true
+}
+`, tag1, value1, resourceType, ruleTag1, ruleValue1))
+}
+
 func ConfigDefaultTagsEmptyConfigurationBlock() string {
 	//lintignore:AT004
 	return ConfigCompose(
@@ -0,0 +1,119 @@
+package acctest
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/internal/replay"
+)
+
+// FactoriesRecordFixtures creates ProviderFactories for the main provider which record
+// AWS API interactions as replay fixtures when the TF_ACC_RECORD_FIXTURES_DIR environment variable is set.
+//
+// The fixtures of all provider instances used by the test are written to a single file
+// named after the test when the test completes. They can be replayed without an AWS
+// account using the provider's replay_fixtures argument.
+// If the environment variable is not set, ProviderFactories is returned.
+func FactoriesRecordFixtures(t *testing.T) map[string]func() (*schema.Provider, error) {
+	dir := os.Getenv(conns.EnvVarAccRecordFixturesDir)
+
+	if dir == "" {
+		return ProviderFactories
+	}
+
+	recorder := replay.NewRecorder()
+	filename := filepath.Join(dir, FixturesFilename(t.Name()))
+
+	t.Cleanup(func() {
+		if recorder.Len() == 0 {
+			return
+		}
+
+		if err := recorder.Save(filename); err != nil {
+			t.Errorf("error saving replay fixtures: %s", err)
+		}
+	})
+
+	return map[string]func() (*schema.Provider, error){
+		ProviderName: func() (*schema.Provider, error) { //nolint:unparam
+			return RecordFixtures(provider.Provider(), recorder), nil
+		},
+	}
+}
+
+// RecordFixtures configures the provider to record its AWS API interactions.
+// Interactions made while configuring the provider are not recorded.
+func RecordFixtures(p *schema.Provider, recorder *replay.Recorder) *schema.Provider {
+	configure := p.ConfigureFunc
+
+	p.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		meta, err := configure(d)
+
+		if err != nil {
+			return meta, err
+		}
+
+		if client, ok := meta.(*conns.AWSClient); ok {
+			recorder.SetProvider(client.AccountID, client.Partition, client.Region)
+			client.HTTPClient.Transport = recorder.Transport(client.HTTPClient.Transport)
+		}
+
+		return meta, nil
+	}
+
+	return p
+}
+
+// FactoriesReplayFixtures creates ProviderFactories for the main provider which replay the
+// AWS API interactions recorded in the fixtures file, e.g. by FactoriesRecordFixtures, instead of calling AWS.
+// The provider's region defaults to the recorded region. The replay server is stopped when the test completes.
+func FactoriesReplayFixtures(t *testing.T, filename string) map[string]func() (*schema.Provider, error) {
+	cassette, err := replay.Load(filename)
+
+	if err != nil {
+		t.Fatalf("error loading replay fixtures: %s", err)
+	}
+
+	t.Cleanup(func() {
+		if err := conns.CloseReplayServer(filename); err != nil {
+			t.Errorf("error stopping replay server: %s", err)
+		}
+	})
+
+	return map[string]func() (*schema.Provider, error){
+		ProviderName: func() (*schema.Provider, error) { //nolint:unparam
+			return ReplayFixtures(provider.Provider(), filename, cassette.Region), nil
+		},
+	}
+}
+
+// ReplayFixtures configures the provider to replay the AWS API interactions recorded in the fixtures file
+// and to default to the given region.
+func ReplayFixtures(p *schema.Provider, filename string, region string) *schema.Provider {
+	// The recorded ARNs and endpoints are for the recorded region, whatever the environment's region.
+	p.Schema["region"].DefaultFunc = func() (interface{}, error) {
+		return region, nil
+	}
+
+	configure := p.ConfigureFunc
+
+	p.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		if err := d.Set("replay_fixtures", filename); err != nil {
+			return nil, err
+		}
+
+		return configure(d)
+	}
+
+	return p
+}
+
+// FixturesFilename returns the name of the replay fixtures file for a test.
+func FixturesFilename(testName string) string {
+	return strings.ReplaceAll(testName, "/", "_") + ".json"
+}
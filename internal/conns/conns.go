@@ -3,9 +3,13 @@ package conns
 import (
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/accessanalyzer"
@@ -177,6 +181,7 @@ import (
 	awsbase "github.com/hashicorp/aws-sdk-go-base"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/replay"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/version"
 )
@@ -190,24 +195,43 @@ type Config struct {
 	Region        string
 	MaxRetries    int
 
-	AssumeRoleARN               string
-	AssumeRoleDurationSeconds   int
-	AssumeRoleExternalID        string
-	AssumeRolePolicy            string
-	AssumeRolePolicyARNs        []string
-	AssumeRoleSessionName       string
-	AssumeRoleTags              map[string]string
-	AssumeRoleTransitiveTagKeys []string
+	AssumeRole                []*AssumeRole
+	AssumeRoleWithWebIdentity *AssumeRoleWithWebIdentity
 
 	AllowedAccountIds   []string
 	ForbiddenAccountIds []string
 
+	AllowedOrganizationalUnits   []string
+	ForbiddenOrganizationalUnits []string
+	ForbidManagementAccount      bool
+	AllowedAccountTags           map[string]string
+	OrganizationGuardrailRoleARN string
+
 	DefaultTagsConfig *tftags.DefaultConfig
 	Endpoints         map[string]string
 	IgnoreTagsConfig  *tftags.IgnoreConfig
 	Insecure          bool
 	HTTPProxy         string
 
+	CustomCABundle    string
+	ClientCertificate string
+	ClientPrivateKey  string
+
+	UseDualStackEndpoint bool
+	UseFIPSEndpoint      bool
+
+	RetryMode       string
+	MaxRequestRates map[string]float64
+
+	APITraceLogging bool
+
+	ConsistentReads     int
+	PropagationTimeouts map[string]time.Duration
+
+	ReplayFixtures string
+	// replayURL is the URL of the local server replaying the fixtures.
+	replayURL string
+
 	SkipCredsValidation     bool
 	SkipGetEC2Platforms     bool
 	SkipRegionValidation    bool
@@ -218,6 +242,19 @@ type Config struct {
 	TerraformVersion string
 }
 
+// ForResourceType returns the client to use for a resource type, whose DefaultTagsConfig
+// contains the default tags of the resource type after applying any default tags rules.
+func (client *AWSClient) ForResourceType(typeName string) *AWSClient {
+	if client.DefaultTagsConfig == nil || len(client.DefaultTagsConfig.Rules) == 0 {
+		return client
+	}
+
+	c := *client
+	c.DefaultTagsConfig = client.DefaultTagsConfig.ForResourceType(typeName)
+
+	return &c
+}
+
 type AWSClient struct {
 	AccessAnalyzerConn               *accessanalyzer.AccessAnalyzer
 	AccountID                        string
@@ -262,6 +299,7 @@ type AWSClient struct {
 	CognitoIDPConn                   *cognitoidentityprovider.CognitoIdentityProvider
 	ConfigConn                       *configservice.ConfigService
 	ConnectConn                      *connect.Connect
+	ConsistentReads                  int
 	CURConn                          *costandusagereportservice.CostandUsageReportService
 	DataExchangeConn                 *dataexchange.DataExchange
 	DataPipelineConn                 *datapipeline.DataPipeline
@@ -273,6 +311,7 @@ type AWSClient struct {
 	DLMConn                          *dlm.DLM
 	DMSConn                          *databasemigrationservice.DatabaseMigrationService
 	DNSSuffix                        string
+	HTTPClient                       *http.Client
 	DocDBConn                        *docdb.DocDB
 	DirectoryServiceConn             *directoryservice.DirectoryService
 	DirectConnectConn                *directconnect.DirectConnect
@@ -346,6 +385,7 @@ type AWSClient struct {
 	PrometheusConn                   *prometheusservice.PrometheusService
 	PinpointConn                     *pinpoint.Pinpoint
 	PricingConn                      *pricing.Pricing
+	PropagationTimeouts              map[string]time.Duration
 	QLDBConn                         *qldb.QLDB
 	QuickSightConn                   *quicksight.QuickSight
 	Route53Conn                      *route53.Route53
@@ -414,6 +454,18 @@ func (client *AWSClient) RegionalHostname(prefix string) string {
 
 // Client configures and returns a fully initialized AWSClient
 func (c *Config) Client() (interface{}, error) {
+	var replayCassette *replay.Cassette
+
+	if c.ReplayFixtures != "" {
+		var err error
+
+		c, replayCassette, err = c.replayConfig()
+
+		if err != nil {
+			return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+		}
+	}
+
 	// Get the auth and region. This can fail if keys/regions were not
 	// specified and we're attempting to use the environment.
 	if !c.SkipRegionValidation {
@@ -422,32 +474,74 @@ func (c *Config) Client() (interface{}, error) {
 		}
 	}
 
+	httpClient, err := c.httpClient()
+
+	if err != nil {
+		return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+	}
+
+	throttle, err := c.requestThrottle()
+
+	if err != nil {
+		return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+	}
+
+	if err := c.validateConsistency(); err != nil {
+		return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+	}
+
+	accessKey, secretKey, token := c.AccessKey, c.SecretKey, c.Token
+
+	var webIdentityCreds *credentials.Credentials
+
+	if c.AssumeRoleWithWebIdentity != nil {
+		creds, err := c.webIdentityCredentials(httpClient)
+
+		if err != nil {
+			return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+		}
+
+		// The credentials have already been validated, so this cannot fail.
+		// They are passed as static base credentials for session creation.
+		v, _ := creds.Get()
+		accessKey, secretKey, token = v.AccessKeyID, v.SecretAccessKey, v.SessionToken
+		webIdentityCreds = creds
+	}
+
+	// The STS and IAM endpoints are used during session creation, before the
+	// session's endpoint resolver can be customized.
+	iamEndpoint, stsEndpoint := c.Endpoints["iam"], c.Endpoints["sts"]
+
+	if c.UseFIPSEndpoint || c.UseDualStackEndpoint {
+		resolver := c.endpointResolver(endpoints.DefaultResolver())
+
+		if v, err := resolver.EndpointFor(iam.EndpointsID, c.Region); err == nil && iamEndpoint == "" {
+			iamEndpoint = v.URL
+		}
+
+		if v, err := resolver.EndpointFor(sts.EndpointsID, c.Region); err == nil && stsEndpoint == "" {
+			stsEndpoint = v.URL
+		}
+	}
+
 	awsbaseConfig := &awsbase.Config{
-		AccessKey:                   c.AccessKey,
-		AssumeRoleARN:               c.AssumeRoleARN,
-		AssumeRoleDurationSeconds:   c.AssumeRoleDurationSeconds,
-		AssumeRoleExternalID:        c.AssumeRoleExternalID,
-		AssumeRolePolicy:            c.AssumeRolePolicy,
-		AssumeRolePolicyARNs:        c.AssumeRolePolicyARNs,
-		AssumeRoleSessionName:       c.AssumeRoleSessionName,
-		AssumeRoleTags:              c.AssumeRoleTags,
-		AssumeRoleTransitiveTagKeys: c.AssumeRoleTransitiveTagKeys,
-		CallerDocumentationURL:      "https://registry.terraform.io/providers/hashicorp/aws",
-		CallerName:                  "Terraform AWS Provider",
-		CredsFilename:               c.CredsFilename,
-		DebugLogging:                logging.IsDebugOrHigher(),
-		IamEndpoint:                 c.Endpoints["iam"],
-		Insecure:                    c.Insecure,
-		HTTPProxy:                   c.HTTPProxy,
-		MaxRetries:                  c.MaxRetries,
-		Profile:                     c.Profile,
-		Region:                      c.Region,
-		SecretKey:                   c.SecretKey,
-		SkipCredsValidation:         c.SkipCredsValidation,
-		SkipMetadataApiCheck:        c.SkipMetadataApiCheck,
-		SkipRequestingAccountId:     c.SkipRequestingAccountId,
-		StsEndpoint:                 c.Endpoints["sts"],
-		Token:                       c.Token,
+		AccessKey:               accessKey,
+		CallerDocumentationURL:  "https://registry.terraform.io/providers/hashicorp/aws",
+		CallerName:              "Terraform AWS Provider",
+		CredsFilename:           c.CredsFilename,
+		DebugLogging:            logging.IsDebugOrHigher(),
+		IamEndpoint:             iamEndpoint,
+		Insecure:                c.Insecure,
+		HTTPProxy:               c.HTTPProxy,
+		MaxRetries:              c.MaxRetries,
+		Profile:                 c.Profile,
+		Region:                  c.Region,
+		SecretKey:               secretKey,
+		SkipCredsValidation:     c.SkipCredsValidation,
+		SkipMetadataApiCheck:    c.SkipMetadataApiCheck,
+		SkipRequestingAccountId: c.SkipRequestingAccountId,
+		StsEndpoint:             stsEndpoint,
+		Token:                   token,
 		UserAgentProducts: []*awsbase.UserAgentProduct{
 			{Name: "APN", Version: "1.0"},
 			{Name: "HashiCorp", Version: "1.0"},
@@ -456,11 +550,95 @@ func (c *Config) Client() (interface{}, error) {
 		},
 	}
 
+	// Requests made during session creation do not use the shared HTTP client.
+	// With custom TLS settings, credential validation and account ID lookup are
+	// instead performed once the session's HTTP client has been replaced.
+	customTLS := c.hasCustomTLS()
+
+	if customTLS {
+		awsbaseConfig.SkipCredsValidation = true
+		awsbaseConfig.SkipRequestingAccountId = true
+	}
+
+	// The first role is assumed during session creation, unless it must be
+	// assumed on top of the refreshing web identity credentials or using custom TLS settings.
+	assumeRoles := c.AssumeRole
+
+	if webIdentityCreds == nil && !customTLS && len(assumeRoles) > 0 {
+		assumeRole := assumeRoles[0]
+		assumeRoles = assumeRoles[1:]
+
+		awsbaseConfig.AssumeRoleARN = assumeRole.RoleARN
+		awsbaseConfig.AssumeRoleDurationSeconds = assumeRole.DurationSeconds
+		awsbaseConfig.AssumeRoleExternalID = assumeRole.ExternalID
+		awsbaseConfig.AssumeRolePolicy = assumeRole.Policy
+		awsbaseConfig.AssumeRolePolicyARNs = assumeRole.PolicyARNs
+		awsbaseConfig.AssumeRoleSessionName = assumeRole.SessionName
+		awsbaseConfig.AssumeRoleTags = assumeRole.Tags
+		awsbaseConfig.AssumeRoleTransitiveTagKeys = assumeRole.TransitiveTagKeys
+	}
+
 	sess, accountID, Partition, err := awsbase.GetSessionWithAccountIDAndPartition(awsbaseConfig)
 	if err != nil {
 		return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
 	}
 
+	sess = sess.Copy(&aws.Config{
+		EndpointResolver: c.endpointResolver(sess.Config.EndpointResolver),
+		HTTPClient:       httpClient,
+		Retryer:          throttle.retryer(c.MaxRetries),
+	})
+
+	// Retry and rate limiting handlers are shared by all service clients.
+	throttle.install(&sess.Handlers)
+
+	// Structured API call traces replace the AWS SDK for Go's request and response dumps,
+	// which include sensitive values.
+	if c.APITraceLogging {
+		sess.Config.LogLevel = aws.LogLevel(aws.LogOff)
+		sess.Handlers.Complete.PushBackNamed(traceHandler)
+	}
+
+	if webIdentityCreds != nil {
+		sess = sess.Copy(&aws.Config{Credentials: webIdentityCreds})
+
+		if accountID == "" {
+			if v, err := arn.Parse(c.AssumeRoleWithWebIdentity.RoleARN); err == nil {
+				accountID = v.AccountID
+			}
+		}
+	}
+
+	if len(assumeRoles) > 0 {
+		creds, err := assumeRoleCredentials(sess, assumeRoles)
+
+		if err != nil {
+			return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+		}
+
+		sess = sess.Copy(&aws.Config{Credentials: creds})
+	}
+
+	if customTLS {
+		accountID, Partition, err = c.accountIDAndPartition(sess, accountID, Partition)
+
+		if err != nil {
+			return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+		}
+	}
+
+	// The last role assumed determines the account and partition.
+	if n := len(c.AssumeRole); n > 0 {
+		if v, err := arn.Parse(c.AssumeRole[n-1].RoleARN); err == nil {
+			accountID, Partition = v.AccountID, v.Partition
+		}
+	}
+
+	// Replayed fixtures determine the account and partition.
+	if replayCassette != nil {
+		accountID, Partition = replayCassette.AccountID, replayCassette.Partition
+	}
+
 	if accountID == "" {
 		log.Printf("[WARN] AWS account ID not found for provider. See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for implications.")
 	}
@@ -518,6 +696,7 @@ func (c *Config) Client() (interface{}, error) {
 		CognitoIDPConn:                   cognitoidentityprovider.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cognitoidp"])})),
 		ConfigConn:                       configservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["configservice"])})),
 		ConnectConn:                      connect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["connect"])})),
+		ConsistentReads:                  c.ConsistentReads,
 		CURConn:                          costandusagereportservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cur"])})),
 		DataExchangeConn:                 dataexchange.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dataexchange"])})),
 		DataPipelineConn:                 datapipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["datapipeline"])})),
@@ -529,6 +708,7 @@ func (c *Config) Client() (interface{}, error) {
 		DLMConn:                          dlm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dlm"])})),
 		DMSConn:                          databasemigrationservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dms"])})),
 		DNSSuffix:                        DNSSuffix,
+		HTTPClient:                       httpClient,
 		DocDBConn:                        docdb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["docdb"])})),
 		DirectoryServiceConn:             directoryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ds"])})),
 		DirectConnectConn:                directconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["directconnect"])})),
@@ -600,6 +780,7 @@ func (c *Config) Client() (interface{}, error) {
 		PrometheusConn:                   prometheusservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["prometheusservice"])})),
 		PinpointConn:                     pinpoint.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["pinpoint"])})),
 		PricingConn:                      pricing.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["pricing"])})),
+		PropagationTimeouts:              c.PropagationTimeouts,
 		QLDBConn:                         qldb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["qldb"])})),
 		QuickSightConn:                   quicksight.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["quicksight"])})),
 		RAMConn:                          ram.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ram"])})),
@@ -609,7 +790,6 @@ func (c *Config) Client() (interface{}, error) {
 		ResourceGroupsConn:               resourcegroups.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["resourcegroups"])})),
 		ResourceGroupsTaggingConn:        resourcegroupstaggingapi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["resourcegroupstaggingapi"])})),
 		ReverseDNSPrefix:                 ReverseDNS(DNSSuffix),
-		Route53DomainsConn:               route53domains.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["route53domains"])})),
 		Route53RecoveryControlConfigConn: route53recoverycontrolconfig.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["route53recoverycontrolconfig"])})),
 		Route53RecoveryReadinessConn:     route53recoveryreadiness.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["route53recoveryreadiness"])})),
 		Route53ResolverConn:              route53resolver.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["route53resolver"])})),
@@ -654,6 +834,9 @@ func (c *Config) Client() (interface{}, error) {
 	route53Config := &aws.Config{
 		Endpoint: aws.String(c.Endpoints["route53"]),
 	}
+	route53DomainsConfig := &aws.Config{
+		Endpoint: aws.String(c.Endpoints["route53domains"]),
+	}
 	route53RecoveryControlConfigConfig := &aws.Config{
 		Endpoint: aws.String(c.Endpoints["route53recoverycontrolconfig"]),
 	}
@@ -680,6 +863,7 @@ func (c *Config) Client() (interface{}, error) {
 	case endpoints.AwsPartitionID:
 		globalAcceleratorConfig.Region = aws.String(endpoints.UsWest2RegionID)
 		route53Config.Region = aws.String(endpoints.UsEast1RegionID)
+		route53DomainsConfig.Region = aws.String(endpoints.UsEast1RegionID)
 		route53RecoveryControlConfigConfig.Region = aws.String(endpoints.UsWest2RegionID)
 		route53RecoveryReadinessConfig.Region = aws.String(endpoints.UsWest2RegionID)
 		shieldConfig.Region = aws.String(endpoints.UsEast1RegionID)
@@ -696,6 +880,7 @@ func (c *Config) Client() (interface{}, error) {
 
 	client.GlobalAcceleratorConn = globalaccelerator.New(sess.Copy(globalAcceleratorConfig))
 	client.Route53Conn = route53.New(sess.Copy(route53Config))
+	client.Route53DomainsConn = route53domains.New(sess.Copy(route53DomainsConfig))
 	client.Route53RecoveryControlConfigConn = route53recoverycontrolconfig.New(sess.Copy(route53RecoveryControlConfigConfig))
 	client.Route53RecoveryReadinessConn = route53recoveryreadiness.New(sess.Copy(route53RecoveryReadinessConfig))
 	client.ShieldConn = shield.New(sess.Copy(shieldConfig))
@@ -938,6 +1123,10 @@ func (c *Config) Client() (interface{}, error) {
 		}
 	}
 
+	if err := c.validateAccountOrganization(c.organizationGuardrailsConn(sess, client.OrganizationsConn), accountID); err != nil {
+		return nil, err
+	}
+
 	return client, nil
 }
 
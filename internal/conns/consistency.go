@@ -0,0 +1,70 @@
+package conns
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Services whose propagation timeouts can be configured.
+const (
+	PropagationIAM     = "iam"
+	PropagationRoute53 = "route53"
+	PropagationS3      = "s3"
+)
+
+func Propagation_Values() []string {
+	return []string{
+		PropagationIAM,
+		PropagationRoute53,
+		PropagationS3,
+	}
+}
+
+func (c *Config) validateConsistency() error {
+	if c.ConsistentReads < 0 {
+		return fmt.Errorf("invalid number of consistent reads (%d), must not be negative", c.ConsistentReads)
+	}
+
+	for service, timeout := range c.PropagationTimeouts {
+		valid := false
+
+		for _, v := range Propagation_Values() {
+			if service == v {
+				valid = true
+				break
+			}
+		}
+
+		if !valid {
+			return fmt.Errorf("unsupported propagation timeout service (%s), expected one of: %s", service, strings.Join(Propagation_Values(), ", "))
+		}
+
+		if timeout <= 0 {
+			return fmt.Errorf("invalid propagation timeout for %s (%s), must be greater than zero", service, timeout)
+		}
+	}
+
+	return nil
+}
+
+// ReadAfterWritePolicy returns the policy for reading a resource of the service right after it was written.
+// The timeout configured for the service in the provider's propagation_timeouts takes precedence over defaultTimeout.
+func (client *AWSClient) ReadAfterWritePolicy(service string, defaultTimeout time.Duration) tfresource.ConsistencyPolicy {
+	return tfresource.ConsistencyPolicy{
+		Timeout:          client.PropagationTimeout(service, defaultTimeout),
+		ConsecutiveReads: client.ConsistentReads,
+	}
+}
+
+// PropagationTimeout returns the timeout configured for the service in the provider's propagation_timeouts,
+// or defaultTimeout if there is none.
+func (client *AWSClient) PropagationTimeout(service string, defaultTimeout time.Duration) time.Duration {
+	if v, ok := client.PropagationTimeouts[service]; ok && v > 0 {
+		return v
+	}
+
+	return defaultTimeout
+}
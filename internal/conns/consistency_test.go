@@ -0,0 +1,66 @@
+package conns
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAWSClientReadAfterWritePolicy(t *testing.T) {
+	client := &AWSClient{
+		ConsistentReads:     3,
+		PropagationTimeouts: map[string]time.Duration{PropagationIAM: 5 * time.Minute},
+	}
+
+	policy := client.ReadAfterWritePolicy(PropagationIAM, 2*time.Minute)
+
+	if got, expected := policy.Timeout, 5*time.Minute; got != expected {
+		t.Errorf("got timeout %s, expected %s", got, expected)
+	}
+
+	if got, expected := policy.ConsecutiveReads, 3; got != expected {
+		t.Errorf("got %d consecutive reads, expected %d", got, expected)
+	}
+
+	if got, expected := client.ReadAfterWritePolicy(PropagationS3, time.Minute).Timeout, time.Minute; got != expected {
+		t.Errorf("got default timeout %s, expected %s", got, expected)
+	}
+}
+
+func TestConfigClientPropagationTimeoutsInvalid(t *testing.T) {
+	testCases := []struct {
+		Name   string
+		Config *Config
+	}{
+		{
+			Name: "unsupported service",
+			Config: &Config{
+				PropagationTimeouts: map[string]time.Duration{"ec2": time.Minute},
+				Region:              "us-east-1", //lintignore:AWSAT003
+			},
+		},
+		{
+			Name: "zero timeout",
+			Config: &Config{
+				PropagationTimeouts: map[string]time.Duration{PropagationIAM: 0},
+				Region:              "us-east-1", //lintignore:AWSAT003
+			},
+		},
+		{
+			Name: "negative consistent reads",
+			Config: &Config{
+				ConsistentReads: -1,
+				Region:          "us-east-1", //lintignore:AWSAT003
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			if _, err := testCase.Config.Client(); err == nil {
+				t.Fatalf("expected error, got none")
+			}
+		})
+	}
+}
@@ -0,0 +1,265 @@
+package conns
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+// AssumeRole holds the configuration for a single STS AssumeRole call.
+// Multiple roles can be assumed in sequence, each using the previous role's credentials.
+type AssumeRole struct {
+	DurationSeconds   int
+	ExternalID        string
+	Policy            string
+	PolicyARNs        []string
+	RoleARN           string
+	SessionName       string
+	Tags              map[string]string
+	TransitiveTagKeys []string
+}
+
+// AssumeRoleWithWebIdentity holds the configuration for obtaining provider
+// credentials via the STS AssumeRoleWithWebIdentity API, e.g. from an OIDC token.
+type AssumeRoleWithWebIdentity struct {
+	Duration             time.Duration
+	Policy               string
+	PolicyARNs           []string
+	RoleARN              string
+	SessionName          string
+	WebIdentityToken     string
+	WebIdentityTokenFile string
+}
+
+// webIdentityToken is a stscreds.TokenFetcher returning a static token value.
+type webIdentityToken string
+
+func (t webIdentityToken) FetchToken(credentials.Context) ([]byte, error) {
+	return []byte(t), nil
+}
+
+// webIdentityRoleProvider is a credentials.Provider that calls AssumeRoleWithWebIdentity.
+// It differs from stscreds.WebIdentityRoleProvider in supporting an inline session policy.
+type webIdentityRoleProvider struct {
+	credentials.Expiry
+
+	client       stsiface.STSAPI
+	config       *AssumeRoleWithWebIdentity
+	tokenFetcher stscreds.TokenFetcher
+}
+
+func (p *webIdentityRoleProvider) Retrieve() (credentials.Value, error) {
+	return p.RetrieveWithContext(aws.BackgroundContext())
+}
+
+func (p *webIdentityRoleProvider) RetrieveWithContext(ctx credentials.Context) (credentials.Value, error) {
+	token, err := p.tokenFetcher.FetchToken(ctx)
+
+	if err != nil {
+		return credentials.Value{}, fmt.Errorf("error fetching web identity token: %w", err)
+	}
+
+	sessionName := p.config.SessionName
+
+	if sessionName == "" {
+		sessionName = strconv.FormatInt(time.Now().UnixNano(), 10)
+	}
+
+	input := &sts.AssumeRoleWithWebIdentityInput{
+		RoleArn:          aws.String(p.config.RoleARN),
+		RoleSessionName:  aws.String(sessionName),
+		WebIdentityToken: aws.String(string(token)),
+	}
+
+	if p.config.Duration > 0 {
+		input.DurationSeconds = aws.Int64(int64(p.config.Duration / time.Second))
+	}
+
+	if p.config.Policy != "" {
+		input.Policy = aws.String(p.config.Policy)
+	}
+
+	for _, policyARN := range p.config.PolicyARNs {
+		input.PolicyArns = append(input.PolicyArns, &sts.PolicyDescriptorType{
+			Arn: aws.String(policyARN),
+		})
+	}
+
+	req, output := p.client.AssumeRoleWithWebIdentityRequest(input)
+	req.SetContext(ctx)
+
+	// InvalidIdentityToken can be returned transiently while the identity
+	// provider's signing keys propagate.
+	req.RetryErrorCodes = append(req.RetryErrorCodes, sts.ErrCodeInvalidIdentityTokenException)
+
+	if err := req.Send(); err != nil {
+		return credentials.Value{}, err
+	}
+
+	if output == nil || output.Credentials == nil {
+		return credentials.Value{}, fmt.Errorf("empty result")
+	}
+
+	p.SetExpiration(aws.TimeValue(output.Credentials.Expiration), 0)
+
+	return credentials.Value{
+		AccessKeyID:     aws.StringValue(output.Credentials.AccessKeyId),
+		SecretAccessKey: aws.StringValue(output.Credentials.SecretAccessKey),
+		SessionToken:    aws.StringValue(output.Credentials.SessionToken),
+		ProviderName:    "WebIdentityRoleProvider",
+	}, nil
+}
+
+// webIdentityCredentials returns validated credentials obtained by assuming
+// the configured role with a web identity token.
+// AssumeRoleWithWebIdentity requests are not signed, so no base credentials are required.
+func (c *Config) webIdentityCredentials(httpClient *http.Client) (*credentials.Credentials, error) {
+	webIdentity := c.AssumeRoleWithWebIdentity
+
+	var tokenFetcher stscreds.TokenFetcher
+
+	if v := webIdentity.WebIdentityToken; v != "" {
+		tokenFetcher = webIdentityToken(v)
+	} else if v := webIdentity.WebIdentityTokenFile; v != "" {
+		filename, err := homedir.Expand(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("error expanding web identity token filename: %w", err)
+		}
+
+		tokenFetcher = stscreds.FetchTokenPath(filename)
+	} else {
+		return nil, fmt.Errorf("one of web_identity_token or web_identity_token_file must be set")
+	}
+
+	log.Printf("[INFO] Attempting to AssumeRoleWithWebIdentity %s (SessionName: %q)", webIdentity.RoleARN, webIdentity.SessionName)
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials:      credentials.AnonymousCredentials,
+		Endpoint:         aws.String(c.Endpoints["sts"]),
+		EndpointResolver: c.endpointResolver(endpoints.DefaultResolver()),
+		HTTPClient:       httpClient,
+		MaxRetries:       aws.Int(c.MaxRetries),
+		Region:           aws.String(c.Region),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating web identity session: %w", err)
+	}
+
+	creds := credentials.NewCredentials(&webIdentityRoleProvider{
+		client:       sts.New(sess),
+		config:       webIdentity,
+		tokenFetcher: tokenFetcher,
+	})
+
+	if _, err := creds.Get(); err != nil {
+		return nil, fmt.Errorf("error assuming IAM Role (%s) with web identity: %w", webIdentity.RoleARN, err)
+	}
+
+	return creds, nil
+}
+
+// assumeRoleCredentials returns validated credentials obtained by assuming each
+// of the roles in order, starting from the session's credentials.
+func assumeRoleCredentials(sess *session.Session, assumeRoles []*AssumeRole) (*credentials.Credentials, error) {
+	creds := sess.Config.Credentials
+
+	for _, assumeRole := range assumeRoles {
+		log.Printf("[INFO] Attempting to AssumeRole %s (SessionName: %q, ExternalId: %q)", assumeRole.RoleARN, assumeRole.SessionName, assumeRole.ExternalID)
+
+		provider := &stscreds.AssumeRoleProvider{
+			Client:  sts.New(sess, &aws.Config{Credentials: creds}),
+			RoleARN: assumeRole.RoleARN,
+		}
+
+		if v := assumeRole.DurationSeconds; v > 0 {
+			provider.Duration = time.Duration(v) * time.Second
+		}
+
+		if v := assumeRole.ExternalID; v != "" {
+			provider.ExternalID = aws.String(v)
+		}
+
+		if v := assumeRole.Policy; v != "" {
+			provider.Policy = aws.String(v)
+		}
+
+		for _, policyARN := range assumeRole.PolicyARNs {
+			provider.PolicyArns = append(provider.PolicyArns, &sts.PolicyDescriptorType{
+				Arn: aws.String(policyARN),
+			})
+		}
+
+		if v := assumeRole.SessionName; v != "" {
+			provider.RoleSessionName = v
+		}
+
+		for k, v := range assumeRole.Tags {
+			provider.Tags = append(provider.Tags, &sts.Tag{
+				Key:   aws.String(k),
+				Value: aws.String(v),
+			})
+		}
+
+		if v := assumeRole.TransitiveTagKeys; len(v) > 0 {
+			provider.TransitiveTagKeys = aws.StringSlice(v)
+		}
+
+		creds = credentials.NewCredentials(provider)
+
+		if _, err := creds.Get(); err != nil {
+			return nil, fmt.Errorf("error assuming IAM Role (%s): %w", assumeRole.RoleARN, err)
+		}
+	}
+
+	return creds, nil
+}
+
+// accountIDAndPartition validates the session's credentials and returns the caller's
+// account ID and partition, unless credential validation and account ID lookup are skipped.
+func (c *Config) accountIDAndPartition(sess *session.Session, accountID, partition string) (string, string, error) {
+	if !c.SkipCredsValidation {
+		accountID, partition, err := awsbase.GetAccountIDAndPartitionFromSTSGetCallerIdentity(sts.New(sess))
+
+		if err != nil {
+			return "", "", fmt.Errorf("error validating provider credentials: %w", err)
+		}
+
+		return accountID, partition, nil
+	}
+
+	if !c.SkipRequestingAccountId {
+		credentialsProviderName := ""
+
+		if v, err := sess.Config.Credentials.Get(); err == nil {
+			credentialsProviderName = v.ProviderName
+		}
+
+		accountID, partition, err := awsbase.GetAccountIDAndPartition(iam.New(sess), sts.New(sess), credentialsProviderName)
+
+		if err != nil {
+			return "", "", fmt.Errorf(
+				"AWS account ID not previously found and failed retrieving via all available methods. "+
+					"See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for workaround and implications. "+
+					"Errors: %w", err)
+		}
+
+		return accountID, partition, nil
+	}
+
+	return accountID, partition, nil
+}
@@ -0,0 +1,391 @@
+package conns
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+const testAssumeRoleWithWebIdentityResponse = `<AssumeRoleWithWebIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleWithWebIdentityResult>
+    <Credentials>
+      <AccessKeyId>WebIdentityAccessKey</AccessKeyId>
+      <SecretAccessKey>WebIdentitySecretKey</SecretAccessKey>
+      <SessionToken>WebIdentitySessionToken</SessionToken>
+      <Expiration>%s</Expiration>
+    </Credentials>
+    <AssumedRoleUser>
+      <Arn>arn:aws:sts::555555555555:assumed-role/WebIdentityRole/test-session</Arn>
+      <AssumedRoleId>ARO123EXAMPLE123:test-session</AssumedRoleId>
+    </AssumedRoleUser>
+  </AssumeRoleWithWebIdentityResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</AssumeRoleWithWebIdentityResponse>`
+
+// testAssumeRoleResponse is formatted with the access key ID and expiration of the returned credentials.
+const testAssumeRoleResponse = `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleResult>
+    <Credentials>
+      <AccessKeyId>%[1]s</AccessKeyId>
+      <SecretAccessKey>%[1]sSecret</SecretAccessKey>
+      <SessionToken>%[1]sToken</SessionToken>
+      <Expiration>%[2]s</Expiration>
+    </Credentials>
+    <AssumedRoleUser>
+      <Arn>arn:aws:sts::555555555555:assumed-role/Role/test-session</Arn>
+      <AssumedRoleId>ARO123EXAMPLE123:test-session</AssumedRoleId>
+    </AssumedRoleUser>
+  </AssumeRoleResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</AssumeRoleResponse>`
+
+type testAssumeRoleRequest struct {
+	AccessKeyID string
+	ExternalID  string
+	RoleARN     string
+	Tags        string
+}
+
+var testSigV4CredentialRegexp = regexp.MustCompile(`Credential=([^/]+)/`)
+
+// testAssumeRoleSTSServer returns a local STS stand-in that answers AssumeRole by returning
+// credentials whose access key ID is the assumed role's name, recording each request received.
+func testAssumeRoleSTSServer(t *testing.T, got *[]testAssumeRoleRequest) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("error parsing request: %s", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if action := r.PostForm.Get("Action"); action != "AssumeRole" {
+			t.Errorf("unexpected action: %s", action)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		request := testAssumeRoleRequest{
+			ExternalID: r.PostForm.Get("ExternalId"),
+			RoleARN:    r.PostForm.Get("RoleArn"),
+			Tags:       r.PostForm.Get("Tags.member.1.Key") + "=" + r.PostForm.Get("Tags.member.1.Value"),
+		}
+
+		if m := testSigV4CredentialRegexp.FindStringSubmatch(r.Header.Get("Authorization")); m != nil {
+			request.AccessKeyID = m[1]
+		}
+
+		*got = append(*got, request)
+
+		roleName := request.RoleARN[strings.LastIndex(request.RoleARN, "/")+1:]
+
+		w.Header().Set("Content-Type", "text/xml")
+		fmt.Fprintf(w, testAssumeRoleResponse, roleName, time.Now().Add(1*time.Hour).UTC().Format(time.RFC3339))
+	}))
+}
+
+// testWebIdentitySTSServer returns a local STS stand-in that answers AssumeRoleWithWebIdentity
+// and records the form values of the last request received.
+func testWebIdentitySTSServer(t *testing.T, got map[string]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("error parsing request: %s", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if r.Header.Get("Authorization") != "" {
+			t.Errorf("expected unsigned AssumeRoleWithWebIdentity request")
+		}
+
+		for k := range r.PostForm {
+			got[k] = r.PostForm.Get(k)
+		}
+
+		w.Header().Set("Content-Type", "text/xml")
+		fmt.Fprintf(w, testAssumeRoleWithWebIdentityResponse, time.Now().Add(1*time.Hour).UTC().Format(time.RFC3339))
+	}))
+}
+
+func TestConfigWebIdentityCredentials(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+
+	if err := os.WriteFile(tokenFile, []byte("FileToken"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		Name                      string
+		AssumeRoleWithWebIdentity *AssumeRoleWithWebIdentity
+		ExpectedForm              map[string]string
+		ExpectedError             bool
+	}{
+		{
+			Name: "token",
+			AssumeRoleWithWebIdentity: &AssumeRoleWithWebIdentity{
+				RoleARN:          "arn:aws:iam::555555555555:role/WebIdentityRole",
+				SessionName:      "test-session",
+				WebIdentityToken: "LiteralToken",
+			},
+			ExpectedForm: map[string]string{
+				"Action":           "AssumeRoleWithWebIdentity",
+				"RoleArn":          "arn:aws:iam::555555555555:role/WebIdentityRole",
+				"RoleSessionName":  "test-session",
+				"WebIdentityToken": "LiteralToken",
+			},
+		},
+		{
+			Name: "token file",
+			AssumeRoleWithWebIdentity: &AssumeRoleWithWebIdentity{
+				RoleARN:              "arn:aws:iam::555555555555:role/WebIdentityRole",
+				SessionName:          "test-session",
+				WebIdentityTokenFile: tokenFile,
+			},
+			ExpectedForm: map[string]string{
+				"RoleArn":          "arn:aws:iam::555555555555:role/WebIdentityRole",
+				"WebIdentityToken": "FileToken",
+			},
+		},
+		{
+			Name: "duration and policies",
+			AssumeRoleWithWebIdentity: &AssumeRoleWithWebIdentity{
+				Duration:         2 * time.Hour,
+				Policy:           `{"Version":"2012-10-17"}`,
+				PolicyARNs:       []string{"arn:aws:iam::aws:policy/ReadOnlyAccess"},
+				RoleARN:          "arn:aws:iam::555555555555:role/WebIdentityRole",
+				WebIdentityToken: "LiteralToken",
+			},
+			ExpectedForm: map[string]string{
+				"DurationSeconds":         "7200",
+				"Policy":                  `{"Version":"2012-10-17"}`,
+				"PolicyArns.member.1.arn": "arn:aws:iam::aws:policy/ReadOnlyAccess",
+			},
+		},
+		{
+			Name: "missing token",
+			AssumeRoleWithWebIdentity: &AssumeRoleWithWebIdentity{
+				RoleARN: "arn:aws:iam::555555555555:role/WebIdentityRole",
+			},
+			ExpectedError: true,
+		},
+		{
+			Name: "missing token file",
+			AssumeRoleWithWebIdentity: &AssumeRoleWithWebIdentity{
+				RoleARN:              "arn:aws:iam::555555555555:role/WebIdentityRole",
+				WebIdentityTokenFile: filepath.Join(t.TempDir(), "missing"),
+			},
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := make(map[string]string)
+			server := testWebIdentitySTSServer(t, got)
+			defer server.Close()
+
+			config := &Config{
+				AssumeRoleWithWebIdentity: testCase.AssumeRoleWithWebIdentity,
+				Endpoints:                 map[string]string{"sts": server.URL},
+				Region:                    "us-east-1", //lintignore:AWSAT003
+			}
+
+			creds, err := config.webIdentityCredentials(http.DefaultClient)
+
+			if testCase.ExpectedError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			v, err := creds.Get()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if v.AccessKeyID != "WebIdentityAccessKey" || v.SecretAccessKey != "WebIdentitySecretKey" || v.SessionToken != "WebIdentitySessionToken" {
+				t.Errorf("unexpected credentials: %#v", v)
+			}
+
+			for k, expected := range testCase.ExpectedForm {
+				if got[k] != expected {
+					t.Errorf("request parameter %s: got %q, expected %q", k, got[k], expected)
+				}
+			}
+		})
+	}
+}
+
+func TestConfigClientWebIdentity(t *testing.T) {
+	got := make(map[string]string)
+	server := testWebIdentitySTSServer(t, got)
+	defer server.Close()
+
+	config := &Config{
+		AssumeRoleWithWebIdentity: &AssumeRoleWithWebIdentity{
+			RoleARN:          "arn:aws:iam::555555555555:role/WebIdentityRole",
+			WebIdentityToken: "LiteralToken",
+		},
+		Endpoints:               map[string]string{"sts": server.URL},
+		MaxRetries:              1,
+		Region:                  "us-east-1", //lintignore:AWSAT003
+		SkipCredsValidation:     true,
+		SkipGetEC2Platforms:     true,
+		SkipMetadataApiCheck:    true,
+		SkipRequestingAccountId: true,
+	}
+
+	raw, err := config.Client()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	client := raw.(*AWSClient)
+
+	if got, expected := client.AccountID, "555555555555"; got != expected {
+		t.Errorf("account ID: got %q, expected %q", got, expected)
+	}
+
+	v, err := client.S3Conn.Config.Credentials.Get()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := v.ProviderName, "WebIdentityRoleProvider"; got != expected {
+		t.Errorf("credentials provider: got %q, expected %q", got, expected)
+	}
+}
+
+func TestAssumeRoleCredentials(t *testing.T) {
+	var got []testAssumeRoleRequest
+	server := testAssumeRoleSTSServer(t, &got)
+	defer server.Close()
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("BaseAccessKey", "BaseSecretKey", ""),
+		Endpoint:    aws.String(server.URL),
+		Region:      aws.String("us-east-1"), //lintignore:AWSAT003
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assumeRoles := []*AssumeRole{
+		{
+			RoleARN: "arn:aws:iam::111111111111:role/CIRole",
+		},
+		{
+			ExternalID: "hub",
+			RoleARN:    "arn:aws:iam::222222222222:role/OrgAdmin",
+			Tags:       map[string]string{"Project": "hub"},
+		},
+		{
+			ExternalID: "workload",
+			RoleARN:    "arn:aws:iam::333333333333:role/Target",
+		},
+	}
+
+	creds, err := assumeRoleCredentials(sess, assumeRoles)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	v, err := creds.Get()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := v.AccessKeyID, "Target"; got != expected {
+		t.Errorf("access key ID: got %q, expected %q", got, expected)
+	}
+
+	expected := []testAssumeRoleRequest{
+		{AccessKeyID: "BaseAccessKey", RoleARN: "arn:aws:iam::111111111111:role/CIRole", Tags: "="},
+		{AccessKeyID: "CIRole", ExternalID: "hub", RoleARN: "arn:aws:iam::222222222222:role/OrgAdmin", Tags: "Project=hub"},
+		{AccessKeyID: "OrgAdmin", ExternalID: "workload", RoleARN: "arn:aws:iam::333333333333:role/Target", Tags: "="},
+	}
+
+	if len(got) != len(expected) {
+		t.Fatalf("got %d AssumeRole requests, expected %d", len(got), len(expected))
+	}
+
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("AssumeRole request %d: got %#v, expected %#v", i, got[i], expected[i])
+		}
+	}
+}
+
+func TestConfigClientAssumeRoleChain(t *testing.T) {
+	var got []testAssumeRoleRequest
+	server := testAssumeRoleSTSServer(t, &got)
+	defer server.Close()
+
+	config := &Config{
+		AccessKey: "BaseAccessKey",
+		AssumeRole: []*AssumeRole{
+			{RoleARN: "arn:aws:iam::111111111111:role/CIRole"},
+			{RoleARN: "arn:aws:iam::222222222222:role/OrgAdmin"},
+			{RoleARN: "arn:aws:iam::333333333333:role/Target"},
+		},
+		Endpoints:               map[string]string{"sts": server.URL},
+		MaxRetries:              1,
+		Region:                  "us-east-1", //lintignore:AWSAT003
+		SecretKey:               "BaseSecretKey",
+		SkipCredsValidation:     true,
+		SkipGetEC2Platforms:     true,
+		SkipMetadataApiCheck:    true,
+		SkipRequestingAccountId: true,
+	}
+
+	raw, err := config.Client()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	client := raw.(*AWSClient)
+
+	if got, expected := client.AccountID, "333333333333"; got != expected {
+		t.Errorf("account ID: got %q, expected %q", got, expected)
+	}
+
+	v, err := client.EC2Conn.Config.Credentials.Get()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := v.AccessKeyID, "Target"; got != expected {
+		t.Errorf("access key ID: got %q, expected %q", got, expected)
+	}
+
+	if got, expected := len(got), 3; got != expected {
+		t.Errorf("got %d AssumeRole requests, expected %d", got, expected)
+	}
+}
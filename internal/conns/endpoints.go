@@ -0,0 +1,93 @@
+package conns
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/ec2metadata"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+)
+
+// endpointResolver wraps an endpoints.Resolver so that FIPS and/or dual-stack
+// service endpoints are selected when configured.
+// Endpoints overridden per service via aws.Config.Endpoint bypass the resolver entirely.
+// When replaying fixtures, all services resolve to the local replay server.
+func (c *Config) endpointResolver(resolver endpoints.Resolver) endpoints.Resolver {
+	if c.replayURL != "" {
+		return endpoints.ResolverFunc(func(service, region string, optFns ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+			resolved, err := resolver.EndpointFor(service, region, optFns...)
+
+			if err == nil && service != ec2metadata.ServiceName {
+				resolved.URL = c.replayURL
+			}
+
+			return resolved, err
+		})
+	}
+
+	if !c.UseFIPSEndpoint && !c.UseDualStackEndpoint {
+		return resolver
+	}
+
+	return endpoints.ResolverFunc(func(service, region string, optFns ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+		if service == ec2metadata.ServiceName {
+			return resolver.EndpointFor(service, region, optFns...)
+		}
+
+		if c.UseFIPSEndpoint {
+			return resolveFIPSEndpoint(resolver, service, region, c.UseDualStackEndpoint, optFns...)
+		}
+
+		return resolveDualStackEndpoint(resolver, service, region, optFns...)
+	})
+}
+
+// resolveDualStackEndpoint returns the dual-stack endpoint for the service in the region,
+// or the default endpoint if the AWS SDK models no dual-stack endpoint for the service.
+func resolveDualStackEndpoint(resolver endpoints.Resolver, service, region string, optFns ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+	if v, err := resolver.EndpointFor(service, region, append(optFns, endpoints.UseDualStackEndpointOption, endpoints.StrictMatchingOption)...); err == nil {
+		return v, nil
+	}
+
+	return resolver.EndpointFor(service, region, optFns...)
+}
+
+// resolveFIPSEndpoint returns the FIPS endpoint for the service in the region.
+// FIPS endpoints are modeled by the AWS SDK as endpoint variants; global services, e.g. IAM and Route 53,
+// model a single partition-wide FIPS endpoint.
+// If the service has no modeled FIPS endpoint an error is returned rather than guessing a hostname,
+// which may not exist or may not be FIPS validated.
+// A dual-stack endpoint is only used if it is also a FIPS endpoint.
+func resolveFIPSEndpoint(resolver endpoints.Resolver, service, region string, dualStack bool, optFns ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+	resolved, err := resolver.EndpointFor(service, region, optFns...)
+
+	if err != nil {
+		return resolved, err
+	}
+
+	fipsRegions := []string{region}
+
+	// The region resolves to the partition-wide endpoint of a global service.
+	globalRegion := fmt.Sprintf("%s-global", resolved.PartitionID)
+
+	if v, err := resolver.EndpointFor(service, globalRegion, append(optFns, endpoints.StrictMatchingOption)...); err == nil && v.URL == resolved.URL {
+		fipsRegions = append(fipsRegions, globalRegion)
+	}
+
+	for _, fipsRegion := range fipsRegions {
+		if dualStack {
+			if v, err := resolver.EndpointFor(service, fipsRegion, append(optFns, useFIPSEndpointOption, endpoints.UseDualStackEndpointOption, endpoints.StrictMatchingOption)...); err == nil {
+				return v, nil
+			}
+		}
+
+		if v, err := resolver.EndpointFor(service, fipsRegion, append(optFns, useFIPSEndpointOption, endpoints.StrictMatchingOption)...); err == nil {
+			return v, nil
+		}
+	}
+
+	return endpoints.ResolvedEndpoint{}, fmt.Errorf("no FIPS endpoint is known for service %s in region %s: configure one for the service in the provider's endpoints configuration block", service, region)
+}
+
+func useFIPSEndpointOption(o *endpoints.Options) {
+	o.UseFIPSEndpoint = endpoints.FIPSEndpointStateEnabled
+}
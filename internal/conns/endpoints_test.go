@@ -0,0 +1,214 @@
+package conns
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+)
+
+func TestConfigClientEndpoints(t *testing.T) {
+	testCases := []struct {
+		Name                 string
+		Region               string
+		Endpoints            map[string]string
+		UseDualStackEndpoint bool
+		UseFIPSEndpoint      bool
+		Expected             map[string]string
+	}{
+		{
+			Name:   "default",
+			Region: "us-west-2", //lintignore:AWSAT003
+			Expected: map[string]string{
+				"dynamodb": "https://dynamodb.us-west-2.amazonaws.com", //lintignore:AWSAT003
+				"ec2":      "https://ec2.us-west-2.amazonaws.com",      //lintignore:AWSAT003
+				"iam":      "https://iam.amazonaws.com",
+				"kms":      "https://kms.us-west-2.amazonaws.com", //lintignore:AWSAT003
+				"route53":  "https://route53.amazonaws.com",
+				"s3":       "https://s3.us-west-2.amazonaws.com", //lintignore:AWSAT003
+				"sts":      "https://sts.amazonaws.com",
+			},
+		},
+		{
+			Name:            "FIPS",
+			Region:          "us-west-2", //lintignore:AWSAT003
+			UseFIPSEndpoint: true,
+			Expected: map[string]string{
+				"dynamodb": "https://dynamodb-fips.us-west-2.amazonaws.com", //lintignore:AWSAT003
+				"ec2":      "https://ec2-fips.us-west-2.amazonaws.com",      //lintignore:AWSAT003
+				"iam":      "https://iam-fips.amazonaws.com",
+				"kms":      "https://kms-fips.us-west-2.amazonaws.com", //lintignore:AWSAT003
+				"route53":  "https://route53-fips.amazonaws.com",
+				"s3":       "https://s3-fips.us-west-2.amazonaws.com",  //lintignore:AWSAT003
+				"sts":      "https://sts-fips.us-west-2.amazonaws.com", //lintignore:AWSAT003
+			},
+		},
+		{
+			Name:            "FIPS GovCloud",
+			Region:          "us-gov-west-1", //lintignore:AWSAT003
+			UseFIPSEndpoint: true,
+			Expected: map[string]string{
+				"iam":     "https://iam.us-gov.amazonaws.com",
+				"kms":     "https://kms-fips.us-gov-west-1.amazonaws.com", //lintignore:AWSAT003
+				"route53": "https://route53.us-gov.amazonaws.com",
+				"s3":      "https://s3-fips.us-gov-west-1.amazonaws.com", //lintignore:AWSAT003
+				"sts":     "https://sts.us-gov-west-1.amazonaws.com",     //lintignore:AWSAT003
+			},
+		},
+		{
+			Name:                 "dual-stack",
+			Region:               "us-west-2", //lintignore:AWSAT003
+			UseDualStackEndpoint: true,
+			Expected: map[string]string{
+				"ec2": "https://api.ec2.us-west-2.aws", //lintignore:AWSAT003
+				"iam": "https://iam.amazonaws.com",
+				"s3":  "https://s3.dualstack.us-west-2.amazonaws.com", //lintignore:AWSAT003
+			},
+		},
+		{
+			Name:                 "FIPS and dual-stack",
+			Region:               "us-west-2", //lintignore:AWSAT003
+			UseDualStackEndpoint: true,
+			UseFIPSEndpoint:      true,
+			Expected: map[string]string{
+				"ec2": "https://ec2-fips.us-west-2.amazonaws.com", //lintignore:AWSAT003
+				"iam": "https://iam-fips.amazonaws.com",
+				"s3":  "https://s3-fips.dualstack.us-west-2.amazonaws.com", //lintignore:AWSAT003
+			},
+		},
+		{
+			Name:   "FIPS with endpoint overrides",
+			Region: "us-west-2", //lintignore:AWSAT003
+			Endpoints: map[string]string{
+				"ec2": "http://localhost:4566",
+				"s3":  "http://localhost:4567",
+			},
+			UseFIPSEndpoint: true,
+			Expected: map[string]string{
+				"ec2": "http://localhost:4566",
+				"kms": "https://kms-fips.us-west-2.amazonaws.com", //lintignore:AWSAT003
+				"s3":  "http://localhost:4567",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			endpoints := testCase.Endpoints
+
+			if endpoints == nil {
+				endpoints = make(map[string]string)
+			}
+
+			config := &Config{
+				AccessKey:               "StaticAccessKey",
+				Endpoints:               endpoints,
+				Region:                  testCase.Region,
+				SecretKey:               "StaticSecretKey",
+				SkipCredsValidation:     true,
+				SkipGetEC2Platforms:     true,
+				SkipMetadataApiCheck:    true,
+				SkipRequestingAccountId: true,
+				UseDualStackEndpoint:    testCase.UseDualStackEndpoint,
+				UseFIPSEndpoint:         testCase.UseFIPSEndpoint,
+			}
+
+			raw, err := config.Client()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			awsClient := raw.(*AWSClient)
+
+			clients := map[string]*client.Client{
+				"dynamodb": awsClient.DynamoDBConn.Client,
+				"ec2":      awsClient.EC2Conn.Client,
+				"iam":      awsClient.IAMConn.Client,
+				"kms":      awsClient.KMSConn.Client,
+				"route53":  awsClient.Route53Conn.Client,
+				"s3":       awsClient.S3Conn.Client,
+				"sts":      awsClient.STSConn.Client,
+			}
+
+			for service, expected := range testCase.Expected {
+				if got := clients[service].Endpoint; got != expected {
+					t.Errorf("%s endpoint: got %q, expected %q", service, got, expected)
+				}
+			}
+
+			if got, expected := awsClient.KMSConn.SigningRegion, testCase.Region; got != expected {
+				t.Errorf("kms signing region: got %q, expected %q", got, expected)
+			}
+		})
+	}
+}
+
+func TestResolveFIPSEndpoint(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Service       string
+		Region        string
+		DualStack     bool
+		Expected      string
+		ExpectedError string
+	}{
+		{
+			Name:     "regional",
+			Service:  "kms",
+			Region:   "us-west-2",                                //lintignore:AWSAT003
+			Expected: "https://kms-fips.us-west-2.amazonaws.com", //lintignore:AWSAT003
+		},
+		{
+			Name:     "global",
+			Service:  "iam",
+			Region:   "us-west-2", //lintignore:AWSAT003
+			Expected: "https://iam-fips.amazonaws.com",
+		},
+		{
+			Name:      "dual-stack",
+			Service:   "s3",
+			Region:    "us-west-2", //lintignore:AWSAT003
+			DualStack: true,
+			Expected:  "https://s3-fips.dualstack.us-west-2.amazonaws.com", //lintignore:AWSAT003
+		},
+		{
+			Name:      "dual-stack not modeled",
+			Service:   "kms",
+			Region:    "us-west-2", //lintignore:AWSAT003
+			DualStack: true,
+			Expected:  "https://kms-fips.us-west-2.amazonaws.com", //lintignore:AWSAT003
+		},
+		{
+			Name:          "not modeled",
+			Service:       "ec2",
+			Region:        "us-gov-west-1", //lintignore:AWSAT003
+			ExpectedError: "no FIPS endpoint is known for service ec2 in region us-gov-west-1",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := resolveFIPSEndpoint(endpoints.DefaultResolver(), testCase.Service, testCase.Region, testCase.DualStack)
+
+			if testCase.ExpectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.ExpectedError) {
+					t.Fatalf("got error %v, expected %q", err, testCase.ExpectedError)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got.URL != testCase.Expected {
+				t.Errorf("got %q, expected %q", got.URL, testCase.Expected)
+			}
+		})
+	}
+}
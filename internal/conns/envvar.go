@@ -54,6 +54,9 @@ const (
 	// For tests requiring restricted IAM permissions, an existing IAM Role to assume
 	// An inline assume role policy is then used to deny actions for the test
 	EnvVarAccAssumeRoleARN = "TF_ACC_ASSUME_ROLE_ARN"
+
+	// For tests recording AWS API interactions as replay fixtures, the directory to write the fixtures to
+	EnvVarAccRecordFixturesDir = "TF_ACC_RECORD_FIXTURES_DIR"
 )
 
 // Custom environment variables used for assuming a role with resource sweepers
@@ -0,0 +1,101 @@
+package conns
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/go-cleanhttp"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+// hasCustomTLS returns whether the configuration customizes TLS beyond the "insecure" setting.
+func (c *Config) hasCustomTLS() bool {
+	return c.CustomCABundle != "" || c.ClientCertificate != "" || c.ClientPrivateKey != ""
+}
+
+// httpClient returns the HTTP client shared by all AWS service sessions,
+// configured with the proxy and TLS settings.
+func (c *Config) httpClient() (*http.Client, error) {
+	client := cleanhttp.DefaultPooledClient()
+	transport := client.Transport.(*http.Transport)
+
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: c.Insecure,
+	}
+
+	if v := c.CustomCABundle; v != "" {
+		pem, err := readPEM(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("error reading custom CA bundle: %w", err)
+		}
+
+		certPool := x509.NewCertPool()
+
+		if !certPool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("error reading custom CA bundle: no valid PEM-encoded certificates found")
+		}
+
+		tlsConfig.RootCAs = certPool
+	}
+
+	if c.ClientCertificate != "" || c.ClientPrivateKey != "" {
+		if c.ClientCertificate == "" || c.ClientPrivateKey == "" {
+			return nil, fmt.Errorf("both a client certificate and client private key must be configured")
+		}
+
+		certPEM, err := readPEM(c.ClientCertificate)
+
+		if err != nil {
+			return nil, fmt.Errorf("error reading client certificate: %w", err)
+		}
+
+		keyPEM, err := readPEM(c.ClientPrivateKey)
+
+		if err != nil {
+			return nil, fmt.Errorf("error reading client private key: %w", err)
+		}
+
+		certificate, err := tls.X509KeyPair(certPEM, keyPEM)
+
+		if err != nil {
+			return nil, fmt.Errorf("error loading client certificate: %w", err)
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	if v := c.HTTPProxy; v != "" {
+		proxyURL, err := url.Parse(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("error parsing HTTP proxy URL: %w", err)
+		}
+
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	return client, nil
+}
+
+// readPEM returns PEM-encoded content, either given directly or read from a file path.
+func readPEM(v string) ([]byte, error) {
+	if strings.Contains(v, "-----BEGIN ") {
+		return []byte(v), nil
+	}
+
+	filename, err := homedir.Expand(v)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return os.ReadFile(filename)
+}
@@ -0,0 +1,242 @@
+package conns
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"log"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const testGetCallerIdentityResponse = `<GetCallerIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <GetCallerIdentityResult>
+    <Arn>arn:aws:iam::222222222222:user/Alice</Arn>
+    <UserId>AKIAI44QH8DHBEXAMPLE</UserId>
+    <Account>222222222222</Account>
+  </GetCallerIdentityResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</GetCallerIdentityResponse>`
+
+// testGetCallerIdentitySTSServer returns a local TLS STS stand-in that answers GetCallerIdentity.
+// If clientCAs is not nil, clients must present a certificate signed by one of the CAs.
+func testGetCallerIdentitySTSServer(t *testing.T, clientCAs *x509.CertPool) *httptest.Server {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("error parsing request: %s", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if action := r.PostForm.Get("Action"); action != "GetCallerIdentity" {
+			t.Errorf("unexpected action: %s", action)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/xml")
+		fmt.Fprint(w, testGetCallerIdentityResponse)
+	}))
+
+	if clientCAs != nil {
+		server.TLS = &tls.Config{
+			ClientAuth: tls.RequireAndVerifyClientCert,
+			ClientCAs:  clientCAs,
+		}
+	}
+
+	// Silence TLS handshake errors logged by the server.
+	server.Config.ErrorLog = log.New(io.Discard, "", 0)
+
+	server.StartTLS()
+
+	return server
+}
+
+// testClientCertificate returns a self-signed PEM-encoded client certificate and private key,
+// along with a pool containing the certificate.
+func testClientCertificate(t *testing.T) (string, string, *x509.CertPool) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "terraform-provider-aws"},
+		NotBefore:             time.Now().Add(-1 * time.Hour),
+		NotAfter:              time.Now().Add(1 * time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certificate, err := x509.ParseCertificate(der)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pool := x509.NewCertPool()
+	pool.AddCert(certificate)
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+
+	return string(certPEM), string(keyPEM), pool
+}
+
+func TestConfigClientCustomTLS(t *testing.T) {
+	clientCertificate, clientPrivateKey, clientCAs := testClientCertificate(t)
+
+	server := testGetCallerIdentitySTSServer(t, nil)
+	defer server.Close()
+
+	mtlsServer := testGetCallerIdentitySTSServer(t, clientCAs)
+	defer mtlsServer.Close()
+
+	// Both servers use the same httptest certificate.
+	caBundle := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+	caBundleFile := filepath.Join(t.TempDir(), "ca-bundle.pem")
+
+	if err := os.WriteFile(caBundleFile, []byte(caBundle), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	clientCertificateFile := filepath.Join(t.TempDir(), "client.pem")
+
+	if err := os.WriteFile(clientCertificateFile, []byte(clientCertificate), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		Name              string
+		Endpoint          string
+		CustomCABundle    string
+		ClientCertificate string
+		ClientPrivateKey  string
+		ExpectedError     bool
+	}{
+		{
+			Name:          "no CA bundle",
+			Endpoint:      server.URL,
+			ExpectedError: true,
+		},
+		{
+			Name:           "CA bundle content",
+			Endpoint:       server.URL,
+			CustomCABundle: caBundle,
+		},
+		{
+			Name:           "CA bundle file",
+			Endpoint:       server.URL,
+			CustomCABundle: caBundleFile,
+		},
+		{
+			Name:           "invalid CA bundle",
+			Endpoint:       server.URL,
+			CustomCABundle: "-----BEGIN CERTIFICATE-----\ninvalid\n-----END CERTIFICATE-----\n",
+			ExpectedError:  true,
+		},
+		{
+			Name:           "missing CA bundle file",
+			Endpoint:       server.URL,
+			CustomCABundle: filepath.Join(t.TempDir(), "missing.pem"),
+			ExpectedError:  true,
+		},
+		{
+			Name:           "mutual TLS without client certificate",
+			Endpoint:       mtlsServer.URL,
+			CustomCABundle: caBundle,
+			ExpectedError:  true,
+		},
+		{
+			Name:              "mutual TLS client certificate content",
+			Endpoint:          mtlsServer.URL,
+			CustomCABundle:    caBundle,
+			ClientCertificate: clientCertificate,
+			ClientPrivateKey:  clientPrivateKey,
+		},
+		{
+			Name:              "mutual TLS client certificate file",
+			Endpoint:          mtlsServer.URL,
+			CustomCABundle:    caBundle,
+			ClientCertificate: clientCertificateFile,
+			ClientPrivateKey:  clientPrivateKey,
+		},
+		{
+			Name:              "client certificate without private key",
+			Endpoint:          mtlsServer.URL,
+			CustomCABundle:    caBundle,
+			ClientCertificate: clientCertificate,
+			ExpectedError:     true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			config := &Config{
+				AccessKey:               "StaticAccessKey",
+				ClientCertificate:       testCase.ClientCertificate,
+				ClientPrivateKey:        testCase.ClientPrivateKey,
+				CustomCABundle:          testCase.CustomCABundle,
+				Endpoints:               map[string]string{"sts": testCase.Endpoint},
+				MaxRetries:              1,
+				Region:                  "us-east-1", //lintignore:AWSAT003
+				SecretKey:               "StaticSecretKey",
+				SkipGetEC2Platforms:     true,
+				SkipMetadataApiCheck:    true,
+				SkipRequestingAccountId: true,
+			}
+
+			raw, err := config.Client()
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got none")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			client := raw.(*AWSClient)
+
+			if got, expected := client.AccountID, "222222222222"; got != expected {
+				t.Errorf("account ID: got %q, expected %q", got, expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package conns
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+)
+
+// organizationGuardrailsConn returns the Organizations client used to validate the account against the guardrails.
+// If a guardrail role is configured, the client assumes it using the provider's credentials,
+// so that the organization can be read from its management account or a delegated administrator.
+func (c *Config) organizationGuardrailsConn(sess *session.Session, conn *organizations.Organizations) *organizations.Organizations {
+	if c.OrganizationGuardrailRoleARN == "" {
+		return conn
+	}
+
+	stsConn := sts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sts"])}))
+
+	return organizations.New(sess.Copy(&aws.Config{
+		Credentials: stscreds.NewCredentialsWithClient(stsConn, c.OrganizationGuardrailRoleARN),
+		Endpoint:    aws.String(c.Endpoints["organizations"]),
+	}))
+}
+
+// hasAccountOrganizationGuardrails returns whether any guardrails requiring the Organizations API are configured.
+func (c *Config) hasAccountOrganizationGuardrails() bool {
+	return len(c.AllowedOrganizationalUnits) > 0 || len(c.ForbiddenOrganizationalUnits) > 0 || c.ForbidManagementAccount || len(c.AllowedAccountTags) > 0
+}
+
+// validateAccountOrganization validates the account against the configured organizational unit, management account
+// and account tag guardrails. The organization, the account's organizational units and tags are read using the Organizations API,
+// which must be called with credentials of the organization's management account or a delegated administrator,
+// see organizationGuardrailsConn.
+func (c *Config) validateAccountOrganization(conn *organizations.Organizations, accountID string) error {
+	if !c.hasAccountOrganizationGuardrails() {
+		return nil
+	}
+
+	if accountID == "" {
+		return fmt.Errorf("AWS account ID is required to validate organizational units and account tags, but it was not found. Remove skip_requesting_account_id from the provider configuration")
+	}
+
+	if c.ForbidManagementAccount {
+		output, err := conn.DescribeOrganization(&organizations.DescribeOrganizationInput{})
+
+		if err != nil {
+			return c.organizationLookupError(accountID, "organization", err)
+		}
+
+		if output != nil && output.Organization != nil && aws.StringValue(output.Organization.MasterAccountId) == accountID {
+			return fmt.Errorf("AWS account ID not allowed: %s is the management account of organization %s", accountID, aws.StringValue(output.Organization.Id))
+		}
+	}
+
+	if len(c.AllowedOrganizationalUnits) > 0 || len(c.ForbiddenOrganizationalUnits) > 0 {
+		parents, err := accountParentIDs(conn, accountID)
+
+		if err != nil {
+			return c.organizationLookupError(accountID, "organizational units", err)
+		}
+
+		if len(c.AllowedOrganizationalUnits) > 0 && !containsAny(parents, c.AllowedOrganizationalUnits) {
+			return fmt.Errorf("AWS account ID not allowed: %s is not in any of the allowed organizational units (%s). Its parents are %s", accountID, strings.Join(c.AllowedOrganizationalUnits, ", "), strings.Join(parents, ", "))
+		}
+
+		for _, id := range c.ForbiddenOrganizationalUnits {
+			if containsAny(parents, []string{id}) {
+				return fmt.Errorf("AWS account ID not allowed: %s is in the forbidden organizational unit %s. Its parents are %s", accountID, id, strings.Join(parents, ", "))
+			}
+		}
+	}
+
+	if len(c.AllowedAccountTags) > 0 {
+		tags, err := accountTags(conn, accountID)
+
+		if err != nil {
+			return c.organizationLookupError(accountID, "tags", err)
+		}
+
+		keys := make([]string, 0, len(c.AllowedAccountTags))
+
+		for k := range c.AllowedAccountTags {
+			keys = append(keys, k)
+		}
+
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			expected := c.AllowedAccountTags[k]
+
+			if got, ok := tags[k]; !ok {
+				return fmt.Errorf("AWS account ID not allowed: %s is not tagged with %s (expected value %q)", accountID, k, expected)
+			} else if got != expected {
+				return fmt.Errorf("AWS account ID not allowed: %s is tagged with %s value %q (expected value %q)", accountID, k, got, expected)
+			}
+		}
+	}
+
+	return nil
+}
+
+// organizationLookupError returns the error for a failed guardrail lookup.
+// Access denied errors, from the Organizations API or from assuming the guardrail role,
+// are reported as such rather than as the raw AWS error.
+func (c *Config) organizationLookupError(accountID string, what string, err error) error {
+	if !tfawserr.ErrCodeEquals(err, organizations.ErrCodeAccessDeniedException) && !tfawserr.ErrCodeEquals(err, "AccessDenied") {
+		return fmt.Errorf("error reading AWS account (%s) %s: %w", accountID, what, err)
+	}
+
+	if c.OrganizationGuardrailRoleARN == "" {
+		return fmt.Errorf("not authorized to read AWS account (%s) %s from AWS Organizations. "+
+			"Organization guardrails can only be checked with credentials of the organization's management account or a delegated administrator. "+
+			"Set organization_guardrail_role_arn to a role in one of those accounts that the provider's credentials can assume", accountID, what)
+	}
+
+	return fmt.Errorf("not authorized to read AWS account (%s) %s from AWS Organizations using organization_guardrail_role_arn (%s). "+
+		"Check that the provider's credentials can assume the role and that it is in the organization's management account or a delegated administrator", accountID, what, c.OrganizationGuardrailRoleARN)
+}
+
+// accountParentIDs returns the IDs of the account's parent organizational units, from its
+// immediate parent to the organization root.
+func accountParentIDs(conn *organizations.Organizations, accountID string) ([]string, error) {
+	var ids []string
+
+	for childID := accountID; ; {
+		output, err := conn.ListParents(&organizations.ListParentsInput{
+			ChildId: aws.String(childID),
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		// Accounts and organizational units have exactly one parent.
+		if output == nil || len(output.Parents) == 0 {
+			return ids, nil
+		}
+
+		parent := output.Parents[0]
+		childID = aws.StringValue(parent.Id)
+		ids = append(ids, childID)
+
+		if aws.StringValue(parent.Type) == organizations.ParentTypeRoot {
+			return ids, nil
+		}
+	}
+}
+
+// accountTags returns the account's tags.
+func accountTags(conn *organizations.Organizations, accountID string) (map[string]string, error) {
+	tags := make(map[string]string)
+
+	err := conn.ListTagsForResourcePages(&organizations.ListTagsForResourceInput{
+		ResourceId: aws.String(accountID),
+	}, func(page *organizations.ListTagsForResourceOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, tag := range page.Tags {
+			tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+func containsAny(ids []string, candidates []string) bool {
+	for _, id := range ids {
+		for _, candidate := range candidates {
+			if id == candidate {
+				return true
+			}
+		}
+	}
+
+	return false
+}
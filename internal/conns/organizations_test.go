@@ -0,0 +1,328 @@
+package conns
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/organizations"
+)
+
+// testOrganizationsServer returns a server for the Organizations API with the organization
+// r-root > ou-parent > ou-child > 111111111111, where the account is tagged environment=sandbox
+// and 000000000000 is the management account.
+func testOrganizationsServer(t *testing.T) *httptest.Server {
+	parents := map[string][]string{
+		"111111111111": {"ou-child", "ORGANIZATIONAL_UNIT"},
+		"ou-child":     {"ou-parent", "ORGANIZATIONAL_UNIT"},
+		"ou-parent":    {"r-root", "ROOT"},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var input map[string]string
+
+		if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+			t.Errorf("error decoding request: %s", err)
+		}
+
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+
+		switch target := r.Header.Get("X-Amz-Target"); {
+		case strings.HasSuffix(target, ".ListParents"):
+			parent, ok := parents[input["ChildId"]]
+
+			if !ok {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"__type":"ChildNotFoundException","Message":"not found"}`)
+				return
+			}
+
+			fmt.Fprintf(w, `{"Parents":[{"Id":%q,"Type":%q}]}`, parent[0], parent[1])
+		case strings.HasSuffix(target, ".DescribeOrganization"):
+			fmt.Fprint(w, `{"Organization":{"Id":"o-exampleorgid","MasterAccountId":"000000000000"}}`)
+		case strings.HasSuffix(target, ".ListTagsForResource"):
+			fmt.Fprint(w, `{"Tags":[{"Key":"environment","Value":"sandbox"}]}`)
+		default:
+			t.Errorf("unexpected operation: %s", target)
+		}
+	}))
+
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestConfigValidateAccountOrganization(t *testing.T) {
+	server := testOrganizationsServer(t)
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("StaticAccessKey", "StaticSecretKey", ""),
+		Endpoint:    aws.String(server.URL),
+		MaxRetries:  aws.Int(0),
+		Region:      aws.String("us-east-1"), //lintignore:AWSAT003
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conn := organizations.New(sess)
+
+	testCases := []struct {
+		Name          string
+		Config        *Config
+		AccountID     string
+		ExpectedError string
+	}{
+		{
+			Name:      "no guardrails",
+			Config:    &Config{},
+			AccountID: "",
+		},
+		{
+			Name:          "no account ID",
+			Config:        &Config{AllowedOrganizationalUnits: []string{"ou-parent"}},
+			AccountID:     "",
+			ExpectedError: "AWS account ID is required",
+		},
+		{
+			Name:      "allowed immediate parent",
+			Config:    &Config{AllowedOrganizationalUnits: []string{"ou-child"}},
+			AccountID: "111111111111",
+		},
+		{
+			Name:      "allowed ancestor",
+			Config:    &Config{AllowedOrganizationalUnits: []string{"ou-other", "ou-parent"}},
+			AccountID: "111111111111",
+		},
+		{
+			Name:          "not allowed",
+			Config:        &Config{AllowedOrganizationalUnits: []string{"ou-other"}},
+			AccountID:     "111111111111",
+			ExpectedError: "111111111111 is not in any of the allowed organizational units (ou-other). Its parents are ou-child, ou-parent, r-root",
+		},
+		{
+			Name:      "not forbidden",
+			Config:    &Config{ForbiddenOrganizationalUnits: []string{"ou-other"}},
+			AccountID: "111111111111",
+		},
+		{
+			Name:          "forbidden ancestor",
+			Config:        &Config{ForbiddenOrganizationalUnits: []string{"ou-parent"}},
+			AccountID:     "111111111111",
+			ExpectedError: "111111111111 is in the forbidden organizational unit ou-parent",
+		},
+		{
+			Name: "allowed and not forbidden",
+			Config: &Config{
+				AllowedOrganizationalUnits:   []string{"ou-parent"},
+				ForbiddenOrganizationalUnits: []string{"ou-other"},
+			},
+			AccountID: "111111111111",
+		},
+		{
+			Name: "allowed and forbidden",
+			Config: &Config{
+				AllowedOrganizationalUnits:   []string{"ou-parent"},
+				ForbiddenOrganizationalUnits: []string{"ou-child"},
+			},
+			AccountID:     "111111111111",
+			ExpectedError: "111111111111 is in the forbidden organizational unit ou-child",
+		},
+		{
+			Name:      "not management account",
+			Config:    &Config{ForbidManagementAccount: true},
+			AccountID: "111111111111",
+		},
+		{
+			Name:          "management account",
+			Config:        &Config{ForbidManagementAccount: true},
+			AccountID:     "000000000000",
+			ExpectedError: "000000000000 is the management account of organization o-exampleorgid",
+		},
+		{
+			Name:          "account not found",
+			Config:        &Config{ForbiddenOrganizationalUnits: []string{"ou-parent"}},
+			AccountID:     "222222222222",
+			ExpectedError: "error reading AWS account (222222222222) organizational units: ChildNotFoundException",
+		},
+		{
+			Name:      "allowed tags",
+			Config:    &Config{AllowedAccountTags: map[string]string{"environment": "sandbox"}},
+			AccountID: "111111111111",
+		},
+		{
+			Name:          "tag value not allowed",
+			Config:        &Config{AllowedAccountTags: map[string]string{"environment": "production"}},
+			AccountID:     "111111111111",
+			ExpectedError: `111111111111 is tagged with environment value "sandbox" (expected value "production")`,
+		},
+		{
+			Name:          "tag missing",
+			Config:        &Config{AllowedAccountTags: map[string]string{"environment": "sandbox", "team": "platform"}},
+			AccountID:     "111111111111",
+			ExpectedError: `111111111111 is not tagged with team (expected value "platform")`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := testCase.Config.validateAccountOrganization(conn, testCase.AccountID)
+
+			if testCase.ExpectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error containing %q, got none", testCase.ExpectedError)
+			}
+
+			if !strings.Contains(err.Error(), testCase.ExpectedError) {
+				t.Fatalf("expected error containing %q, got %q", testCase.ExpectedError, err)
+			}
+		})
+	}
+}
+
+func TestConfigValidateAccountOrganizationAccessDenied(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"__type":"AccessDeniedException","Message":"You don't have permissions to access this resource."}`)
+	}))
+
+	t.Cleanup(server.Close)
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("StaticAccessKey", "StaticSecretKey", ""),
+		Endpoint:    aws.String(server.URL),
+		MaxRetries:  aws.Int(0),
+		Region:      aws.String("us-east-1"), //lintignore:AWSAT003
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conn := organizations.New(sess)
+
+	testCases := []struct {
+		Name          string
+		Config        *Config
+		ExpectedError string
+	}{
+		{
+			Name:          "provider credentials",
+			Config:        &Config{AllowedOrganizationalUnits: []string{"ou-parent"}},
+			ExpectedError: "not authorized to read AWS account (111111111111) organizational units from AWS Organizations. Organization guardrails can only be checked with credentials of the organization's management account or a delegated administrator. Set organization_guardrail_role_arn",
+		},
+		{
+			Name: "guardrail role",
+			Config: &Config{
+				AllowedAccountTags:           map[string]string{"environment": "sandbox"},
+				OrganizationGuardrailRoleARN: "arn:aws:iam::000000000000:role/guardrails", //lintignore:AWSAT005
+			},
+			ExpectedError: "not authorized to read AWS account (111111111111) tags from AWS Organizations using organization_guardrail_role_arn (arn:aws:iam::000000000000:role/guardrails)", //lintignore:AWSAT005
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := testCase.Config.validateAccountOrganization(conn, "111111111111")
+
+			if err == nil {
+				t.Fatalf("expected error containing %q, got none", testCase.ExpectedError)
+			}
+
+			if !strings.Contains(err.Error(), testCase.ExpectedError) {
+				t.Fatalf("expected error containing %q, got %q", testCase.ExpectedError, err)
+			}
+
+			if strings.Contains(err.Error(), "AccessDeniedException") {
+				t.Errorf("expected error without the raw AWS error, got %q", err)
+			}
+		})
+	}
+}
+
+func TestConfigOrganizationGuardrailsConn(t *testing.T) {
+	var organizationsAccessKeys []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Amz-Target") == "" {
+			// STS AssumeRole.
+			if err := r.ParseForm(); err != nil {
+				t.Errorf("error parsing request: %s", err)
+			}
+
+			if got, expected := r.Form.Get("RoleArn"), "arn:aws:iam::000000000000:role/guardrails"; got != expected { //lintignore:AWSAT005
+				t.Errorf("assumed role: got %q, expected %q", got, expected)
+			}
+
+			w.Header().Set("Content-Type", "text/xml")
+			fmt.Fprint(w, `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/"><AssumeRoleResult><Credentials><AccessKeyId>AssumedAccessKey</AccessKeyId><SecretAccessKey>AssumedSecretKey</SecretAccessKey><SessionToken>AssumedSessionToken</SessionToken><Expiration>2099-01-01T00:00:00Z</Expiration></Credentials></AssumeRoleResult></AssumeRoleResponse>`)
+			return
+		}
+
+		organizationsAccessKeys = append(organizationsAccessKeys, strings.SplitN(strings.SplitN(r.Header.Get("Authorization"), "Credential=", 2)[1], "/", 2)[0])
+
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		fmt.Fprint(w, `{"Parents":[{"Id":"r-root","Type":"ROOT"}]}`)
+	}))
+
+	t.Cleanup(server.Close)
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("StaticAccessKey", "StaticSecretKey", ""),
+		MaxRetries:  aws.Int(0),
+		Region:      aws.String("us-east-1"), //lintignore:AWSAT003
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := &Config{
+		AllowedOrganizationalUnits: []string{"r-root"},
+		Endpoints: map[string]string{
+			"organizations": server.URL,
+			"sts":           server.URL,
+		},
+		OrganizationGuardrailRoleARN: "arn:aws:iam::000000000000:role/guardrails", //lintignore:AWSAT005
+	}
+
+	conn := organizations.New(sess.Copy(&aws.Config{Endpoint: aws.String(server.URL)}))
+
+	if err := config.validateAccountOrganization(config.organizationGuardrailsConn(sess, conn), "111111111111"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := strings.Join(organizationsAccessKeys, ","), "AssumedAccessKey"; got != expected {
+		t.Errorf("Organizations API called with access keys %q, expected %q", got, expected)
+	}
+
+	config.OrganizationGuardrailRoleARN = ""
+	organizationsAccessKeys = nil
+
+	if err := config.validateAccountOrganization(config.organizationGuardrailsConn(sess, conn), "111111111111"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := strings.Join(organizationsAccessKeys, ","), "StaticAccessKey"; got != expected {
+		t.Errorf("Organizations API called with access keys %q, expected %q", got, expected)
+	}
+}
@@ -0,0 +1,135 @@
+package conns
+
+import (
+	"fmt"
+	"path/filepath"
+	"sync"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/replay"
+)
+
+// replayServers are the running replay servers, keyed by fixtures file.
+var replayServers = struct {
+	sync.Mutex
+	servers map[string]*replayServer
+}{
+	servers: make(map[string]*replayServer),
+}
+
+type replayServer struct {
+	cassette *replay.Cassette
+	server   *replay.Server
+	url      string
+}
+
+// replayConfig returns a copy of the configuration that sends all requests to a local
+// server replaying the recorded fixtures, along with the fixtures.
+// No requests are made to AWS, so credentials are not required or validated.
+// The server is shared by all provider instances replaying the same fixtures, so that
+// interactions are served in recorded order across Terraform commands, and runs until
+// CloseReplayServer is called or the process exits.
+func (c *Config) replayConfig() (*Config, *replay.Cassette, error) {
+	rs, err := startReplayServer(c.ReplayFixtures)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cassette, url := rs.cassette, rs.url
+
+	config := *c
+	config.AccessKey = "mock_access_key"
+	config.AssumeRole = nil
+	config.AssumeRoleWithWebIdentity = nil
+	config.ClientCertificate = ""
+	config.ClientPrivateKey = ""
+	config.CredsFilename = ""
+	config.CustomCABundle = ""
+	config.Endpoints = map[string]string{
+		"iam": url,
+		"sts": url,
+	}
+	config.Profile = ""
+	config.S3ForcePathStyle = true
+	config.SecretKey = "mock_secret_key"
+	config.SkipCredsValidation = true
+	config.SkipGetEC2Platforms = true
+	config.SkipMetadataApiCheck = true
+	config.SkipRequestingAccountId = true
+	config.Token = ""
+	config.UseDualStackEndpoint = false
+	config.UseFIPSEndpoint = false
+	config.replayURL = url
+
+	if config.Region == "" {
+		config.Region = cassette.Region
+	}
+
+	if config.Region == "" {
+		return nil, nil, fmt.Errorf("region not configured or recorded in replay fixtures (%s)", c.ReplayFixtures)
+	}
+
+	return &config, cassette, nil
+}
+
+// startReplayServer returns the running replay server for the fixtures file, starting one if necessary.
+func startReplayServer(filename string) (*replayServer, error) {
+	key, err := filepath.Abs(filename)
+
+	if err != nil {
+		return nil, fmt.Errorf("error resolving replay fixtures path (%s): %w", filename, err)
+	}
+
+	replayServers.Lock()
+	defer replayServers.Unlock()
+
+	if rs, ok := replayServers.servers[key]; ok {
+		return rs, nil
+	}
+
+	cassette, err := replay.Load(filename)
+
+	if err != nil {
+		return nil, err
+	}
+
+	server := replay.NewServer(cassette)
+	url, err := server.Start()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rs := &replayServer{
+		cassette: cassette,
+		server:   server,
+		url:      url,
+	}
+	replayServers.servers[key] = rs
+
+	return rs, nil
+}
+
+// CloseReplayServer stops the replay server for the fixtures file, if one is running.
+// A provider configured to replay the fixtures afterwards starts a new server,
+// which serves the interactions from the beginning.
+func CloseReplayServer(filename string) error {
+	key, err := filepath.Abs(filename)
+
+	if err != nil {
+		return fmt.Errorf("error resolving replay fixtures path (%s): %w", filename, err)
+	}
+
+	replayServers.Lock()
+	defer replayServers.Unlock()
+
+	rs, ok := replayServers.servers[key]
+
+	if !ok {
+		return nil
+	}
+
+	delete(replayServers.servers, key)
+
+	return rs.server.Close()
+}
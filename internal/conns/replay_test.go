@@ -0,0 +1,147 @@
+package conns
+
+import (
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-provider-aws/internal/replay"
+)
+
+func TestConfigClientReplayFixtures(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "fixtures.json")
+	cassette := &replay.Cassette{
+		AccountID: "222222222222",
+		Partition: "aws",
+		Region:    "us-west-2", //lintignore:AWSAT003
+		Interactions: []*replay.Interaction{
+			{
+				Request: replay.Request{
+					Service:   "sts",
+					Operation: "GetCallerIdentity",
+					Method:    http.MethodPost,
+					Path:      "/",
+				},
+				Response: replay.Response{
+					StatusCode: http.StatusOK,
+					Header:     map[string]string{"Content-Type": "text/xml"},
+					Body:       testGetCallerIdentityResponse,
+				},
+			},
+		},
+	}
+
+	if err := cassette.Save(filename); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := CloseReplayServer(filename); err != nil {
+			t.Error(err)
+		}
+	})
+
+	// No credentials are configured, and the configured endpoints are ignored.
+	config := &Config{
+		Endpoints:      map[string]string{"sts": "https://sts.example.com"},
+		MaxRetries:     1,
+		ReplayFixtures: filename,
+	}
+
+	raw, err := config.Client()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	client := raw.(*AWSClient)
+
+	if got, expected := client.AccountID, "222222222222"; got != expected {
+		t.Errorf("account ID: got %q, expected %q", got, expected)
+	}
+
+	if got, expected := client.Region, "us-west-2"; got != expected { //lintignore:AWSAT003
+		t.Errorf("region: got %q, expected %q", got, expected)
+	}
+
+	output, err := client.STSConn.GetCallerIdentity(&sts.GetCallerIdentityInput{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := aws.StringValue(output.Account), "222222222222"; got != expected {
+		t.Errorf("account ID: got %q, expected %q", got, expected)
+	}
+
+	_, err = client.IAMConn.GetUser(&iam.GetUserInput{})
+
+	if !tfawserr.ErrCodeEquals(err, replay.ErrCodeFixtureNotFound) {
+		t.Errorf("expected %s error, got %v", replay.ErrCodeFixtureNotFound, err)
+	}
+}
+
+func TestConfigClientReplayFixturesSharedServer(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "fixtures.json")
+	cassette := &replay.Cassette{
+		AccountID: "222222222222",
+		Partition: "aws",
+		Region:    "us-west-2", //lintignore:AWSAT003
+	}
+
+	if err := cassette.Save(filename); err != nil {
+		t.Fatal(err)
+	}
+
+	endpoint := func() string {
+		config := &Config{
+			MaxRetries:     1,
+			ReplayFixtures: filename,
+		}
+
+		raw, err := config.Client()
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		return raw.(*AWSClient).STSConn.Endpoint
+	}
+
+	first := endpoint()
+
+	if second := endpoint(); second != first {
+		t.Errorf("got replay server %q, expected shared server %q", second, first)
+	}
+
+	if err := CloseReplayServer(filename); err != nil {
+		t.Fatalf("error closing replay server: %s", err)
+	}
+
+	if _, err := http.Get(first); err == nil {
+		t.Errorf("replay server %q still running after close", first)
+	}
+
+	if third := endpoint(); third == first {
+		t.Errorf("got closed replay server %q, expected new server", third)
+	}
+
+	if err := CloseReplayServer(filename); err != nil {
+		t.Fatalf("error closing replay server: %s", err)
+	}
+}
+
+func TestConfigClientReplayFixturesMissing(t *testing.T) {
+	config := &Config{
+		Region:         "us-west-2", //lintignore:AWSAT003
+		ReplayFixtures: filepath.Join(t.TempDir(), "missing.json"),
+	}
+
+	if _, err := config.Client(); err == nil {
+		t.Fatalf("expected error, got none")
+	}
+}
@@ -0,0 +1,466 @@
+package conns
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const (
+	// RetryModeLegacy retries using the AWS SDK for Go default retryer.
+	RetryModeLegacy = "legacy"
+	// RetryModeStandard retries using exponential backoff with full jitter, limited by a retry quota.
+	RetryModeStandard = "standard"
+	// RetryModeAdaptive retries as RetryModeStandard, additionally limiting the request rate when throttled.
+	RetryModeAdaptive = "adaptive"
+)
+
+func RetryMode_Values() []string {
+	return []string{
+		RetryModeLegacy,
+		RetryModeStandard,
+		RetryModeAdaptive,
+	}
+}
+
+const (
+	standardRetryBaseDelay = 1 * time.Second
+	standardRetryMaxDelay  = 20 * time.Second
+
+	retryQuotaInitialCapacity  = 500
+	retryQuotaRetryCost        = 5
+	retryQuotaTimeoutCost      = 10
+	retryQuotaNoRetryIncrement = 1
+)
+
+// standardRetryer is a request.Retryer that backs off exponentially with full jitter.
+type standardRetryer struct {
+	client.DefaultRetryer
+}
+
+func (r standardRetryer) RetryRules(req *request.Request) time.Duration {
+	delay := standardRetryMaxDelay
+
+	if req.RetryCount < 5 {
+		delay = time.Duration(math.Min(float64(standardRetryBaseDelay)*math.Pow(2, float64(req.RetryCount)), float64(standardRetryMaxDelay)))
+	}
+
+	return time.Duration(rand.Int63n(int64(delay)))
+}
+
+// retryQuota limits the number of retries made by all service clients.
+// Retries consume capacity and successful requests return it, so that retries
+// stop when most requests are failing.
+type retryQuota struct {
+	mu sync.Mutex
+
+	available int
+	// costs holds the capacity consumed by the latest retry of in-flight requests.
+	costs map[*request.Request]int
+}
+
+func newRetryQuota() *retryQuota {
+	return &retryQuota{
+		available: retryQuotaInitialCapacity,
+		costs:     make(map[*request.Request]int),
+	}
+}
+
+// acquire consumes the capacity needed to retry the request, returning false if there is not enough.
+func (q *retryQuota) acquire(r *request.Request) bool {
+	cost := retryQuotaRetryCost
+
+	if isErrorTimeout(r.Error) {
+		cost = retryQuotaTimeoutCost
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if cost > q.available {
+		return false
+	}
+
+	q.available -= cost
+	q.costs[r] = cost
+
+	return true
+}
+
+// release returns capacity once the request has completed.
+func (q *retryQuota) release(r *request.Request) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	cost, ok := q.costs[r]
+	delete(q.costs, r)
+
+	if r.Error != nil {
+		return
+	}
+
+	if !ok {
+		cost = retryQuotaNoRetryIncrement
+	}
+
+	q.available = int(math.Min(float64(q.available+cost), retryQuotaInitialCapacity))
+}
+
+// tokenBucket is a token bucket rate limiter.
+// Tokens may be borrowed, with the borrower waiting until the bucket has refilled.
+type tokenBucket struct {
+	fillRate        float64
+	maxCapacity     float64
+	currentCapacity float64
+	lastTimestamp   time.Time
+}
+
+func (b *tokenBucket) refill(now time.Time) {
+	if !b.lastTimestamp.IsZero() {
+		b.currentCapacity = math.Min(b.maxCapacity, b.currentCapacity+now.Sub(b.lastTimestamp).Seconds()*b.fillRate)
+	}
+
+	b.lastTimestamp = now
+}
+
+// acquire takes a token, returning how long to wait before it can be used.
+func (b *tokenBucket) acquire(now time.Time) time.Duration {
+	b.refill(now)
+
+	var wait time.Duration
+
+	if b.currentCapacity < 1 {
+		wait = time.Duration((1 - b.currentCapacity) / b.fillRate * float64(time.Second))
+	}
+
+	b.currentCapacity--
+
+	return wait
+}
+
+// setRate changes the bucket's fill rate and capacity.
+func (b *tokenBucket) setRate(rate, maxCapacity float64, now time.Time) {
+	b.refill(now)
+	b.fillRate = rate
+	b.maxCapacity = maxCapacity
+	b.currentCapacity = math.Min(b.currentCapacity, b.maxCapacity)
+}
+
+// rateLimiter limits a service client's request rate.
+type rateLimiter interface {
+	acquire(now time.Time) time.Duration
+}
+
+// staticRateLimiter limits requests to a fixed rate, without bursting.
+type staticRateLimiter struct {
+	mu     sync.Mutex
+	bucket tokenBucket
+}
+
+func newStaticRateLimiter(rate float64) *staticRateLimiter {
+	return &staticRateLimiter{
+		bucket: tokenBucket{
+			fillRate:        rate,
+			maxCapacity:     1,
+			currentCapacity: 1,
+		},
+	}
+}
+
+func (l *staticRateLimiter) acquire(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.bucket.acquire(now)
+}
+
+const (
+	adaptiveMinFillRate   = 0.5
+	adaptiveMinCapacity   = 1
+	adaptiveSmooth        = 0.8
+	adaptiveBeta          = 0.7
+	adaptiveScaleConstant = 0.4
+)
+
+// adaptiveRateLimiter limits the request rate once requests are throttled,
+// using the CUBIC congestion control algorithm to find the highest sustainable rate.
+// Requests are not limited until the first throttling response is received.
+type adaptiveRateLimiter struct {
+	mu sync.Mutex
+
+	bucket  tokenBucket
+	enabled bool
+
+	measuredTxRate   float64
+	lastTxRateBucket float64
+	requestCount     int
+
+	lastMaxRate      float64
+	lastThrottleTime time.Time
+	timeWindow       float64
+}
+
+func newAdaptiveRateLimiter(now time.Time) *adaptiveRateLimiter {
+	return &adaptiveRateLimiter{
+		bucket: tokenBucket{
+			fillRate:    adaptiveMinFillRate,
+			maxCapacity: adaptiveMinCapacity,
+		},
+		lastTxRateBucket: math.Floor(seconds(now)),
+		lastThrottleTime: now,
+	}
+}
+
+func (l *adaptiveRateLimiter) acquire(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.enabled {
+		return 0
+	}
+
+	return l.bucket.acquire(now)
+}
+
+// update adjusts the request rate after a response is received.
+func (l *adaptiveRateLimiter) update(throttled bool, now time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.updateMeasuredRate(now)
+
+	var rate float64
+
+	if throttled {
+		rate = l.measuredTxRate
+
+		if l.enabled {
+			rate = math.Min(rate, l.bucket.fillRate)
+		}
+
+		l.lastMaxRate = rate
+		l.calculateTimeWindow()
+		l.lastThrottleTime = now
+		rate *= adaptiveBeta
+		l.enabled = true
+	} else {
+		l.calculateTimeWindow()
+		rate = adaptiveScaleConstant*math.Pow(now.Sub(l.lastThrottleTime).Seconds()-l.timeWindow, 3) + l.lastMaxRate
+	}
+
+	rate = math.Min(rate, 2*l.measuredTxRate)
+
+	l.bucket.setRate(math.Max(rate, adaptiveMinFillRate), math.Max(rate, adaptiveMinCapacity), now)
+}
+
+func (l *adaptiveRateLimiter) updateMeasuredRate(now time.Time) {
+	timeBucket := math.Floor(seconds(now)*2) / 2
+	l.requestCount++
+
+	if timeBucket > l.lastTxRateBucket {
+		currentRate := float64(l.requestCount) / (timeBucket - l.lastTxRateBucket)
+		l.measuredTxRate = currentRate*adaptiveSmooth + l.measuredTxRate*(1-adaptiveSmooth)
+		l.requestCount = 0
+		l.lastTxRateBucket = timeBucket
+	}
+}
+
+func (l *adaptiveRateLimiter) calculateTimeWindow() {
+	l.timeWindow = math.Cbrt(l.lastMaxRate * (1 - adaptiveBeta) / adaptiveScaleConstant)
+}
+
+func seconds(t time.Time) float64 {
+	return float64(t.UnixNano()) / float64(time.Second)
+}
+
+// requestThrottle holds the retry and rate limiting state shared by all service clients.
+type requestThrottle struct {
+	mode string
+	now  func() time.Time
+
+	quota *retryQuota
+
+	mu       sync.Mutex
+	static   map[string]*staticRateLimiter
+	adaptive map[string]*adaptiveRateLimiter
+}
+
+// requestThrottle returns the configured retry and rate limiting state.
+func (c *Config) requestThrottle() (*requestThrottle, error) {
+	t := &requestThrottle{
+		mode:     c.RetryMode,
+		now:      time.Now,
+		static:   make(map[string]*staticRateLimiter),
+		adaptive: make(map[string]*adaptiveRateLimiter),
+	}
+
+	switch t.mode {
+	case "":
+		t.mode = RetryModeLegacy
+	case RetryModeLegacy:
+	case RetryModeStandard, RetryModeAdaptive:
+		t.quota = newRetryQuota()
+	default:
+		return nil, fmt.Errorf("unsupported retry mode (%s), expected one of: %s", t.mode, strings.Join(RetryMode_Values(), ", "))
+	}
+
+	for service, rate := range c.MaxRequestRates {
+		if rate <= 0 {
+			return nil, fmt.Errorf("invalid maximum request rate for %s (%f), must be greater than zero", service, rate)
+		}
+
+		t.static[service] = newStaticRateLimiter(rate)
+	}
+
+	return t, nil
+}
+
+// retryer returns the request.Retryer for the retry mode, or nil to use the AWS SDK for Go default.
+func (t *requestThrottle) retryer(maxRetries int) request.Retryer {
+	if t.mode == RetryModeLegacy {
+		return nil
+	}
+
+	return standardRetryer{
+		DefaultRetryer: client.DefaultRetryer{NumMaxRetries: maxRetries},
+	}
+}
+
+// install adds the request handlers to a session or service client's handlers.
+func (t *requestThrottle) install(handlers *request.Handlers) {
+	handlers.Sign.PushFrontNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.RateLimitHandler",
+		Fn:   t.rateLimit,
+	})
+
+	if t.quota != nil {
+		handlers.AfterRetry.PushFrontNamed(request.NamedHandler{
+			Name: "terraform-provider-aws.RetryQuotaHandler",
+			Fn:   t.retryQuota,
+		})
+		handlers.Complete.PushBackNamed(request.NamedHandler{
+			Name: "terraform-provider-aws.RetryQuotaReleaseHandler",
+			Fn:   t.quota.release,
+		})
+	}
+
+	if t.mode == RetryModeAdaptive {
+		handlers.Retry.PushFrontNamed(request.NamedHandler{
+			Name: "terraform-provider-aws.AdaptiveRateLimitThrottledHandler",
+			Fn: func(r *request.Request) {
+				t.adaptiveRateLimiter(r.ClientInfo).update(r.IsErrorThrottle(), t.now())
+			},
+		})
+		handlers.Complete.PushBackNamed(request.NamedHandler{
+			Name: "terraform-provider-aws.AdaptiveRateLimitSucceededHandler",
+			Fn: func(r *request.Request) {
+				if r.Error == nil {
+					t.adaptiveRateLimiter(r.ClientInfo).update(false, t.now())
+				}
+			},
+		})
+	}
+}
+
+// rateLimit delays each request attempt until the service's rate limiters allow it.
+func (t *requestThrottle) rateLimit(r *request.Request) {
+	var limiters []rateLimiter
+
+	if v, ok := t.static[serviceKey(r.ClientInfo)]; ok {
+		limiters = append(limiters, v)
+	}
+
+	if t.mode == RetryModeAdaptive {
+		limiters = append(limiters, t.adaptiveRateLimiter(r.ClientInfo))
+	}
+
+	for _, limiter := range limiters {
+		if err := sleepWithContext(r.Context(), limiter.acquire(t.now())); err != nil {
+			r.Error = awserr.New(request.CanceledErrorCode, "request context canceled", err)
+
+			return
+		}
+	}
+}
+
+// retryQuota prevents a retry once the retry quota is exhausted.
+// It runs before the AWS SDK for Go's own handler makes the final retry decision.
+func (t *requestThrottle) retryQuota(r *request.Request) {
+	if r.Retryable == nil {
+		r.Retryable = aws.Bool(r.ShouldRetry(r))
+	}
+
+	if !r.WillRetry() {
+		return
+	}
+
+	if !t.quota.acquire(r) {
+		r.Retryable = aws.Bool(false)
+	}
+}
+
+func (t *requestThrottle) adaptiveRateLimiter(info metadata.ClientInfo) *adaptiveRateLimiter {
+	key := serviceKey(info)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	limiter, ok := t.adaptive[key]
+
+	if !ok {
+		limiter = newAdaptiveRateLimiter(t.now())
+		t.adaptive[key] = limiter
+	}
+
+	return limiter
+}
+
+// serviceKey returns the name used to configure a service's request rate.
+// This is the service ID in lower case without spaces, e.g. "route53", matching the AWS SDK for Go package name.
+func serviceKey(info metadata.ClientInfo) string {
+	return strings.ToLower(strings.ReplaceAll(info.ServiceID, " ", ""))
+}
+
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+
+	return aws.SleepWithContext(ctx, d)
+}
+
+// isErrorTimeout returns whether the error, or any error it wraps, is a timeout.
+func isErrorTimeout(err error) bool {
+	for err != nil {
+		if v, ok := err.(interface{ Timeout() bool }); ok && v.Timeout() {
+			return true
+		}
+
+		switch v := err.(type) {
+		case awserr.Error:
+			switch v.Code() {
+			case "RequestTimeout", "RequestTimeoutException":
+				return true
+			}
+
+			err = v.OrigErr()
+		case *url.Error:
+			err = v.Err
+		default:
+			return false
+		}
+	}
+
+	return false
+}
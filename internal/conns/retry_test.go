@@ -0,0 +1,322 @@
+package conns
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+const testThrottlingResponse = `<ErrorResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <Error>
+    <Type>Sender</Type>
+    <Code>Throttling</Code>
+    <Message>Rate exceeded</Message>
+  </Error>
+  <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+</ErrorResponse>`
+
+// testThrottlingSTSServer returns a local STS stand-in that answers GetCallerIdentity,
+// responding with a throttling error for requests for which throttle returns true.
+// Each request's arrival time is recorded.
+func testThrottlingSTSServer(t *testing.T, throttle func(n int) bool, got *[]time.Time) *httptest.Server {
+	var mu sync.Mutex
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		*got = append(*got, time.Now())
+		n := len(*got)
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "text/xml")
+		w.Header().Set("X-Amzn-Requestid", "01234567-89ab-cdef-0123-456789abcdef")
+
+		if throttle(n) {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, testThrottlingResponse)
+			return
+		}
+
+		fmt.Fprint(w, testGetCallerIdentityResponse)
+	}))
+}
+
+// testConfigClientSTSConn returns an STS client for the configuration, with retry delays disabled.
+func testConfigClientSTSConn(t *testing.T, config *Config) *sts.STS {
+	config.AccessKey = "StaticAccessKey"
+	config.Region = "us-east-1" //lintignore:AWSAT003
+	config.SecretKey = "StaticSecretKey"
+	config.SkipCredsValidation = true
+	config.SkipGetEC2Platforms = true
+	config.SkipMetadataApiCheck = true
+	config.SkipRequestingAccountId = true
+
+	raw, err := config.Client()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	conn := raw.(*AWSClient).STSConn
+	conn.Config.SleepDelay = func(time.Duration) {}
+
+	return conn
+}
+
+func TestConfigClientRetryMode(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		RetryMode        string
+		ThrottledCount   int
+		ExpectedError    bool
+		ExpectedRequests int
+	}{
+		{
+			Name:             "legacy",
+			RetryMode:        RetryModeLegacy,
+			ThrottledCount:   2,
+			ExpectedRequests: 3,
+		},
+		{
+			Name:             "legacy exhausted",
+			RetryMode:        RetryModeLegacy,
+			ThrottledCount:   10,
+			ExpectedError:    true,
+			ExpectedRequests: 4,
+		},
+		{
+			Name:             "standard",
+			RetryMode:        RetryModeStandard,
+			ThrottledCount:   2,
+			ExpectedRequests: 3,
+		},
+		{
+			Name:             "standard exhausted",
+			RetryMode:        RetryModeStandard,
+			ThrottledCount:   10,
+			ExpectedError:    true,
+			ExpectedRequests: 4,
+		},
+		{
+			Name:             "adaptive",
+			RetryMode:        RetryModeAdaptive,
+			ThrottledCount:   1,
+			ExpectedRequests: 2,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			var got []time.Time
+			server := testThrottlingSTSServer(t, func(n int) bool { return n <= testCase.ThrottledCount }, &got)
+			defer server.Close()
+
+			conn := testConfigClientSTSConn(t, &Config{
+				Endpoints:  map[string]string{"sts": server.URL},
+				MaxRetries: 3,
+				RetryMode:  testCase.RetryMode,
+			})
+
+			_, err := conn.GetCallerIdentity(&sts.GetCallerIdentityInput{})
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got none")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, expected := len(got), testCase.ExpectedRequests; got != expected {
+				t.Errorf("got %d requests, expected %d", got, expected)
+			}
+		})
+	}
+}
+
+func TestConfigClientRetryModeInvalid(t *testing.T) {
+	config := &Config{
+		Region:    "us-east-1", //lintignore:AWSAT003
+		RetryMode: "aggressive",
+	}
+
+	if _, err := config.Client(); err == nil {
+		t.Fatalf("expected error, got none")
+	}
+}
+
+func TestConfigClientRetryQuota(t *testing.T) {
+	var got []time.Time
+	server := testThrottlingSTSServer(t, func(int) bool { return true }, &got)
+	defer server.Close()
+
+	conn := testConfigClientSTSConn(t, &Config{
+		Endpoints:  map[string]string{"sts": server.URL},
+		MaxRetries: 25,
+		RetryMode:  RetryModeStandard,
+	})
+
+	// Each retry consumes 5 units of the 500 unit quota, so only 100 retries are possible.
+	for i := 0; i < 10; i++ {
+		if _, err := conn.GetCallerIdentity(&sts.GetCallerIdentityInput{}); err == nil {
+			t.Fatalf("expected error, got none")
+		}
+	}
+
+	if got, expected := len(got), 10+100; got != expected {
+		t.Errorf("got %d requests, expected %d", got, expected)
+	}
+}
+
+func TestConfigClientAdaptiveRateLimit(t *testing.T) {
+	var got []time.Time
+	server := testThrottlingSTSServer(t, func(n int) bool { return n == 1 }, &got)
+	defer server.Close()
+
+	conn := testConfigClientSTSConn(t, &Config{
+		Endpoints:  map[string]string{"sts": server.URL},
+		MaxRetries: 3,
+		RetryMode:  RetryModeAdaptive,
+	})
+
+	if _, err := conn.GetCallerIdentity(&sts.GetCallerIdentityInput{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := len(got), 2; got != expected {
+		t.Fatalf("got %d requests, expected %d", got, expected)
+	}
+
+	// Retry delays are disabled, so the retry is only delayed by the rate limiter.
+	// With a single request measured, the rate after throttling is at most ~1.1 requests per second.
+	if elapsed, expected := got[1].Sub(got[0]), 800*time.Millisecond; elapsed < expected {
+		t.Errorf("retry after throttling took %s, expected at least %s", elapsed, expected)
+	}
+}
+
+func TestConfigClientMaxRequestRates(t *testing.T) {
+	var got []time.Time
+	server := testThrottlingSTSServer(t, func(int) bool { return false }, &got)
+	defer server.Close()
+
+	conn := testConfigClientSTSConn(t, &Config{
+		Endpoints:       map[string]string{"sts": server.URL},
+		MaxRequestRates: map[string]float64{"sts": 50},
+		MaxRetries:      3,
+	})
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 11; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if _, err := conn.GetCallerIdentity(&sts.GetCallerIdentityInput{}); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if got, expected := len(got), 11; got != expected {
+		t.Fatalf("got %d requests, expected %d", got, expected)
+	}
+
+	first, last := got[0], got[0]
+
+	for _, v := range got {
+		if v.Before(first) {
+			first = v
+		}
+
+		if v.After(last) {
+			last = v
+		}
+	}
+
+	// 10 requests after the first at 50 requests per second.
+	if elapsed, expected := last.Sub(first), 180*time.Millisecond; elapsed < expected {
+		t.Errorf("requests took %s, expected at least %s", elapsed, expected)
+	}
+}
+
+func TestConfigClientMaxRequestRatesInvalid(t *testing.T) {
+	config := &Config{
+		MaxRequestRates: map[string]float64{"sts": 0},
+		Region:          "us-east-1", //lintignore:AWSAT003
+	}
+
+	if _, err := config.Client(); err == nil {
+		t.Fatalf("expected error, got none")
+	}
+}
+
+func TestAdaptiveRateLimiter(t *testing.T) {
+	now := time.Unix(1000, 0)
+	limiter := newAdaptiveRateLimiter(now)
+
+	if got := limiter.acquire(now); got != 0 {
+		t.Errorf("wait before throttling: got %s, expected none", got)
+	}
+
+	// Send 10 requests per second for 5 seconds, then throttle.
+	for i := 0; i < 50; i++ {
+		now = now.Add(100 * time.Millisecond)
+		limiter.update(false, now)
+	}
+
+	limiter.update(true, now)
+
+	if !limiter.enabled {
+		t.Fatalf("expected rate limiting to be enabled after throttling")
+	}
+
+	throttledRate := limiter.bucket.fillRate
+
+	if throttledRate >= 10 || throttledRate < 5 {
+		t.Errorf("rate after throttling: got %f, expected 70%% of ~10 requests per second", throttledRate)
+	}
+
+	// The rate recovers towards, and beyond, the rate at which throttling occurred.
+	for i := 0; i < 100; i++ {
+		now = now.Add(100 * time.Millisecond)
+		limiter.update(false, now)
+	}
+
+	if got := limiter.bucket.fillRate; got <= throttledRate {
+		t.Errorf("rate after recovery: got %f, expected greater than %f", got, throttledRate)
+	}
+}
+
+func TestRetryQuota(t *testing.T) {
+	quota := newRetryQuota()
+	failed := &request.Request{Error: fmt.Errorf("failed")}
+
+	for i := 0; i < retryQuotaInitialCapacity/retryQuotaRetryCost; i++ {
+		if !quota.acquire(failed) {
+			t.Fatalf("retry %d: expected quota to be available", i)
+		}
+	}
+
+	if quota.acquire(failed) {
+		t.Fatalf("expected quota to be exhausted")
+	}
+
+	// A retried request that succeeds returns the capacity of its last retry.
+	failed.Error = nil
+	quota.release(failed)
+
+	if !quota.acquire(&request.Request{Error: fmt.Errorf("failed")}) {
+		t.Errorf("expected quota to be available after a successful retry")
+	}
+}
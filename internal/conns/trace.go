@@ -0,0 +1,143 @@
+package conns
+
+import (
+	"encoding/json"
+	"log"
+	"reflect"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/hashicorp/terraform-provider-aws/internal/redact"
+)
+
+// apiCallTrace is the structured log entry for a single AWS API call, including any retries.
+type apiCallTrace struct {
+	Service        string      `json:"service"`
+	Operation      string      `json:"operation"`
+	Region         string      `json:"region"`
+	DurationMillis int64       `json:"duration_ms"`
+	Retries        int         `json:"retries"`
+	RequestID      string      `json:"request_id,omitempty"`
+	HTTPStatusCode int         `json:"http_status_code,omitempty"`
+	ErrorCode      string      `json:"error_code,omitempty"`
+	ErrorMessage   string      `json:"error_message,omitempty"`
+	Parameters     interface{} `json:"parameters,omitempty"`
+}
+
+// traceHandler logs a JSON trace of each completed AWS API call, with sensitive parameter values redacted.
+var traceHandler = request.NamedHandler{
+	Name: "terraform-provider-aws.TraceHandler",
+	Fn: func(r *request.Request) {
+		trace := apiCallTrace{
+			Service:        serviceKey(r.ClientInfo),
+			Region:         aws.StringValue(r.Config.Region),
+			DurationMillis: time.Since(r.Time).Milliseconds(),
+			Retries:        r.RetryCount,
+			RequestID:      r.RequestID,
+			Parameters:     redactTraceValue(reflect.ValueOf(r.Params)),
+		}
+
+		if r.Operation != nil {
+			trace.Operation = r.Operation.Name
+		}
+
+		if r.HTTPResponse != nil {
+			trace.HTTPStatusCode = r.HTTPResponse.StatusCode
+		}
+
+		if err, ok := r.Error.(awserr.Error); ok {
+			trace.ErrorCode = err.Code()
+			trace.ErrorMessage = err.Message()
+		} else if r.Error != nil {
+			trace.ErrorMessage = r.Error.Error()
+		}
+
+		b, err := json.Marshal(trace)
+
+		if err != nil {
+			log.Printf("[WARN] error encoding AWS API call trace: %s", err)
+			return
+		}
+
+		log.Printf("[DEBUG] [aws-api-trace] %s", b)
+	},
+}
+
+// redactTraceValue returns a JSON-encodable copy of an AWS SDK for Go input shape,
+// omitting unset fields and redacting sensitive values.
+func redactTraceValue(v reflect.Value) interface{} {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+
+		return redactTraceValue(v.Elem())
+	case reflect.Struct:
+		if t, ok := v.Interface().(time.Time); ok {
+			return t
+		}
+
+		m := make(map[string]interface{})
+
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+
+			if field.PkgPath != "" {
+				continue
+			}
+
+			value := v.Field(i)
+
+			if isZeroTraceValue(value) {
+				continue
+			}
+
+			if redact.IsSensitiveField(field) {
+				m[field.Name] = redact.Redacted
+				continue
+			}
+
+			m[field.Name] = redactTraceValue(value)
+		}
+
+		return m
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			// Binary data, e.g. object contents, is never logged.
+			return redact.Redacted
+		}
+
+		s := make([]interface{}, v.Len())
+
+		for i := range s {
+			s[i] = redactTraceValue(v.Index(i))
+		}
+
+		return s
+	case reflect.Map:
+		m := make(map[string]interface{}, v.Len())
+
+		for _, k := range v.MapKeys() {
+			m[k.String()] = redactTraceValue(v.MapIndex(k))
+		}
+
+		return m
+	case reflect.Bool, reflect.Float32, reflect.Float64, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.String:
+		return v.Interface()
+	default:
+		// Streams and other types that cannot be logged.
+		return nil
+	}
+}
+
+func isZeroTraceValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice:
+		return v.IsNil()
+	default:
+		return false
+	}
+}
@@ -0,0 +1,193 @@
+package conns
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+// testTraces returns the API call traces logged by f.
+func testTraces(t *testing.T, f func()) []apiCallTrace {
+	var buf bytes.Buffer
+
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	f()
+
+	var traces []apiCallTrace
+
+	for _, line := range strings.Split(buf.String(), "\n") {
+		i := strings.Index(line, "[aws-api-trace] ")
+
+		if i < 0 {
+			continue
+		}
+
+		var trace apiCallTrace
+
+		if err := json.Unmarshal([]byte(line[i+len("[aws-api-trace] "):]), &trace); err != nil {
+			t.Fatalf("error decoding trace (%s): %s", line, err)
+		}
+
+		traces = append(traces, trace)
+	}
+
+	return traces
+}
+
+func TestConfigClientAPITraceLogging(t *testing.T) {
+	var got []time.Time
+	server := testThrottlingSTSServer(t, func(n int) bool { return n == 1 || n >= 3 }, &got)
+	defer server.Close()
+
+	conn := testConfigClientSTSConn(t, &Config{
+		APITraceLogging: true,
+		Endpoints:       map[string]string{"sts": server.URL},
+		MaxRetries:      1,
+	})
+
+	traces := testTraces(t, func() {
+		// Throttled, then succeeds on retry.
+		if _, err := conn.GetCallerIdentity(&sts.GetCallerIdentityInput{}); err != nil {
+			t.Errorf("unexpected error: %s", err)
+		}
+
+		// Throttled until retries are exhausted.
+		if _, err := conn.GetCallerIdentity(&sts.GetCallerIdentityInput{}); err == nil {
+			t.Errorf("expected error, got none")
+		}
+	})
+
+	if got, expected := len(traces), 2; got != expected {
+		t.Fatalf("got %d traces, expected %d", got, expected)
+	}
+
+	expected := []apiCallTrace{
+		{
+			Service:        "sts",
+			Operation:      "GetCallerIdentity",
+			Region:         "us-east-1", //lintignore:AWSAT003
+			Retries:        1,
+			RequestID:      "01234567-89ab-cdef-0123-456789abcdef",
+			HTTPStatusCode: 200,
+			Parameters:     map[string]interface{}{},
+		},
+		{
+			Service:        "sts",
+			Operation:      "GetCallerIdentity",
+			Region:         "us-east-1", //lintignore:AWSAT003
+			Retries:        1,
+			RequestID:      "01234567-89ab-cdef-0123-456789abcdef",
+			HTTPStatusCode: 400,
+			ErrorCode:      "Throttling",
+			ErrorMessage:   "Rate exceeded",
+			Parameters:     map[string]interface{}{},
+		},
+	}
+
+	for i := range expected {
+		traces[i].DurationMillis = 0
+
+		if !reflect.DeepEqual(traces[i], expected[i]) {
+			t.Errorf("trace %d: got %#v, expected %#v", i, traces[i], expected[i])
+		}
+	}
+}
+
+func TestConfigClientAPITraceLoggingDisabled(t *testing.T) {
+	var got []time.Time
+	server := testThrottlingSTSServer(t, func(int) bool { return false }, &got)
+	defer server.Close()
+
+	conn := testConfigClientSTSConn(t, &Config{
+		Endpoints:  map[string]string{"sts": server.URL},
+		MaxRetries: 1,
+	})
+
+	traces := testTraces(t, func() {
+		if _, err := conn.GetCallerIdentity(&sts.GetCallerIdentityInput{}); err != nil {
+			t.Errorf("unexpected error: %s", err)
+		}
+	})
+
+	if got := len(traces); got != 0 {
+		t.Errorf("got %d traces, expected none", got)
+	}
+}
+
+func TestRedactTraceValue(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    interface{}
+		Expected string
+	}{
+		{
+			Name: "tagged sensitive",
+			Input: &secretsmanager.PutSecretValueInput{
+				SecretId:      aws.String("example"),
+				SecretString:  aws.String("hunter2"),
+				VersionStages: aws.StringSlice([]string{"AWSCURRENT"}),
+			},
+			Expected: `{"SecretId":"example","SecretString":"***REDACTED***","VersionStages":["AWSCURRENT"]}`,
+		},
+		{
+			Name: "binary",
+			Input: &secretsmanager.PutSecretValueInput{
+				SecretBinary: []byte("hunter2"),
+				SecretId:     aws.String("example"),
+			},
+			Expected: `{"SecretBinary":"***REDACTED***","SecretId":"example"}`,
+		},
+		{
+			Name: "known sensitive field",
+			Input: &sts.AssumeRoleWithWebIdentityInput{
+				DurationSeconds:  aws.Int64(3600),
+				RoleArn:          aws.String("arn:aws:iam::555555555555:role/WebIdentityRole"),
+				RoleSessionName:  aws.String("test-session"),
+				WebIdentityToken: aws.String("eyJhbGciOiJSUzI1NiJ9"),
+			},
+			Expected: `{"DurationSeconds":3600,"RoleArn":"arn:aws:iam::555555555555:role/WebIdentityRole","RoleSessionName":"test-session","WebIdentityToken":"***REDACTED***"}`,
+		},
+		{
+			Name: "nested",
+			Input: &sts.AssumeRoleInput{
+				RoleArn: aws.String("arn:aws:iam::555555555555:role/Role"),
+				Tags: []*sts.Tag{
+					{Key: aws.String("Project"), Value: aws.String("hub")},
+				},
+			},
+			Expected: `{"RoleArn":"arn:aws:iam::555555555555:role/Role","Tags":[{"Key":"Project","Value":"hub"}]}`,
+		},
+		{
+			Name:     "nil",
+			Input:    nil,
+			Expected: `null`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			b, err := json.Marshal(redactTraceValue(reflect.ValueOf(testCase.Input)))
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := string(b); got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,554 @@
+package flex
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Options configures Expand and Flatten.
+type Options struct {
+	// AttributeNames maps AWS SDK struct field names to attribute names, overriding
+	// FieldNameToAttributeName, e.g. {"NumberOfNodes": "node_count"}.
+	// It applies to fields of nested structs too.
+	AttributeNames map[string]string
+
+	// ZeroValueAttributeNames are the attributes whose zero-valued strings and numbers
+	// are expanded, e.g. a port that may be 0. See Expand.
+	ZeroValueAttributeNames []string
+}
+
+// Expand sets the fields of the AWS SDK struct pointed to by apiObject from Terraform data,
+// typically a nested block from (*schema.ResourceData).Get().
+//
+// tfData is either a map[string]interface{} or a list (or *schema.Set) containing one.
+// Each exported field of the AWS SDK struct is set from the key that is its snake_case name,
+// e.g. "KmsKeyId" from "kms_key_id", unless overridden by Options.AttributeNames.
+// Nested structs are expanded from blocks, i.e. lists of maps.
+// Enums are strings in the AWS SDK, and timestamps are expanded from RFC3339 strings.
+//
+// Keys which are missing leave the field nil. Because (*schema.ResourceData).Get() returns
+// the zero value for unset attributes, zero-valued strings, numbers, lists and maps also
+// leave the field nil, but false is expanded: an unset bool cannot be told apart from false.
+// Use Options.ZeroValueAttributeNames for attributes where an empty string or 0 is meaningful.
+func Expand(tfData interface{}, apiObject interface{}, optFns ...func(*Options)) error {
+	to := reflect.ValueOf(apiObject)
+
+	if to.Kind() != reflect.Ptr || to.IsNil() || to.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("expected a non-nil pointer to a struct, got %T", apiObject)
+	}
+
+	tfMap, ok := expandBlock(tfData)
+
+	if !ok {
+		return fmt.Errorf("expected a map or a list of one map, got %T", tfData)
+	}
+
+	if tfMap == nil {
+		return nil
+	}
+
+	return expandStruct(tfMap, to.Elem(), newOptions(optFns))
+}
+
+// Flatten returns the Terraform data for the AWS SDK struct (or pointer to struct) apiObject.
+// It is the reverse of Expand: nil fields are omitted, nested structs are flattened to blocks,
+// i.e. lists of one map, and timestamps are flattened to RFC3339 strings.
+// Attribute names are overridden by Options.AttributeNames.
+func Flatten(apiObject interface{}, optFns ...func(*Options)) (map[string]interface{}, error) {
+	from := reflect.ValueOf(apiObject)
+
+	if from.Kind() == reflect.Ptr {
+		if from.IsNil() {
+			return nil, nil
+		}
+
+		from = from.Elem()
+	}
+
+	if from.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("expected a struct or pointer to a struct, got %T", apiObject)
+	}
+
+	return flattenStruct(from, newOptions(optFns))
+}
+
+func newOptions(optFns []func(*Options)) *Options {
+	options := &Options{}
+
+	for _, optFn := range optFns {
+		optFn(options)
+	}
+
+	return options
+}
+
+func (o *Options) attributeName(fieldName string) string {
+	if v, ok := o.AttributeNames[fieldName]; ok {
+		return v
+	}
+
+	return FieldNameToAttributeName(fieldName)
+}
+
+func (o *Options) expandZeroValue(attributeName string) bool {
+	for _, v := range o.ZeroValueAttributeNames {
+		if v == attributeName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// FieldNameToAttributeName returns the snake_case attribute name for an AWS SDK struct field name.
+// Versioned and plural acronyms are kept whole, e.g. "PrivateIPv4Address" is "private_ipv4_address"
+// and "AllowedAllVPCs" is "allowed_all_vpcs".
+func FieldNameToAttributeName(fieldName string) string {
+	runes := []rune(fieldName)
+	var b strings.Builder
+
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+
+			// Start a new word after a lower case letter or digit ("KmsKey", "S3Bucket"),
+			// or at the last upper case letter of an acronym ("IPAddress").
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1]) && !isAcronymSuffix(runes[i+1:])) {
+				b.WriteRune('_')
+			}
+		}
+
+		b.WriteRune(unicode.ToLower(r))
+	}
+
+	return b.String()
+}
+
+// isAcronymSuffix returns whether the lower case letter at the start of runes ends an acronym,
+// i.e. it is the "v" of a version ("IPv6") or a plural "s" ("VPCs") rather than the start of a word.
+func isAcronymSuffix(runes []rune) bool {
+	switch runes[0] {
+	case 'v':
+		return len(runes) > 1 && unicode.IsDigit(runes[1])
+	case 's':
+		return len(runes) == 1 || unicode.IsUpper(runes[1])
+	}
+
+	return false
+}
+
+var (
+	bytesType = reflect.TypeOf([]byte(nil))
+	timeType  = reflect.TypeOf(time.Time{})
+)
+
+// expandBlock returns the map for a block. ok is false if tfData is not a block; the map is nil for an empty block.
+func expandBlock(tfData interface{}) (map[string]interface{}, bool) {
+	switch v := tfData.(type) {
+	case nil:
+		return nil, true
+	case map[string]interface{}:
+		return v, true
+	case *schema.Set:
+		if v == nil {
+			return nil, true
+		}
+
+		return expandBlock(v.List())
+	case []interface{}:
+		if len(v) == 0 || v[0] == nil {
+			return nil, true
+		}
+
+		tfMap, ok := v[0].(map[string]interface{})
+
+		return tfMap, ok
+	}
+
+	return nil, false
+}
+
+func expandStruct(tfMap map[string]interface{}, to reflect.Value, options *Options) error {
+	typ := to.Type()
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+
+		if field.PkgPath != "" {
+			continue
+		}
+
+		key := options.attributeName(field.Name)
+		v, ok := tfMap[key]
+
+		if !ok || v == nil {
+			continue
+		}
+
+		if err := expandValue(v, to.Field(i), options, options.expandZeroValue(key)); err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+	}
+
+	return nil
+}
+
+// expandValue sets to from the Terraform value v. Zero-valued strings and numbers are only expanded if zeroValue is true.
+func expandValue(v interface{}, to reflect.Value, options *Options, zeroValue bool) error {
+	switch typ := to.Type(); typ.Kind() {
+	case reflect.Ptr:
+		elemType := typ.Elem()
+
+		if elemType == timeType {
+			s, ok := v.(string)
+
+			if !ok {
+				return fmt.Errorf("expected string, got %T", v)
+			}
+
+			if s == "" {
+				return nil
+			}
+
+			t, err := time.Parse(time.RFC3339, s)
+
+			if err != nil {
+				return err
+			}
+
+			to.Set(reflect.ValueOf(&t))
+
+			return nil
+		}
+
+		if elemType.Kind() == reflect.Struct {
+			tfMap, ok := expandBlock(v)
+
+			if !ok {
+				return fmt.Errorf("expected block, got %T", v)
+			}
+
+			if tfMap == nil {
+				return nil
+			}
+
+			ptr := reflect.New(elemType)
+
+			if err := expandStruct(tfMap, ptr.Elem(), options); err != nil {
+				return err
+			}
+
+			to.Set(ptr)
+
+			return nil
+		}
+
+		elem, set, err := expandPrimitive(v, elemType)
+
+		if err != nil {
+			return err
+		}
+
+		if set || zeroValue {
+			ptr := reflect.New(elemType)
+			ptr.Elem().Set(elem)
+			to.Set(ptr)
+		}
+
+		return nil
+	case reflect.Slice:
+		// Blobs are expanded from strings.
+		if typ == bytesType {
+			s, ok := v.(string)
+
+			if !ok {
+				return fmt.Errorf("expected string, got %T", v)
+			}
+
+			if s != "" || zeroValue {
+				to.SetBytes([]byte(s))
+			}
+
+			return nil
+		}
+
+		var l []interface{}
+
+		switch v := v.(type) {
+		case []interface{}:
+			l = v
+		case *schema.Set:
+			l = v.List()
+		default:
+			return fmt.Errorf("expected list or set, got %T", v)
+		}
+
+		if len(l) == 0 {
+			return nil
+		}
+
+		slice := reflect.MakeSlice(typ, 0, len(l))
+
+		for i, e := range l {
+			elem := reflect.New(typ.Elem()).Elem()
+
+			if err := expandValue(e, elem, options, zeroValue); err != nil {
+				return fmt.Errorf("[%d]: %w", i, err)
+			}
+
+			if elem.Kind() == reflect.Ptr && elem.IsNil() {
+				continue
+			}
+
+			slice = reflect.Append(slice, elem)
+		}
+
+		to.Set(slice)
+
+		return nil
+	case reflect.Map:
+		m, ok := v.(map[string]interface{})
+
+		if !ok {
+			return fmt.Errorf("expected map, got %T", v)
+		}
+
+		if typ.Key().Kind() != reflect.String {
+			return fmt.Errorf("unsupported map key type: %s", typ.Key())
+		}
+
+		if len(m) == 0 {
+			return nil
+		}
+
+		result := reflect.MakeMapWithSize(typ, len(m))
+
+		for k, e := range m {
+			elem := reflect.New(typ.Elem()).Elem()
+
+			// Unlike struct fields, zero-valued map values such as empty tag values are kept.
+			if elemType := typ.Elem(); elemType.Kind() == reflect.Ptr && isPrimitive(elemType.Elem()) {
+				primitive, _, err := expandPrimitive(e, elemType.Elem())
+
+				if err != nil {
+					return fmt.Errorf("[%q]: %w", k, err)
+				}
+
+				elem.Set(reflect.New(elemType.Elem()))
+				elem.Elem().Set(primitive)
+			} else if err := expandValue(e, elem, options, zeroValue); err != nil {
+				return fmt.Errorf("[%q]: %w", k, err)
+			}
+
+			result.SetMapIndex(reflect.ValueOf(k).Convert(typ.Key()), elem)
+		}
+
+		to.Set(result)
+
+		return nil
+	}
+
+	return fmt.Errorf("unsupported type: %s", to.Type())
+}
+
+func isPrimitive(typ reflect.Type) bool {
+	switch typ.Kind() {
+	case reflect.String, reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Float32, reflect.Float64:
+		return true
+	}
+
+	return false
+}
+
+// expandPrimitive converts a Terraform primitive value to the AWS SDK type.
+// set is false for zero-valued strings and numbers, which are treated as unset
+// unless the attribute is in Options.ZeroValueAttributeNames. It is always true for bools.
+func expandPrimitive(v interface{}, typ reflect.Type) (reflect.Value, bool, error) {
+	switch typ.Kind() {
+	case reflect.String:
+		s, ok := v.(string)
+
+		if !ok {
+			return reflect.Value{}, false, fmt.Errorf("expected string, got %T", v)
+		}
+
+		return reflect.ValueOf(s).Convert(typ), s != "", nil
+	case reflect.Bool:
+		b, ok := v.(bool)
+
+		if !ok {
+			return reflect.Value{}, false, fmt.Errorf("expected bool, got %T", v)
+		}
+
+		return reflect.ValueOf(b).Convert(typ), true, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var i int64
+
+		switch v := v.(type) {
+		case int:
+			i = int64(v)
+		case int64:
+			i = v
+		default:
+			return reflect.Value{}, false, fmt.Errorf("expected int, got %T", v)
+		}
+
+		return reflect.ValueOf(i).Convert(typ), i != 0, nil
+	case reflect.Float32, reflect.Float64:
+		var f float64
+
+		switch v := v.(type) {
+		case float64:
+			f = v
+		case int:
+			f = float64(v)
+		default:
+			return reflect.Value{}, false, fmt.Errorf("expected float, got %T", v)
+		}
+
+		return reflect.ValueOf(f).Convert(typ), f != 0, nil
+	}
+
+	return reflect.Value{}, false, fmt.Errorf("unsupported type: %s", typ)
+}
+
+func flattenStruct(from reflect.Value, options *Options) (map[string]interface{}, error) {
+	typ := from.Type()
+	tfMap := make(map[string]interface{})
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+
+		if field.PkgPath != "" {
+			continue
+		}
+
+		key := options.attributeName(field.Name)
+		v, ok, err := flattenValue(from.Field(i), options)
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", key, err)
+		}
+
+		if ok {
+			tfMap[key] = v
+		}
+	}
+
+	return tfMap, nil
+}
+
+// flattenValue returns the Terraform value for an AWS SDK value. ok is false for nil values.
+func flattenValue(from reflect.Value, options *Options) (interface{}, bool, error) {
+	switch from.Kind() {
+	case reflect.Ptr:
+		if from.IsNil() {
+			return nil, false, nil
+		}
+
+		elem := from.Elem()
+
+		if elem.Type() == timeType {
+			return elem.Interface().(time.Time).Format(time.RFC3339), true, nil
+		}
+
+		if elem.Kind() == reflect.Struct {
+			tfMap, err := flattenStruct(elem, options)
+
+			if err != nil {
+				return nil, false, err
+			}
+
+			return []interface{}{tfMap}, true, nil
+		}
+
+		return flattenPrimitive(elem)
+	case reflect.Slice:
+		if from.IsNil() {
+			return nil, false, nil
+		}
+
+		if from.Type() == bytesType {
+			return string(from.Bytes()), true, nil
+		}
+
+		l := make([]interface{}, 0, from.Len())
+
+		for i := 0; i < from.Len(); i++ {
+			elem := from.Index(i)
+
+			// A nested struct is flattened to a single map, not a block.
+			if elem.Kind() == reflect.Ptr && !elem.IsNil() && elem.Elem().Kind() == reflect.Struct && elem.Elem().Type() != timeType {
+				tfMap, err := flattenStruct(elem.Elem(), options)
+
+				if err != nil {
+					return nil, false, fmt.Errorf("[%d]: %w", i, err)
+				}
+
+				l = append(l, tfMap)
+
+				continue
+			}
+
+			v, ok, err := flattenValue(elem, options)
+
+			if err != nil {
+				return nil, false, fmt.Errorf("[%d]: %w", i, err)
+			}
+
+			if ok {
+				l = append(l, v)
+			}
+		}
+
+		return l, true, nil
+	case reflect.Map:
+		if from.IsNil() {
+			return nil, false, nil
+		}
+
+		if from.Type().Key().Kind() != reflect.String {
+			return nil, false, fmt.Errorf("unsupported map key type: %s", from.Type().Key())
+		}
+
+		m := make(map[string]interface{}, from.Len())
+		iter := from.MapRange()
+
+		for iter.Next() {
+			k := iter.Key().String()
+			v, ok, err := flattenValue(iter.Value(), options)
+
+			if err != nil {
+				return nil, false, fmt.Errorf("[%q]: %w", k, err)
+			}
+
+			if ok {
+				m[k] = v
+			}
+		}
+
+		return m, true, nil
+	}
+
+	return flattenPrimitive(from)
+}
+
+func flattenPrimitive(from reflect.Value) (interface{}, bool, error) {
+	switch from.Kind() {
+	case reflect.String:
+		return from.String(), true, nil
+	case reflect.Bool:
+		return from.Bool(), true, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return int(from.Int()), true, nil
+	case reflect.Float32, reflect.Float64:
+		return from.Float(), true, nil
+	}
+
+	return nil, false, fmt.Errorf("unsupported type: %s", from.Type())
+}
@@ -0,0 +1,365 @@
+package flex
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appmesh"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestFieldNameToAttributeName(t *testing.T) {
+	testCases := []struct {
+		FieldName string
+		Expected  string
+	}{
+		{"Arn", "arn"},
+		{"ARN", "arn"},
+		{"KmsKeyId", "kms_key_id"},
+		{"IPAddress", "ip_address"},
+		{"DBInstanceIdentifier", "db_instance_identifier"},
+		{"S3Bucket", "s3_bucket"},
+		{"Ipv6CidrBlock", "ipv6_cidr_block"},
+		{"BlockPublicAcls", "block_public_acls"},
+		{"IPv6Address", "ipv6_address"},
+		{"PrivateIPv4Address", "private_ipv4_address"},
+		{"AllowedAllVPCs", "allowed_all_vpcs"},
+		{"SubnetARNs", "subnet_arns"},
+		{"DBInstances", "db_instances"},
+		{"IPSetId", "ip_set_id"},
+	}
+
+	for _, testCase := range testCases {
+		if got := FieldNameToAttributeName(testCase.FieldName); got != testCase.Expected {
+			t.Errorf("FieldNameToAttributeName(%q) = %q, expected %q", testCase.FieldName, got, testCase.Expected)
+		}
+	}
+}
+
+func TestExpand(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		TFData      interface{}
+		OptFns      []func(*Options)
+		Target      interface{}
+		Expected    interface{}
+		ExpectError bool
+	}{
+		{
+			Name: "strings, enum, float and map",
+			TFData: []interface{}{map[string]interface{}{
+				"default_value":    0.5,
+				"dimensions":       map[string]interface{}{"Key": "Value", "Empty": ""},
+				"metric_name":      "test",
+				"metric_namespace": "",
+				"metric_value":     "1",
+				"unit":             cloudwatchlogs.StandardUnitCount,
+			}},
+			Target: &cloudwatchlogs.MetricTransformation{},
+			Expected: &cloudwatchlogs.MetricTransformation{
+				DefaultValue: aws.Float64(0.5),
+				Dimensions:   aws.StringMap(map[string]string{"Key": "Value", "Empty": ""}),
+				MetricName:   aws.String("test"),
+				MetricValue:  aws.String("1"),
+				Unit:         aws.String(cloudwatchlogs.StandardUnitCount),
+			},
+		},
+		{
+			Name: "bools",
+			TFData: map[string]interface{}{
+				"block_public_acls":   true,
+				"block_public_policy": false,
+			},
+			Target: &s3.PublicAccessBlockConfiguration{},
+			Expected: &s3.PublicAccessBlockConfiguration{
+				BlockPublicAcls:   aws.Bool(true),
+				BlockPublicPolicy: aws.Bool(false),
+			},
+		},
+		{
+			Name: "string list from set",
+			TFData: map[string]interface{}{
+				"items":    schema.NewSet(schema.HashString, []interface{}{"example.com"}),
+				"quantity": 1,
+			},
+			Target: &cloudfront.Aliases{},
+			Expected: &cloudfront.Aliases{
+				Items:    aws.StringSlice([]string{"example.com"}),
+				Quantity: aws.Int64(1),
+			},
+		},
+		{
+			Name: "nested blocks",
+			TFData: []interface{}{map[string]interface{}{
+				"listeners": []interface{}{
+					map[string]interface{}{
+						"port_mapping": []interface{}{map[string]interface{}{
+							"port":     8080,
+							"protocol": appmesh.PortProtocolHttp,
+						}},
+					},
+				},
+			}},
+			Target: &appmesh.VirtualRouterSpec{},
+			Expected: &appmesh.VirtualRouterSpec{
+				Listeners: []*appmesh.VirtualRouterListener{
+					{
+						PortMapping: &appmesh.PortMapping{
+							Port:     aws.Int64(8080),
+							Protocol: aws.String(appmesh.PortProtocolHttp),
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "timestamp",
+			TFData: map[string]interface{}{
+				"arn":        "arn:aws:appmesh:us-west-2:123456789012:mesh/test", //lintignore:AWSAT003,AWSAT005
+				"created_at": "2021-10-01T12:00:00Z",
+				"version":    1,
+			},
+			Target: &appmesh.ResourceMetadata{},
+			Expected: &appmesh.ResourceMetadata{
+				Arn:       aws.String("arn:aws:appmesh:us-west-2:123456789012:mesh/test"), //lintignore:AWSAT003,AWSAT005
+				CreatedAt: aws.Time(time.Date(2021, time.October, 1, 12, 0, 0, 0, time.UTC)),
+				Version:   aws.Int64(1),
+			},
+		},
+		{
+			Name: "attribute names",
+			TFData: []interface{}{map[string]interface{}{
+				"listeners": []interface{}{
+					map[string]interface{}{
+						"port": []interface{}{map[string]interface{}{
+							"number":   8080,
+							"protocol": appmesh.PortProtocolHttp,
+						}},
+					},
+				},
+			}},
+			OptFns: []func(*Options){func(o *Options) {
+				o.AttributeNames = map[string]string{"PortMapping": "port", "Port": "number"}
+			}},
+			Target: &appmesh.VirtualRouterSpec{},
+			Expected: &appmesh.VirtualRouterSpec{
+				Listeners: []*appmesh.VirtualRouterListener{
+					{
+						PortMapping: &appmesh.PortMapping{
+							Port:     aws.Int64(8080),
+							Protocol: aws.String(appmesh.PortProtocolHttp),
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "zero values",
+			TFData: map[string]interface{}{
+				"default_value":    0.0,
+				"metric_name":      "test",
+				"metric_namespace": "",
+				"metric_value":     "",
+			},
+			OptFns: []func(*Options){func(o *Options) {
+				o.ZeroValueAttributeNames = []string{"default_value", "metric_namespace"}
+			}},
+			Target: &cloudwatchlogs.MetricTransformation{},
+			Expected: &cloudwatchlogs.MetricTransformation{
+				DefaultValue:    aws.Float64(0),
+				MetricName:      aws.String("test"),
+				MetricNamespace: aws.String(""),
+			},
+		},
+		{
+			Name:     "empty block",
+			TFData:   []interface{}{},
+			Target:   &appmesh.VirtualRouterSpec{},
+			Expected: &appmesh.VirtualRouterSpec{},
+		},
+		{
+			Name: "wrong type",
+			TFData: map[string]interface{}{
+				"port": "8080",
+			},
+			Target:      &appmesh.PortMapping{},
+			ExpectError: true,
+		},
+		{
+			Name:        "not a pointer",
+			TFData:      map[string]interface{}{},
+			Target:      appmesh.PortMapping{},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := Expand(testCase.TFData, testCase.Target, testCase.OptFns...)
+
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(testCase.Target, testCase.Expected) {
+				t.Errorf("got %s, expected %s", testCase.Target, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		APIObject interface{}
+		OptFns    []func(*Options)
+		Expected  map[string]interface{}
+	}{
+		{
+			Name: "strings, enum, float and map",
+			APIObject: &cloudwatchlogs.MetricTransformation{
+				DefaultValue: aws.Float64(0),
+				Dimensions:   aws.StringMap(map[string]string{"Key": "Value"}),
+				MetricName:   aws.String("test"),
+				Unit:         aws.String(cloudwatchlogs.StandardUnitCount),
+			},
+			Expected: map[string]interface{}{
+				"default_value": float64(0),
+				"dimensions":    map[string]interface{}{"Key": "Value"},
+				"metric_name":   "test",
+				"unit":          cloudwatchlogs.StandardUnitCount,
+			},
+		},
+		{
+			Name: "string list",
+			APIObject: cloudfront.Aliases{
+				Items:    aws.StringSlice([]string{"example.com"}),
+				Quantity: aws.Int64(1),
+			},
+			Expected: map[string]interface{}{
+				"items":    []interface{}{"example.com"},
+				"quantity": 1,
+			},
+		},
+		{
+			Name: "nested blocks",
+			APIObject: &appmesh.VirtualRouterSpec{
+				Listeners: []*appmesh.VirtualRouterListener{
+					{
+						PortMapping: &appmesh.PortMapping{
+							Port:     aws.Int64(8080),
+							Protocol: aws.String(appmesh.PortProtocolHttp),
+						},
+					},
+				},
+			},
+			Expected: map[string]interface{}{
+				"listeners": []interface{}{
+					map[string]interface{}{
+						"port_mapping": []interface{}{map[string]interface{}{
+							"port":     8080,
+							"protocol": appmesh.PortProtocolHttp,
+						}},
+					},
+				},
+			},
+		},
+		{
+			Name: "attribute names",
+			APIObject: &appmesh.VirtualRouterSpec{
+				Listeners: []*appmesh.VirtualRouterListener{
+					{
+						PortMapping: &appmesh.PortMapping{
+							Port:     aws.Int64(8080),
+							Protocol: aws.String(appmesh.PortProtocolHttp),
+						},
+					},
+				},
+			},
+			OptFns: []func(*Options){func(o *Options) {
+				o.AttributeNames = map[string]string{"PortMapping": "port", "Port": "number"}
+			}},
+			Expected: map[string]interface{}{
+				"listeners": []interface{}{
+					map[string]interface{}{
+						"port": []interface{}{map[string]interface{}{
+							"number":   8080,
+							"protocol": appmesh.PortProtocolHttp,
+						}},
+					},
+				},
+			},
+		},
+		{
+			Name: "timestamp",
+			APIObject: &appmesh.ResourceMetadata{
+				CreatedAt: aws.Time(time.Date(2021, time.October, 1, 12, 0, 0, 0, time.UTC)),
+				Version:   aws.Int64(1),
+			},
+			Expected: map[string]interface{}{
+				"created_at": "2021-10-01T12:00:00Z",
+				"version":    1,
+			},
+		},
+		{
+			Name:      "nil",
+			APIObject: (*appmesh.VirtualRouterSpec)(nil),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := Flatten(testCase.APIObject, testCase.OptFns...)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %#v, expected %#v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestExpandFlattenRoundTrip(t *testing.T) {
+	tfData := map[string]interface{}{
+		"block_public_acls":       true,
+		"block_public_policy":     false,
+		"ignore_public_acls":      true,
+		"restrict_public_buckets": false,
+	}
+
+	apiObject := &s3.PublicAccessBlockConfiguration{}
+
+	if err := Expand(tfData, apiObject); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := Flatten(apiObject)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !reflect.DeepEqual(got, tfData) {
+		t.Errorf("got %#v, expected %#v", got, tfData)
+	}
+}
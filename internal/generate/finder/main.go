@@ -0,0 +1,562 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+const (
+	sdkModule = "github.com/aws/aws-sdk-go"
+)
+
+var (
+	op            = flag.String("Op", "", "name of the AWS SDK operation, e.g. DescribeFileSystems")
+	name          = flag.String("Name", "", "name of the resource type in the generated function names, e.g. FileSystem")
+	idField       = flag.String("IDField", "", "name of the field of the operation input identifying the resource, e.g. FileSystemIds")
+	listField     = flag.String("ListField", "", "name of the field of the operation output listing the resources, e.g. FileSystems")
+	notFoundCodes = flag.String("NotFoundCodes", "", "comma-separated AWS error codes, or names of AWS SDK ErrCode constants, signalling that the resource is not found")
+	sdkPackage    = flag.String("SDKPackage", "", "name of the AWS SDK service package (default: the name of the current directory)")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+type TemplateData struct {
+	Parameters     string
+	ServicePackage string
+	SDKPackage     string
+	SDKImport      string
+	IfacePackage   string
+	IfaceImport    string
+	ClientAPI      string
+
+	Op            string
+	Name          string
+	PluralName    string
+	IDField       string
+	IDFieldIsList bool
+	ListField     string
+	ElemType      string
+	Paginated     bool
+	NotFoundCodes []string
+}
+
+func main() {
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	for _, v := range []struct {
+		flag  string
+		value string
+	}{
+		{"Op", *op},
+		{"Name", *name},
+		{"IDField", *idField},
+		{"ListField", *listField},
+	} {
+		if v.value == "" {
+			flag.Usage()
+			log.Fatalf("-%s is required", v.flag)
+		}
+	}
+
+	wd, err := os.Getwd()
+
+	if err != nil {
+		log.Fatalf("unable to get working directory: %s", err)
+	}
+
+	servicePackage := filepath.Base(wd)
+	pkgName := *sdkPackage
+
+	if pkgName == "" {
+		pkgName = servicePackage
+	}
+
+	sdk, err := parseSDKPackage(pkgName)
+
+	if err != nil {
+		log.Fatalf("error parsing AWS SDK package %s: %s", pkgName, err)
+	}
+
+	client, ok := sdk.clientType(*op)
+
+	if !ok {
+		log.Fatalf("operation %s not found in AWS SDK package %s", *op, pkgName)
+	}
+
+	idType, ok := sdk.fieldType(*op+"Input", *idField)
+
+	if !ok {
+		log.Fatalf("field %s not found in %s.%sInput", *idField, pkgName, *op)
+	}
+
+	if idType != "*string" && idType != "[]*string" {
+		log.Fatalf("field %s.%sInput.%s has type %s, expected *string or []*string", pkgName, *op, *idField, idType)
+	}
+
+	listType, ok := sdk.fieldType(*op+"Output", *listField)
+
+	if !ok {
+		log.Fatalf("field %s not found in %s.%sOutput", *listField, pkgName, *op)
+	}
+
+	if !strings.HasPrefix(listType, "[]*") {
+		log.Fatalf("field %s.%sOutput.%s has type %s, expected a list of pointers", pkgName, *op, *listField, listType)
+	}
+
+	templateData := TemplateData{
+		Parameters:     strings.Join(os.Args[1:], " "),
+		ServicePackage: servicePackage,
+		SDKPackage:     pkgName,
+		SDKImport:      fmt.Sprintf("%s/service/%s", sdkModule, pkgName),
+		IfacePackage:   pkgName + "iface",
+		IfaceImport:    fmt.Sprintf("%s/service/%s/%siface", sdkModule, pkgName, pkgName),
+		ClientAPI:      client + "API",
+
+		Op:            *op,
+		Name:          *name,
+		PluralName:    plural(*name),
+		IDField:       *idField,
+		IDFieldIsList: idType == "[]*string",
+		ListField:     *listField,
+		ElemType:      fmt.Sprintf("%s.%s", pkgName, strings.TrimPrefix(listType, "[]*")),
+		Paginated:     sdk.hasMethod(client, *op+"Pages"),
+	}
+
+	for _, code := range strings.Split(*notFoundCodes, ",") {
+		code = strings.TrimSpace(code)
+
+		switch {
+		case code == "":
+			continue
+		case strings.HasPrefix(code, "ErrCode"):
+			if !sdk.hasConst(code) {
+				log.Fatalf("constant %s not found in AWS SDK package %s", code, pkgName)
+			}
+
+			templateData.NotFoundCodes = append(templateData.NotFoundCodes, fmt.Sprintf("%s.%s", pkgName, code))
+		default:
+			templateData.NotFoundCodes = append(templateData.NotFoundCodes, strconv.Quote(code))
+		}
+	}
+
+	baseName := fmt.Sprintf("find_%s_gen", snakeCase(*name))
+
+	writeTemplate(baseName+".go", finderTemplate, templateData)
+	writeTemplate(baseName+"_test.go", finderTestTemplate, templateData)
+}
+
+func writeTemplate(filename, body string, templateData TemplateData) {
+	tmpl := template.Must(template.New(filename).Parse(body))
+
+	var buffer bytes.Buffer
+	if err := tmpl.Execute(&buffer, templateData); err != nil {
+		log.Fatalf("error executing template for %s: %s", filename, err)
+	}
+
+	src, err := format.Source(buffer.Bytes())
+	if err != nil {
+		log.Printf("warning: internal error: invalid Go generated: %s", err)
+		log.Printf("warning: compile the package to analyze the error")
+		src = buffer.Bytes()
+	}
+
+	if err := os.WriteFile(filename, src, 0644); err != nil {
+		log.Fatalf("error writing %s: %s", filename, err)
+	}
+}
+
+type sdkPackageInfo struct {
+	consts  map[string]bool
+	methods map[string]map[string]bool
+	structs map[string]*ast.StructType
+}
+
+// parseSDKPackage parses the source of an AWS SDK for Go service package from the module cache.
+func parseSDKPackage(pkgName string) (*sdkPackageInfo, error) {
+	output, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", sdkModule).Output()
+
+	if err != nil {
+		return nil, fmt.Errorf("error locating %s: %w", sdkModule, err)
+	}
+
+	dir := filepath.Join(strings.TrimSpace(string(output)), "service", pkgName)
+
+	pkgs, err := parser.ParseDir(token.NewFileSet(), dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pkg, ok := pkgs[pkgName]
+
+	if !ok {
+		return nil, fmt.Errorf("package %s not found in %s", pkgName, dir)
+	}
+
+	info := &sdkPackageInfo{
+		consts:  make(map[string]bool),
+		methods: make(map[string]map[string]bool),
+		structs: make(map[string]*ast.StructType),
+	}
+
+	for _, file := range pkg.Files {
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if decl.Recv == nil || len(decl.Recv.List) != 1 {
+					continue
+				}
+
+				star, ok := decl.Recv.List[0].Type.(*ast.StarExpr)
+
+				if !ok {
+					continue
+				}
+
+				ident, ok := star.X.(*ast.Ident)
+
+				if !ok {
+					continue
+				}
+
+				if info.methods[ident.Name] == nil {
+					info.methods[ident.Name] = make(map[string]bool)
+				}
+
+				info.methods[ident.Name][decl.Name.Name] = true
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					switch spec := spec.(type) {
+					case *ast.ValueSpec:
+						if decl.Tok == token.CONST {
+							for _, name := range spec.Names {
+								info.consts[name.Name] = true
+							}
+						}
+					case *ast.TypeSpec:
+						if structType, ok := spec.Type.(*ast.StructType); ok {
+							info.structs[spec.Name.Name] = structType
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return info, nil
+}
+
+// clientType returns the name of the service client type implementing the operation.
+func (info *sdkPackageInfo) clientType(op string) (string, bool) {
+	for typeName, methods := range info.methods {
+		if methods[op] && methods[op+"WithContext"] {
+			return typeName, true
+		}
+	}
+
+	return "", false
+}
+
+func (info *sdkPackageInfo) hasMethod(typeName, method string) bool {
+	return info.methods[typeName][method]
+}
+
+func (info *sdkPackageInfo) hasConst(name string) bool {
+	return info.consts[name]
+}
+
+func (info *sdkPackageInfo) fieldType(structName, fieldName string) (string, bool) {
+	structType, ok := info.structs[structName]
+
+	if !ok {
+		return "", false
+	}
+
+	for _, field := range structType.Fields.List {
+		for _, name := range field.Names {
+			if name.Name == fieldName {
+				return types.ExprString(field.Type), true
+			}
+		}
+	}
+
+	return "", false
+}
+
+func plural(s string) string {
+	switch {
+	case strings.HasSuffix(s, "s"), strings.HasSuffix(s, "x"), strings.HasSuffix(s, "sh"), strings.HasSuffix(s, "ch"):
+		return s + "es"
+	case strings.HasSuffix(s, "y") && !strings.HasSuffix(s, "ay") && !strings.HasSuffix(s, "ey") && !strings.HasSuffix(s, "oy"):
+		return strings.TrimSuffix(s, "y") + "ies"
+	}
+
+	return s + "s"
+}
+
+var snakeCaseRegexp = regexp.MustCompile(`([a-z0-9])([A-Z])`)
+
+func snakeCase(s string) string {
+	return strings.ToLower(snakeCaseRegexp.ReplaceAllString(s, "${1}_${2}"))
+}
+
+const finderTemplate = `// Code generated by "internal/generate/finder/main.go {{ .Parameters }}"; DO NOT EDIT.
+
+package {{ .ServicePackage }}
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+{{- if .NotFoundCodes }}
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+{{- end }}
+	"{{ .SDKImport }}"
+	"{{ .IfaceImport }}"
+{{- if .NotFoundCodes }}
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+{{- end }}
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Find{{ .PluralName }} returns all {{ .PluralName }} matching the {{ .Op }} input.
+func Find{{ .PluralName }}(conn {{ .IfacePackage }}.{{ .ClientAPI }}, input *{{ .SDKPackage }}.{{ .Op }}Input) ([]*{{ .ElemType }}, error) {
+	var output []*{{ .ElemType }}
+{{ if .Paginated }}
+	err := conn.{{ .Op }}Pages(input, func(page *{{ .SDKPackage }}.{{ .Op }}Output, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.{{ .ListField }} {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+{{ else }}
+	page, err := conn.{{ .Op }}(input)
+{{ end }}
+{{- if .NotFoundCodes }}
+	if tfawserr.ErrCodeEquals(err, {{ range $i, $v := .NotFoundCodes }}{{ if $i }}, {{ end }}{{ $v }}{{ end }}) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+{{ end }}
+	if err != nil {
+		return nil, err
+	}
+{{ if not .Paginated }}
+	if page != nil {
+		for _, v := range page.{{ .ListField }} {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+	}
+{{ end }}
+	return output, nil
+}
+
+// Find{{ .Name }}ByID returns the {{ .Name }} with the specified ID.
+func Find{{ .Name }}ByID(conn {{ .IfacePackage }}.{{ .ClientAPI }}, id string) (*{{ .ElemType }}, error) {
+	input := &{{ .SDKPackage }}.{{ .Op }}Input{
+{{- if .IDFieldIsList }}
+		{{ .IDField }}: aws.StringSlice([]string{id}),
+{{- else }}
+		{{ .IDField }}: aws.String(id),
+{{- end }}
+	}
+
+	output, err := Find{{ .PluralName }}(conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+`
+
+const finderTestTemplate = `// Code generated by "internal/generate/finder/main.go {{ .Parameters }}"; DO NOT EDIT.
+
+package {{ .ServicePackage }}_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"{{ .SDKImport }}"
+	"{{ .IfaceImport }}"
+	tf{{ .ServicePackage }} "github.com/hashicorp/terraform-provider-aws/internal/service/{{ .ServicePackage }}"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type mock{{ .PluralName }}Conn struct {
+	{{ .IfacePackage }}.{{ .ClientAPI }}
+
+	pages []*{{ .SDKPackage }}.{{ .Op }}Output
+	err   error
+}
+{{ if .Paginated }}
+func (m *mock{{ .PluralName }}Conn) {{ .Op }}Pages(input *{{ .SDKPackage }}.{{ .Op }}Input, fn func(*{{ .SDKPackage }}.{{ .Op }}Output, bool) bool) error {
+	if m.err != nil {
+		return m.err
+	}
+
+	for i, page := range m.pages {
+		if !fn(page, i == len(m.pages)-1) {
+			break
+		}
+	}
+
+	return nil
+}
+{{ else }}
+func (m *mock{{ .PluralName }}Conn) {{ .Op }}(input *{{ .SDKPackage }}.{{ .Op }}Input) (*{{ .SDKPackage }}.{{ .Op }}Output, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	if len(m.pages) == 0 {
+		return nil, nil
+	}
+
+	return m.pages[0], nil
+}
+{{ end }}
+func TestFind{{ .Name }}ByID(t *testing.T) {
+	found := &{{ .ElemType }}{}
+
+	testCases := []struct {
+		Name          string
+		Conn          *mock{{ .PluralName }}Conn
+		ExpectFound   bool
+		ExpectNotFound bool
+		ExpectError   error
+	}{
+		{
+			Name: "found",
+			Conn: &mock{{ .PluralName }}Conn{
+				pages: []*{{ .SDKPackage }}.{{ .Op }}Output{
+					{ {{- .ListField }}: []*{{ .ElemType }}{found}},
+				},
+			},
+			ExpectFound: true,
+		},
+		{
+			Name: "empty result",
+			Conn: &mock{{ .PluralName }}Conn{
+				pages: []*{{ .SDKPackage }}.{{ .Op }}Output{
+					{},
+				},
+			},
+			ExpectNotFound: true,
+			ExpectError:    tfresource.ErrEmptyResult,
+		},
+		{
+			Name: "too many results",
+			Conn: &mock{{ .PluralName }}Conn{
+				pages: []*{{ .SDKPackage }}.{{ .Op }}Output{
+					{ {{- .ListField }}: []*{{ .ElemType }}{found, {}}},
+				},
+			},
+			ExpectNotFound: true,
+			ExpectError:    tfresource.ErrTooManyResults,
+		},
+{{- if .Paginated }}
+		{
+			Name: "too many results across pages",
+			Conn: &mock{{ .PluralName }}Conn{
+				pages: []*{{ .SDKPackage }}.{{ .Op }}Output{
+					{ {{- .ListField }}: []*{{ .ElemType }}{found}},
+					{ {{- .ListField }}: []*{{ .ElemType }}{ {} }},
+				},
+			},
+			ExpectNotFound: true,
+			ExpectError:    tfresource.ErrTooManyResults,
+		},
+{{- end }}
+{{- range .NotFoundCodes }}
+		{
+			Name: "not found error " + {{ . }},
+			Conn: &mock{{ $.PluralName }}Conn{
+				err: awserr.New({{ . }}, "not found", nil),
+			},
+			ExpectNotFound: true,
+		},
+{{- end }}
+		{
+			Name: "other error",
+			Conn: &mock{{ .PluralName }}Conn{
+				err: awserr.New("InternalFailure", "internal failure", nil),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			output, err := tf{{ .ServicePackage }}.Find{{ .Name }}ByID(testCase.Conn, "test-id")
+
+			if got, expected := tfresource.NotFound(err), testCase.ExpectNotFound; got != expected {
+				t.Errorf("got not found %t, expected %t: %v", got, expected, err)
+			}
+
+			if testCase.ExpectError != nil && !errors.Is(err, testCase.ExpectError) {
+				t.Errorf("got error %v, expected %v", err, testCase.ExpectError)
+			}
+
+			if testCase.ExpectFound {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if output != found {
+					t.Errorf("got %v, expected %v", output, found)
+				}
+			} else if err == nil {
+				t.Errorf("expected error, got %v", output)
+			}
+		})
+	}
+}
+`
@@ -502,7 +502,7 @@ func init() {
 	awsServiceNames["lexruntimev2"] = "LexRuntimeV2"
 	awsServiceNames["licensemanager"] = "LicenseManager"
 	awsServiceNames["lightsail"] = "Lightsail"
-	awsServiceNames["location"] = "Location"
+	awsServiceNames["locationservice"] = "LocationService"
 	awsServiceNames["lookoutequipment"] = "LookoutEquipment"
 	awsServiceNames["lookoutforvision"] = "LookoutForVision"
 	awsServiceNames["lookoutmetrics"] = "LookoutMetrics"
@@ -0,0 +1,500 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	modulePath = "github.com/hashicorp/terraform-provider-aws"
+
+	checkSweeper    = "sweeper"
+	checkImport     = "import"
+	checkDisappears = "disappears"
+
+	baselineHeader = `# Known resource coverage gaps, one "<resource> <sweeper|import|disappears>" per line.
+# Generated by "internal/generate/resourcecoverage/main.go -WriteBaseline". Remove entries as gaps are fixed.
+`
+)
+
+var (
+	providerFile  = flag.String("Provider", "internal/provider/provider.go", "path of the file declaring the provider ResourcesMap")
+	format        = flag.String("Format", "table", "report format: table or csv")
+	baselineFile  = flag.String("Baseline", "", "path of a file listing known coverage gaps; new gaps not listed cause a non-zero exit")
+	writeBaseline = flag.Bool("WriteBaseline", false, "write the current coverage gaps to the -Baseline file instead of checking them")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags]\n\n")
+	fmt.Fprintf(os.Stderr, "Reports resources without a sweeper, an ImportState acceptance test step or a disappears acceptance test.\n")
+	fmt.Fprintf(os.Stderr, "Must be run from the root of the repository.\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+// resourceRegistration is a resource type name registered in the provider ResourcesMap.
+type resourceRegistration struct {
+	Name        string
+	Dir         string
+	Constructor string
+}
+
+// resourceCoverage is the coverage of a registered resource type.
+type resourceCoverage struct {
+	resourceRegistration
+	Sweeper    bool
+	Import     bool
+	Disappears bool
+}
+
+func (c resourceCoverage) gaps() []string {
+	var gaps []string
+
+	if !c.Sweeper {
+		gaps = append(gaps, gapKey(c.Name, checkSweeper))
+	}
+
+	if !c.Import {
+		gaps = append(gaps, gapKey(c.Name, checkImport))
+	}
+
+	if !c.Disappears {
+		gaps = append(gaps, gapKey(c.Name, checkDisappears))
+	}
+
+	return gaps
+}
+
+func gapKey(resourceName, check string) string {
+	return fmt.Sprintf("%s %s", resourceName, check)
+}
+
+// packageInfo is the information gathered from the source files of a Go package directory.
+type packageInfo struct {
+	// funcFiles maps function names to the base name of the non-test file declaring them.
+	funcFiles map[string]string
+	// importStateFiles is the set of test files with an ImportState test step.
+	importStateFiles map[string]bool
+	// disappearsFiles is the set of test files with a *_disappears* test function.
+	disappearsFiles map[string]bool
+	// disappearsFuncs is the set of resource constructors passed to CheckResourceDisappears.
+	disappearsFuncs map[string]bool
+}
+
+func main() {
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	if *writeBaseline && *baselineFile == "" {
+		log.Fatal("-WriteBaseline requires -Baseline")
+	}
+
+	registrations, err := parseResourcesMap(*providerFile)
+
+	if err != nil {
+		log.Fatalf("error parsing %s: %s", *providerFile, err)
+	}
+
+	sweepers := make(map[string]bool)
+	packages := make(map[string]*packageInfo)
+
+	for _, registration := range registrations {
+		if _, ok := packages[registration.Dir]; ok {
+			continue
+		}
+
+		info, err := parsePackage(registration.Dir, sweepers)
+
+		if err != nil {
+			log.Fatalf("error parsing %s: %s", registration.Dir, err)
+		}
+
+		packages[registration.Dir] = info
+	}
+
+	var coverages []resourceCoverage
+
+	for _, registration := range registrations {
+		info := packages[registration.Dir]
+		testFile := strings.TrimSuffix(info.funcFiles[registration.Constructor], ".go") + "_test.go"
+
+		coverages = append(coverages, resourceCoverage{
+			resourceRegistration: registration,
+			Sweeper:              sweepers[registration.Name],
+			Import:               info.importStateFiles[testFile],
+			Disappears:           info.disappearsFuncs[registration.Constructor] || info.disappearsFiles[testFile],
+		})
+	}
+
+	if err := writeReport(os.Stdout, coverages); err != nil {
+		log.Fatalf("error writing report: %s", err)
+	}
+
+	if *baselineFile == "" {
+		return
+	}
+
+	var gaps []string
+
+	for _, coverage := range coverages {
+		gaps = append(gaps, coverage.gaps()...)
+	}
+
+	if *writeBaseline {
+		content := baselineHeader + strings.Join(gaps, "\n") + "\n"
+
+		if err := os.WriteFile(*baselineFile, []byte(content), 0644); err != nil {
+			log.Fatalf("error writing %s: %s", *baselineFile, err)
+		}
+
+		return
+	}
+
+	baseline, err := readBaseline(*baselineFile)
+
+	if err != nil {
+		log.Fatalf("error reading %s: %s", *baselineFile, err)
+	}
+
+	var newGaps []string
+
+	for _, gap := range gaps {
+		if !baseline[gap] {
+			newGaps = append(newGaps, gap)
+		}
+
+		delete(baseline, gap)
+	}
+
+	if len(baseline) > 0 {
+		var fixed []string
+
+		for gap := range baseline {
+			fixed = append(fixed, gap)
+		}
+
+		sort.Strings(fixed)
+		log.Printf("%d coverage gaps in %s are no longer present and can be removed:\n\t%s", len(fixed), *baselineFile, strings.Join(fixed, "\n\t"))
+	}
+
+	if len(newGaps) > 0 {
+		log.Fatalf("%d new coverage gaps not in %s:\n\t%s", len(newGaps), *baselineFile, strings.Join(newGaps, "\n\t"))
+	}
+}
+
+// parseResourcesMap returns the resource type names registered in the provider ResourcesMap, sorted by name.
+func parseResourcesMap(filename string) ([]resourceRegistration, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), filename, nil, 0)
+
+	if err != nil {
+		return nil, err
+	}
+
+	imports := make(map[string]string)
+
+	for _, spec := range file.Imports {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+
+		if err != nil {
+			return nil, err
+		}
+
+		name := path.Base(importPath)
+
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+
+		imports[name] = importPath
+	}
+
+	var registrations []resourceRegistration
+
+	add := func(key ast.Expr, value ast.Expr) {
+		name, ok := stringValue(key)
+
+		if !ok {
+			return
+		}
+
+		callExpr, ok := value.(*ast.CallExpr)
+
+		if !ok {
+			return
+		}
+
+		selectorExpr, ok := callExpr.Fun.(*ast.SelectorExpr)
+
+		if !ok {
+			return
+		}
+
+		ident, ok := selectorExpr.X.(*ast.Ident)
+
+		if !ok {
+			return
+		}
+
+		importPath, ok := imports[ident.Name]
+
+		if !ok || !strings.HasPrefix(importPath, modulePath+"/") {
+			return
+		}
+
+		registrations = append(registrations, resourceRegistration{
+			Name:        name,
+			Dir:         filepath.FromSlash(strings.TrimPrefix(importPath, modulePath+"/")),
+			Constructor: selectorExpr.Sel.Name,
+		})
+	}
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.KeyValueExpr:
+			// ResourcesMap: map[string]*schema.Resource{...}
+			if ident, ok := n.Key.(*ast.Ident); !ok || ident.Name != "ResourcesMap" {
+				return true
+			}
+
+			compositeLit, ok := n.Value.(*ast.CompositeLit)
+
+			if !ok {
+				return true
+			}
+
+			for _, elt := range compositeLit.Elts {
+				if kv, ok := elt.(*ast.KeyValueExpr); ok {
+					add(kv.Key, kv.Value)
+				}
+			}
+
+			return false
+		case *ast.AssignStmt:
+			// provider.ResourcesMap["..."] = ...
+			if len(n.Lhs) != 1 || len(n.Rhs) != 1 {
+				return true
+			}
+
+			indexExpr, ok := n.Lhs[0].(*ast.IndexExpr)
+
+			if !ok {
+				return true
+			}
+
+			if selectorExpr, ok := indexExpr.X.(*ast.SelectorExpr); !ok || selectorExpr.Sel.Name != "ResourcesMap" {
+				return true
+			}
+
+			add(indexExpr.Index, n.Rhs[0])
+		}
+
+		return true
+	})
+
+	sort.Slice(registrations, func(i, j int) bool {
+		return registrations[i].Name < registrations[j].Name
+	})
+
+	return registrations, nil
+}
+
+// parsePackage parses all Go files, including tests and files with build constraints, in a package directory.
+// Sweeper names registered by resource.AddTestSweepers are added to sweepers.
+func parsePackage(dir string, sweepers map[string]bool) (*packageInfo, error) {
+	info := &packageInfo{
+		funcFiles:        make(map[string]string),
+		importStateFiles: make(map[string]bool),
+		disappearsFiles:  make(map[string]bool),
+		disappearsFuncs:  make(map[string]bool),
+	}
+
+	pkgs, err := parser.ParseDir(token.NewFileSet(), dir, nil, 0)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pkg := range pkgs {
+		for filename, file := range pkg.Files {
+			base := filepath.Base(filename)
+			isTest := strings.HasSuffix(base, "_test.go")
+
+			for _, decl := range file.Decls {
+				funcDecl, ok := decl.(*ast.FuncDecl)
+
+				if !ok || funcDecl.Recv != nil {
+					continue
+				}
+
+				if !isTest {
+					info.funcFiles[funcDecl.Name.Name] = base
+				} else if strings.Contains(strings.ToLower(funcDecl.Name.Name), "_disappears") {
+					info.disappearsFiles[base] = true
+				}
+			}
+
+			ast.Inspect(file, func(n ast.Node) bool {
+				switch n := n.(type) {
+				case *ast.KeyValueExpr:
+					if key, ok := n.Key.(*ast.Ident); ok && key.Name == "ImportState" {
+						if value, ok := n.Value.(*ast.Ident); ok && value.Name == "true" {
+							info.importStateFiles[base] = true
+						}
+					}
+				case *ast.CallExpr:
+					switch funcName(n.Fun) {
+					case "AddTestSweepers":
+						if len(n.Args) > 0 {
+							if name, ok := stringValue(n.Args[0]); ok {
+								sweepers[name] = true
+							}
+						}
+					case "CheckResourceDisappears":
+						for _, arg := range n.Args {
+							if callExpr, ok := arg.(*ast.CallExpr); ok {
+								info.disappearsFuncs[funcName(callExpr.Fun)] = true
+							}
+						}
+					}
+				}
+
+				return true
+			})
+		}
+	}
+
+	return info, nil
+}
+
+// funcName returns the name of the function in a call expression such as f() or pkg.f().
+func funcName(e ast.Expr) string {
+	switch e := e.(type) {
+	case *ast.Ident:
+		return e.Name
+	case *ast.SelectorExpr:
+		return e.Sel.Name
+	}
+
+	return ""
+}
+
+func stringValue(e ast.Expr) (string, bool) {
+	basicLit, ok := e.(*ast.BasicLit)
+
+	if !ok || basicLit.Kind != token.STRING {
+		return "", false
+	}
+
+	value, err := strconv.Unquote(basicLit.Value)
+
+	if err != nil {
+		return "", false
+	}
+
+	return value, true
+}
+
+func readBaseline(filename string) (map[string]bool, error) {
+	file, err := os.Open(filename)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer file.Close()
+
+	baseline := make(map[string]bool)
+	scanner := bufio.NewScanner(file)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		baseline[strings.Join(strings.Fields(line), " ")] = true
+	}
+
+	return baseline, scanner.Err()
+}
+
+func writeReport(f *os.File, coverages []resourceCoverage) error {
+	yesNo := func(b bool) string {
+		if b {
+			return "yes"
+		}
+
+		return "no"
+	}
+
+	header := []string{"resource", "package", checkSweeper, checkImport, checkDisappears}
+
+	switch *format {
+	case "csv":
+		w := csv.NewWriter(f)
+
+		if err := w.Write(header); err != nil {
+			return err
+		}
+
+		for _, c := range coverages {
+			if err := w.Write([]string{c.Name, filepath.ToSlash(c.Dir), yesNo(c.Sweeper), yesNo(c.Import), yesNo(c.Disappears)}); err != nil {
+				return err
+			}
+		}
+
+		w.Flush()
+
+		return w.Error()
+	case "table":
+		w := tabwriter.NewWriter(f, 0, 8, 2, ' ', 0)
+		var sweepers, imports, disappears int
+
+		fmt.Fprintln(w, strings.ToUpper(strings.Join(header, "\t")))
+
+		for _, c := range coverages {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", c.Name, filepath.ToSlash(c.Dir), yesNo(c.Sweeper), yesNo(c.Import), yesNo(c.Disappears))
+
+			if c.Sweeper {
+				sweepers++
+			}
+
+			if c.Import {
+				imports++
+			}
+
+			if c.Disappears {
+				disappears++
+			}
+		}
+
+		if err := w.Flush(); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(f, "\n%d resources: %d with sweeper, %d with import test, %d with disappears test\n", len(coverages), sweepers, imports, disappears)
+
+		return nil
+	}
+
+	return fmt.Errorf("unsupported format: %s", *format)
+}
@@ -18,36 +18,40 @@ import (
 const filename = `tags_gen.go`
 
 var (
+	createTags       = flag.String("CreateTags", "", "whether to generate tag-on-create helpers")
 	getTag           = flag.String("GetTag", "", "whether to generate GetTag")
 	listTags         = flag.String("ListTags", "", "whether to generate ListTags")
 	serviceTagsMap   = flag.String("ServiceTagsMap", "", "whether to generate service tags for map")
 	serviceTagsSlice = flag.String("ServiceTagsSlice", "", "whether to generate service tags for slice")
 	updateTags       = flag.String("UpdateTags", "", "whether to generate UpdateTags")
 
-	listTagsInFiltIDName  = flag.String("ListTagsInFiltIDName", "", "listTagsInFiltIDName")
-	listTagsInIDElem      = flag.String("ListTagsInIDElem", "ResourceArn", "listTagsInIDElem")
-	listTagsInIDNeedSlice = flag.String("ListTagsInIDNeedSlice", "", "listTagsInIDNeedSlice")
-	listTagsOp            = flag.String("ListTagsOp", "ListTagsForResource", "listTagsOp")
-	listTagsOutTagsElem   = flag.String("ListTagsOutTagsElem", "Tags", "listTagsOutTagsElem")
-	tagInCustomVal        = flag.String("TagInCustomVal", "", "tagInCustomVal")
-	tagInIDElem           = flag.String("TagInIDElem", "ResourceArn", "tagInIDElem")
-	tagInIDNeedSlice      = flag.String("TagInIDNeedSlice", "", "tagInIDNeedSlice")
-	tagInTagsElem         = flag.String("TagInTagsElem", "Tags", "tagInTagsElem")
-	tagKeyType            = flag.String("TagKeyType", "", "tagKeyType")
-	tagOp                 = flag.String("TagOp", "TagResource", "tagOp")
-	tagOpBatchSize        = flag.String("TagOpBatchSize", "", "tagOpBatchSize")
-	tagResTypeElem        = flag.String("TagResTypeElem", "", "tagResTypeElem")
-	tagType               = flag.String("TagType", "Tag", "tagType")
-	tagType2              = flag.String("TagType2", "", "tagType")
-	TagTypeAddBoolElem    = flag.String("TagTypeAddBoolElem", "", "TagTypeAddBoolElem")
-	tagTypeIDElem         = flag.String("TagTypeIDElem", "", "tagTypeIDElem")
-	tagTypeKeyElem        = flag.String("TagTypeKeyElem", "Key", "tagTypeKeyElem")
-	tagTypeValElem        = flag.String("TagTypeValElem", "Value", "tagTypeValElem")
-	untagInCustomVal      = flag.String("UntagInCustomVal", "", "untagInCustomVal")
-	untagInNeedTagKeyType = flag.String("UntagInNeedTagKeyType", "", "untagInNeedTagKeyType")
-	untagInNeedTagType    = flag.String("UntagInNeedTagType", "", "untagInNeedTagType")
-	untagInTagsElem       = flag.String("UntagInTagsElem", "TagKeys", "untagInTagsElem")
-	untagOp               = flag.String("UntagOp", "UntagResource", "untagOp")
+	createTagsInTagSpecs          = flag.String("CreateTagsInTagSpecs", "", "createTagsInTagSpecs")
+	createTagsUnsupportedErrCodes = flag.String("CreateTagsUnsupportedErrCodes", "", "createTagsUnsupportedErrCodes")
+	createTagsUnsupportedErrMsg   = flag.String("CreateTagsUnsupportedErrMsg", "", "createTagsUnsupportedErrMsg")
+	listTagsInFiltIDName          = flag.String("ListTagsInFiltIDName", "", "listTagsInFiltIDName")
+	listTagsInIDElem              = flag.String("ListTagsInIDElem", "ResourceArn", "listTagsInIDElem")
+	listTagsInIDNeedSlice         = flag.String("ListTagsInIDNeedSlice", "", "listTagsInIDNeedSlice")
+	listTagsOp                    = flag.String("ListTagsOp", "ListTagsForResource", "listTagsOp")
+	listTagsOutTagsElem           = flag.String("ListTagsOutTagsElem", "Tags", "listTagsOutTagsElem")
+	tagInCustomVal                = flag.String("TagInCustomVal", "", "tagInCustomVal")
+	tagInIDElem                   = flag.String("TagInIDElem", "ResourceArn", "tagInIDElem")
+	tagInIDNeedSlice              = flag.String("TagInIDNeedSlice", "", "tagInIDNeedSlice")
+	tagInTagsElem                 = flag.String("TagInTagsElem", "Tags", "tagInTagsElem")
+	tagKeyType                    = flag.String("TagKeyType", "", "tagKeyType")
+	tagOp                         = flag.String("TagOp", "TagResource", "tagOp")
+	tagOpBatchSize                = flag.String("TagOpBatchSize", "", "tagOpBatchSize")
+	tagResTypeElem                = flag.String("TagResTypeElem", "", "tagResTypeElem")
+	tagType                       = flag.String("TagType", "Tag", "tagType")
+	tagType2                      = flag.String("TagType2", "", "tagType")
+	TagTypeAddBoolElem            = flag.String("TagTypeAddBoolElem", "", "TagTypeAddBoolElem")
+	tagTypeIDElem                 = flag.String("TagTypeIDElem", "", "tagTypeIDElem")
+	tagTypeKeyElem                = flag.String("TagTypeKeyElem", "Key", "tagTypeKeyElem")
+	tagTypeValElem                = flag.String("TagTypeValElem", "Value", "tagTypeValElem")
+	untagInCustomVal              = flag.String("UntagInCustomVal", "", "untagInCustomVal")
+	untagInNeedTagKeyType         = flag.String("UntagInNeedTagKeyType", "", "untagInNeedTagKeyType")
+	untagInNeedTagType            = flag.String("UntagInNeedTagType", "", "untagInNeedTagType")
+	untagInTagsElem               = flag.String("UntagInTagsElem", "TagKeys", "untagInTagsElem")
+	untagOp                       = flag.String("UntagOp", "UntagResource", "untagOp")
 
 	parentNotFoundErrCode = flag.String("ParentNotFoundErrCode", "", "Parent 'NotFound' Error Code")
 	parentNotFoundErrMsg  = flag.String("ParentNotFoundErrMsg", "", "Parent 'NotFound' Error Message")
@@ -65,35 +69,40 @@ type TemplateData struct {
 	ClientType     string
 	ServicePackage string
 
-	ListTagsInFiltIDName    string
-	ListTagsInIDElem        string
-	ListTagsInIDNeedSlice   string
-	ListTagsOp              string
-	ListTagsOutTagsElem     string
-	ParentNotFoundErrCode   string
-	ParentNotFoundErrMsg    string
-	RetryCreateOnNotFound   string
-	TagInCustomVal          string
-	TagInIDElem             string
-	TagInIDNeedSlice        string
-	TagInTagsElem           string
-	TagKeyType              string
-	TagOp                   string
-	TagOpBatchSize          string
-	TagPackage              string
-	TagResTypeElem          string
-	TagType                 string
-	TagType2                string
-	TagTypeAddBoolElem      string
-	TagTypeAddBoolElemSnake string
-	TagTypeIDElem           string
-	TagTypeKeyElem          string
-	TagTypeValElem          string
-	UntagInCustomVal        string
-	UntagInNeedTagKeyType   string
-	UntagInNeedTagType      string
-	UntagInTagsElem         string
-	UntagOp                 string
+	CreateTagsInTagSpecs          string
+	CreateTagsUnsupportedErrCodes []string
+	CreateTagsUnsupportedErrMsg   string
+	GetTag                        string
+	ListTagsInFiltIDName          string
+	ListTagsInIDElem              string
+	ListTagsInIDNeedSlice         string
+	ListTagsOp                    string
+	ListTagsOutTagsElem           string
+	ParentNotFoundErrCode         string
+	ParentNotFoundErrMsg          string
+	RetryCreateOnNotFound         string
+	ServiceTagsMap                string
+	TagInCustomVal                string
+	TagInIDElem                   string
+	TagInIDNeedSlice              string
+	TagInTagsElem                 string
+	TagKeyType                    string
+	TagOp                         string
+	TagOpBatchSize                string
+	TagPackage                    string
+	TagResTypeElem                string
+	TagType                       string
+	TagType2                      string
+	TagTypeAddBoolElem            string
+	TagTypeAddBoolElemSnake       string
+	TagTypeIDElem                 string
+	TagTypeKeyElem                string
+	TagTypeValElem                string
+	UntagInCustomVal              string
+	UntagInNeedTagKeyType         string
+	UntagInNeedTagType            string
+	UntagInTagsElem               string
+	UntagOp                       string
 }
 
 func main() {
@@ -127,37 +136,49 @@ func main() {
 		ClientType:     clientType,
 		ServicePackage: servicePackage,
 
-		ListTagsInFiltIDName:    *listTagsInFiltIDName,
-		ListTagsInIDElem:        *listTagsInIDElem,
-		ListTagsInIDNeedSlice:   *listTagsInIDNeedSlice,
-		ListTagsOp:              *listTagsOp,
-		ListTagsOutTagsElem:     *listTagsOutTagsElem,
-		ParentNotFoundErrCode:   *parentNotFoundErrCode,
-		ParentNotFoundErrMsg:    *parentNotFoundErrMsg,
-		TagInCustomVal:          *tagInCustomVal,
-		TagInIDElem:             *tagInIDElem,
-		TagInIDNeedSlice:        *tagInIDNeedSlice,
-		TagInTagsElem:           *tagInTagsElem,
-		TagKeyType:              *tagKeyType,
-		TagOp:                   *tagOp,
-		TagOpBatchSize:          *tagOpBatchSize,
-		TagPackage:              tagPackage,
-		TagResTypeElem:          *tagResTypeElem,
-		TagType:                 *tagType,
-		TagType2:                *tagType2,
-		TagTypeAddBoolElem:      *TagTypeAddBoolElem,
-		TagTypeAddBoolElemSnake: ToSnakeCase(*TagTypeAddBoolElem),
-		TagTypeIDElem:           *tagTypeIDElem,
-		TagTypeKeyElem:          *tagTypeKeyElem,
-		TagTypeValElem:          *tagTypeValElem,
-		UntagInCustomVal:        *untagInCustomVal,
-		UntagInNeedTagKeyType:   *untagInNeedTagKeyType,
-		UntagInNeedTagType:      *untagInNeedTagType,
-		UntagInTagsElem:         *untagInTagsElem,
-		UntagOp:                 *untagOp,
+		CreateTagsInTagSpecs:        *createTagsInTagSpecs,
+		CreateTagsUnsupportedErrMsg: *createTagsUnsupportedErrMsg,
+		GetTag:                      *getTag,
+		ListTagsInFiltIDName:        *listTagsInFiltIDName,
+		ListTagsInIDElem:            *listTagsInIDElem,
+		ListTagsInIDNeedSlice:       *listTagsInIDNeedSlice,
+		ListTagsOp:                  *listTagsOp,
+		ListTagsOutTagsElem:         *listTagsOutTagsElem,
+		ParentNotFoundErrCode:       *parentNotFoundErrCode,
+		ParentNotFoundErrMsg:        *parentNotFoundErrMsg,
+		ServiceTagsMap:              *serviceTagsMap,
+		TagInCustomVal:              *tagInCustomVal,
+		TagInIDElem:                 *tagInIDElem,
+		TagInIDNeedSlice:            *tagInIDNeedSlice,
+		TagInTagsElem:               *tagInTagsElem,
+		TagKeyType:                  *tagKeyType,
+		TagOp:                       *tagOp,
+		TagOpBatchSize:              *tagOpBatchSize,
+		TagPackage:                  tagPackage,
+		TagResTypeElem:              *tagResTypeElem,
+		TagType:                     *tagType,
+		TagType2:                    *tagType2,
+		TagTypeAddBoolElem:          *TagTypeAddBoolElem,
+		TagTypeAddBoolElemSnake:     ToSnakeCase(*TagTypeAddBoolElem),
+		TagTypeIDElem:               *tagTypeIDElem,
+		TagTypeKeyElem:              *tagTypeKeyElem,
+		TagTypeValElem:              *tagTypeValElem,
+		UntagInCustomVal:            *untagInCustomVal,
+		UntagInNeedTagKeyType:       *untagInNeedTagKeyType,
+		UntagInNeedTagType:          *untagInNeedTagType,
+		UntagInTagsElem:             *untagInTagsElem,
+		UntagOp:                     *untagOp,
 	}
 
-	if *getTag != "" || *listTags != "" || *serviceTagsMap != "" || *serviceTagsSlice != "" || *updateTags != "" {
+	if *createTagsUnsupportedErrCodes != "" {
+		templateData.CreateTagsUnsupportedErrCodes = strings.Split(*createTagsUnsupportedErrCodes, ",")
+	}
+
+	if *createTags != "" && *serviceTagsMap == "" && *serviceTagsSlice == "" {
+		log.Fatal("CreateTags requires ServiceTagsMap or ServiceTagsSlice")
+	}
+
+	if *createTags != "" || *getTag != "" || *listTags != "" || *serviceTagsMap != "" || *serviceTagsSlice != "" || *updateTags != "" {
 		writeTemplate(headerBody, "header", templateData)
 	}
 
@@ -180,6 +201,10 @@ func main() {
 	if *updateTags != "" {
 		writeTemplate(updatetagsBody, "updatetags", templateData)
 	}
+
+	if *createTags != "" {
+		writeTemplate(createtagsBody, "createtags", templateData)
+	}
 }
 
 func writeTemplate(body string, templateName string, td TemplateData) {
@@ -223,13 +248,20 @@ import (
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
+	{{- if .CreateTagsUnsupportedErrCodes }}
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	{{- end }}
 	"github.com/aws/aws-sdk-go/service/{{ .AWSService }}"
-	{{- if .ParentNotFoundErrCode }}
+	{{- if or ( .ParentNotFoundErrCode ) ( .CreateTagsUnsupportedErrCodes ) }}
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	{{- end }}
+	{{- if .ParentNotFoundErrCode }}
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	{{- end }}
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
-    "github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	{{- if .GetTag }}
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	{{- end }}
 )
 
 `
@@ -810,7 +842,7 @@ func ToSnakeCase(str string) string {
 	return strings.ToLower(result)
 }
 
-//awsServiceNames provides correct names and capitalization as used by AWS in client var
+// awsServiceNames provides correct names and capitalization as used by AWS in client var
 var awsServiceNames map[string]string
 
 func init() {
@@ -976,7 +1008,7 @@ func init() {
 	awsServiceNames["lexruntimev2"] = "LexRuntimeV2"
 	awsServiceNames["licensemanager"] = "LicenseManager"
 	awsServiceNames["lightsail"] = "Lightsail"
-	awsServiceNames["location"] = "Location"
+	awsServiceNames["locationservice"] = "LocationService"
 	awsServiceNames["lookoutequipment"] = "LookoutEquipment"
 	awsServiceNames["lookoutforvision"] = "LookoutForVision"
 	awsServiceNames["lookoutmetrics"] = "LookoutMetrics"
@@ -1100,3 +1132,54 @@ func init() {
 	awsServiceNames["workspaces"] = "WorkSpaces"
 	awsServiceNames["xray"] = "XRay"
 }
+
+var createtagsBody = `
+{{- if .CreateTagsInTagSpecs }}
+// TagSpecificationsOnCreate returns the {{ .ServicePackage }} tag specifications used to tag a new resource of the given type in its create request.
+// Returns nil if there are no tags, so that no empty tag specification is sent.
+func TagSpecificationsOnCreate(tags tftags.KeyValueTags, resourceType string) []*{{ .TagPackage }}.TagSpecification {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	return []*{{ .TagPackage }}.TagSpecification{
+		{
+			ResourceType: aws.String(resourceType),
+			Tags:         Tags(tags.IgnoreAWS()),
+		},
+	}
+}
+{{- else }}
+// TagsOnCreate returns the {{ .ServicePackage }} service tags used to tag a new resource in its create request.
+// Returns nil if there are no tags, so that APIs without tag-on-create support are not sent an empty tags field.
+{{- if .ServiceTagsMap }}
+func TagsOnCreate(tags tftags.KeyValueTags) map[string]*string {
+{{- else }}
+func TagsOnCreate(tags tftags.KeyValueTags) []*{{ .TagPackage }}.{{ .TagType }} {
+{{- end }}
+	if tags = tags.IgnoreAWS(); len(tags) == 0 {
+		return nil
+	}
+
+	return Tags(tags)
+}
+{{- end }}
+{{- if .CreateTagsUnsupportedErrCodes }}
+
+// TagOnCreateUnsupported returns whether the error indicates that a create request was rejected by {{ .ServicePackage }} because it included tags.
+// Tag-on-create is supported in the standard AWS partition, so errors there are never treated as such; elsewhere, for example
+// in ISO partitions, callers should retry the create request without tags and then tag the new resource using UpdateTags.
+func TagOnCreateUnsupported(partition string, err error) bool {
+	if partition == endpoints.AwsPartitionID {
+		return false
+	}
+	{{- if .CreateTagsUnsupportedErrMsg }}
+
+	return {{ range $i, $code := .CreateTagsUnsupportedErrCodes }}{{ if $i }} || {{ end }}tfawserr.ErrMessageContains(err, "{{ $code }}", "{{ $.CreateTagsUnsupportedErrMsg }}"){{ end }}
+	{{- else }}
+
+	return tfawserr.ErrCodeEquals(err{{ range .CreateTagsUnsupportedErrCodes }}, "{{ . }}"{{ end }})
+	{{- end }}
+}
+{{- end }}
+`
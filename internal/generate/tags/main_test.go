@@ -0,0 +1,104 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+// TestCreateTags compares the output of -CreateTags with the golden files in testdata.
+func TestCreateTags(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping generator test in short mode")
+	}
+
+	generator, err := filepath.Abs("main.go")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := []struct {
+		Name           string
+		ServicePackage string
+		Args           []string
+	}{
+		{
+			Name:           "service_tags_map",
+			ServicePackage: "batch",
+			Args:           []string{"-CreateTags=yes", "-ServiceTagsMap=yes"},
+		},
+		{
+			Name:           "service_tags_slice",
+			ServicePackage: "ecs",
+			Args:           []string{"-CreateTags=yes", "-ServiceTagsSlice=yes"},
+		},
+		{
+			Name:           "tag_specifications",
+			ServicePackage: "ec2",
+			Args:           []string{"-CreateTags=yes", "-CreateTagsInTagSpecs=yes", "-ServiceTagsSlice=yes"},
+		},
+		{
+			Name:           "unsupported_err_codes",
+			ServicePackage: "ecs",
+			Args:           []string{"-CreateTags=yes", "-CreateTagsUnsupportedErrCodes=AccessDeniedException,InvalidParameterException", "-ServiceTagsSlice=yes"},
+		},
+		{
+			Name:           "unsupported_err_msg",
+			ServicePackage: "ecs",
+			Args:           []string{"-CreateTags=yes", "-CreateTagsUnsupportedErrCodes=InvalidParameterException", "-CreateTagsUnsupportedErrMsg=tagging is not supported", "-ServiceTagsSlice=yes"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "tags")
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			defer os.RemoveAll(dir)
+
+			cmd := exec.Command("go", append([]string{"run", generator}, testCase.Args...)...)
+			cmd.Dir = dir
+			cmd.Env = append(os.Environ(), "GOPACKAGE="+testCase.ServicePackage)
+
+			if output, err := cmd.CombinedOutput(); err != nil {
+				t.Fatalf("error running generator: %s\n%s", err, output)
+			}
+
+			got, err := ioutil.ReadFile(filepath.Join(dir, "tags_gen.go"))
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			golden := filepath.Join("testdata", testCase.Name+".golden")
+
+			if *update {
+				if err := ioutil.WriteFile(golden, got, 0644); err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			}
+
+			expected, err := ioutil.ReadFile(golden)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !bytes.Equal(got, expected) {
+				t.Errorf("generated tags_gen.go does not match %s, got:\n%s", golden, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,410 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"log"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+const (
+	filename = "waiter_gen.go"
+
+	directivePrefix       = "//waiter:"
+	directiveStatus       = "status"
+	directiveWait         = "wait"
+	waiterPackage         = "github.com/hashicorp/terraform-provider-aws/internal/waiter"
+	contextPackage        = "context"
+	awsPackage            = "github.com/aws/aws-sdk-go/aws"
+	fmtPackage            = "fmt"
+	resourcePackage       = "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	timePackage           = "time"
+	defaultStatusArgument = "Status"
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go\n\n")
+	fmt.Fprintf(os.Stderr, "Generates status and wait functions from //waiter: directives on finder functions in the current directory.\n")
+}
+
+type Param struct {
+	Name string
+	Type string
+}
+
+type StatusFunc struct {
+	Name        string
+	Finder      string
+	FinderCtx   bool
+	Params      []Param
+	OutputType  string
+	StatusField string
+}
+
+type WaitFunc struct {
+	Name        string
+	Status      *StatusFunc
+	Description string
+	Pending     []string
+	Target      []string
+}
+
+type TemplateData struct {
+	Parameters     string
+	ServicePackage string
+	StdImports     []string
+	Imports        []string
+	StatusFuncs    []*StatusFunc
+	WaitFuncs      []*WaitFunc
+}
+
+func main() {
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(fi os.FileInfo) bool {
+		name := fi.Name()
+		return !strings.HasSuffix(name, "_test.go") && !strings.HasSuffix(name, "_gen.go")
+	}, parser.ParseComments)
+
+	if err != nil {
+		log.Fatalf("error parsing package: %s", err)
+	}
+
+	if len(pkgs) != 1 {
+		log.Fatalf("error: %d packages found", len(pkgs))
+	}
+
+	var pkg *ast.Package
+	for _, v := range pkgs {
+		pkg = v
+	}
+
+	templateData := TemplateData{
+		Parameters:     strings.Join(os.Args[1:], " "),
+		ServicePackage: pkg.Name,
+	}
+
+	imports := map[string]bool{
+		contextPackage:  true,
+		resourcePackage: true,
+		waiterPackage:   true,
+	}
+
+	statusFuncs := make(map[string]*StatusFunc)
+	var waitDirectives []map[string]string
+
+	fileNames := make([]string, 0, len(pkg.Files))
+	for name := range pkg.Files {
+		fileNames = append(fileNames, name)
+	}
+	sort.Strings(fileNames)
+
+	for _, name := range fileNames {
+		file := pkg.Files[name]
+
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+
+			if !ok || funcDecl.Doc == nil || funcDecl.Recv != nil {
+				continue
+			}
+
+			for _, comment := range funcDecl.Doc.List {
+				if !strings.HasPrefix(comment.Text, directivePrefix) {
+					continue
+				}
+
+				kind, args := parseDirective(comment.Text)
+
+				switch kind {
+				case directiveStatus:
+					statusFunc := newStatusFunc(funcDecl, args)
+					statusFuncs[statusFunc.Name] = statusFunc
+					templateData.StatusFuncs = append(templateData.StatusFuncs, statusFunc)
+
+					for _, pkgName := range packagesReferenced(funcDecl) {
+						importPath, ok := fileImport(file, pkgName)
+
+						if !ok {
+							log.Fatalf("%s: unable to find import for package %s", fset.Position(comment.Pos()), pkgName)
+						}
+
+						imports[importPath] = true
+					}
+
+					if statusFunc.StatusField != "" {
+						imports[awsPackage] = true
+					}
+				case directiveWait:
+					waitDirectives = append(waitDirectives, args)
+				default:
+					log.Fatalf("%s: unknown directive %q", fset.Position(comment.Pos()), kind)
+				}
+			}
+		}
+	}
+
+	for _, args := range waitDirectives {
+		statusFunc, ok := statusFuncs[args[defaultStatusArgument]]
+
+		if !ok {
+			log.Fatalf("wait function %s: status function %q not found", args["Name"], args[defaultStatusArgument])
+		}
+
+		waitFunc := &WaitFunc{
+			Name:    requiredArgument(args, "Name"),
+			Status:  statusFunc,
+			Pending: splitList(args["Pending"]),
+			Target:  splitList(args["Target"]),
+		}
+
+		if len(waitFunc.Pending) == 0 {
+			log.Fatalf("wait function %s: Pending is required", waitFunc.Name)
+		}
+
+		if len(statusFunc.Params) > 1 {
+			waitFunc.Description = fmt.Sprintf("%s (%%v)", statusFunc.Name)
+			imports[fmtPackage] = true
+		} else {
+			waitFunc.Description = statusFunc.Name
+		}
+
+		imports[timePackage] = true
+		templateData.WaitFuncs = append(templateData.WaitFuncs, waitFunc)
+	}
+
+	if len(templateData.StatusFuncs) == 0 {
+		log.Fatalf("no %s%s directives found", directivePrefix, directiveStatus)
+	}
+
+	for importPath := range imports {
+		if strings.Contains(strings.Split(importPath, "/")[0], ".") {
+			templateData.Imports = append(templateData.Imports, importPath)
+		} else {
+			templateData.StdImports = append(templateData.StdImports, importPath)
+		}
+	}
+	sort.Strings(templateData.StdImports)
+	sort.Strings(templateData.Imports)
+
+	tmpl := template.Must(template.New("waiters").Funcs(template.FuncMap{
+		"Quote": strconv.Quote,
+	}).Parse(fileTemplate))
+
+	var buffer bytes.Buffer
+	if err := tmpl.Execute(&buffer, templateData); err != nil {
+		log.Fatalf("error executing template: %s", err)
+	}
+
+	src, err := format.Source(buffer.Bytes())
+	if err != nil {
+		log.Printf("warning: internal error: invalid Go generated: %s", err)
+		log.Printf("warning: compile the package to analyze the error")
+		src = buffer.Bytes()
+	}
+
+	if err := os.WriteFile(filename, src, 0644); err != nil {
+		log.Fatalf("error writing output: %s", err)
+	}
+}
+
+// parseDirective parses a directive such as "//waiter:wait Name=ThingDeleted Pending=A,B" into its kind and arguments.
+func parseDirective(text string) (string, map[string]string) {
+	fields := strings.Fields(strings.TrimPrefix(text, directivePrefix))
+	args := make(map[string]string)
+
+	if len(fields) == 0 {
+		return "", args
+	}
+
+	for _, field := range fields[1:] {
+		parts := strings.SplitN(field, "=", 2)
+
+		if len(parts) != 2 {
+			log.Fatalf("invalid directive argument %q in %q", field, text)
+		}
+
+		args[parts[0]] = parts[1]
+	}
+
+	return fields[0], args
+}
+
+func requiredArgument(args map[string]string, key string) string {
+	v, ok := args[key]
+
+	if !ok || v == "" {
+		log.Fatalf("directive argument %s is required", key)
+	}
+
+	return v
+}
+
+func splitList(s string) []string {
+	var values []string
+
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+
+	return values
+}
+
+func newStatusFunc(funcDecl *ast.FuncDecl, args map[string]string) *StatusFunc {
+	statusFunc := &StatusFunc{
+		Name:        requiredArgument(args, "Name"),
+		Finder:      funcDecl.Name.Name,
+		StatusField: requiredArgument(args, defaultStatusArgument),
+	}
+
+	results := funcDecl.Type.Results
+
+	if results == nil || len(results.List) != 2 {
+		log.Fatalf("finder %s must return (output, error)", funcDecl.Name.Name)
+	}
+
+	statusFunc.OutputType = types.ExprString(results.List[0].Type)
+
+	for i, field := range funcDecl.Type.Params.List {
+		typ := types.ExprString(field.Type)
+
+		if i == 0 && typ == "context.Context" {
+			statusFunc.FinderCtx = true
+			continue
+		}
+
+		for _, name := range field.Names {
+			statusFunc.Params = append(statusFunc.Params, Param{Name: name.Name, Type: typ})
+		}
+	}
+
+	return statusFunc
+}
+
+// packagesReferenced returns the names of packages referenced in the types of the function's
+// parameters and results, excluding the context package.
+func packagesReferenced(funcDecl *ast.FuncDecl) []string {
+	names := make(map[string]bool)
+
+	for _, fieldList := range []*ast.FieldList{funcDecl.Type.Params, funcDecl.Type.Results} {
+		if fieldList == nil {
+			continue
+		}
+
+		for _, field := range fieldList.List {
+			ast.Inspect(field.Type, func(n ast.Node) bool {
+				if selector, ok := n.(*ast.SelectorExpr); ok {
+					if ident, ok := selector.X.(*ast.Ident); ok && ident.Name != "context" {
+						names[ident.Name] = true
+					}
+				}
+
+				return true
+			})
+		}
+	}
+
+	var result []string
+	for name := range names {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+
+	return result
+}
+
+func fileImport(file *ast.File, pkgName string) (string, bool) {
+	for _, spec := range file.Imports {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+
+		if err != nil {
+			continue
+		}
+
+		if spec.Name != nil {
+			if spec.Name.Name == pkgName {
+				return importPath, true
+			}
+
+			continue
+		}
+
+		if path.Base(importPath) == pkgName {
+			return importPath, true
+		}
+	}
+
+	return "", false
+}
+
+const fileTemplate = `// Code generated by "internal/generate/waiters/main.go{{ if .Parameters }} {{ .Parameters }}{{ end }}"; DO NOT EDIT.
+
+package {{ .ServicePackage }}
+
+import (
+{{- range .StdImports }}
+	{{ Quote . }}
+{{- end }}
+{{ range .Imports }}
+	{{ Quote . }}
+{{- end }}
+)
+{{ range .StatusFuncs }}
+func status{{ .Name }}(ctx context.Context{{ range .Params }}, {{ .Name }} {{ .Type }}{{ end }}) resource.StateRefreshFunc {
+	return waiter.Status(ctx, func(ctx context.Context) (interface{}, error) {
+		output, err := {{ .Finder }}({{ if .FinderCtx }}ctx{{ range .Params }}, {{ .Name }}{{ end }}{{ else }}{{ range $i, $p := .Params }}{{ if $i }}, {{ end }}{{ $p.Name }}{{ end }}{{ end }})
+
+		if output == nil {
+			return nil, err
+		}
+
+		return output, err
+	}, func(output interface{}) string {
+		return aws.StringValue(output.({{ .OutputType }}).{{ .StatusField }})
+	})
+}
+{{ end }}
+{{- range .WaitFuncs }}
+func wait{{ .Name }}(ctx context.Context{{ range .Status.Params }}, {{ .Name }} {{ .Type }}{{ end }}, timeout time.Duration) ({{ .Status.OutputType }}, error) {
+	outputRaw, err := waiter.{{ if .Target }}UntilState{{ else }}UntilDeleted{{ end }}(ctx, &waiter.Config{
+		Pending: []string{ {{- range $i, $v := .Pending }}{{ if $i }}, {{ end }}{{ $v }}{{ end -}} },
+{{- if .Target }}
+		Target:  []string{ {{- range $i, $v := .Target }}{{ if $i }}, {{ end }}{{ $v }}{{ end -}} },
+{{- end }}
+		Refresh: status{{ .Status.Name }}(ctx{{ range .Status.Params }}, {{ .Name }}{{ end }}),
+		Timeout: timeout,
+{{- if gt (len .Status.Params) 1 }}
+		Description: fmt.Sprintf({{ Quote .Description }}, {{ (index .Status.Params 1).Name }}),
+{{- else }}
+		Description: {{ Quote .Description }},
+{{- end }}
+	})
+
+	if output, ok := outputRaw.({{ .Status.OutputType }}); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+{{ end -}}
+`
@@ -0,0 +1,111 @@
+package nullable
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	TypeNullableDuration = schema.TypeString
+)
+
+// Duration is a duration in the format accepted by time.ParseDuration, e.g. "1h30m", or null.
+type Duration string
+
+func (d Duration) IsNull() bool {
+	return d == ""
+}
+
+func (d Duration) Value() (time.Duration, bool, error) {
+	if d.IsNull() {
+		return 0, true, nil
+	}
+
+	value, err := time.ParseDuration(string(d))
+	if err != nil {
+		return 0, false, err
+	}
+	return value, false, nil
+}
+
+func NewDuration(v time.Duration) Duration {
+	return Duration(v.String())
+}
+
+// ValidateTypeStringNullableDuration provides custom error messaging for TypeString durations
+// Some arguments require a duration value or unspecified, empty field.
+func ValidateTypeStringNullableDuration(v interface{}, k string) (ws []string, es []error) {
+	value, ok := v.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if value == "" {
+		return
+	}
+
+	if _, err := time.ParseDuration(value); err != nil {
+		es = append(es, fmt.Errorf("%s: cannot parse '%s' as duration: %w", k, value, err))
+	}
+
+	return
+}
+
+// ValidateTypeStringNullableDurationAtLeast provides custom error messaging for TypeString durations
+// Some arguments require a duration value or unspecified, empty field.
+func ValidateTypeStringNullableDurationAtLeast(min time.Duration) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (ws []string, es []error) {
+		value, ok := i.(string)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		if value == "" {
+			return
+		}
+
+		v, err := time.ParseDuration(value)
+		if err != nil {
+			es = append(es, fmt.Errorf("%s: cannot parse '%s' as duration: %w", k, value, err))
+			return
+		}
+
+		if v < min {
+			es = append(es, fmt.Errorf("expected %s to be at least (%s), got %s", k, min, v))
+		}
+
+		return
+	}
+}
+
+// ValidateTypeStringNullableDurationBetween provides custom error messaging for TypeString durations
+// Some arguments require a duration value or unspecified, empty field.
+func ValidateTypeStringNullableDurationBetween(min time.Duration, max time.Duration) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (ws []string, es []error) {
+		value, ok := i.(string)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		if value == "" {
+			return
+		}
+
+		v, err := time.ParseDuration(value)
+		if err != nil {
+			es = append(es, fmt.Errorf("%s: cannot parse '%s' as duration: %w", k, value, err))
+			return
+		}
+
+		if v < min || v > max {
+			es = append(es, fmt.Errorf("expected %s to be at between (%s) and (%s), got %s", k, min, max, v))
+		}
+
+		return
+	}
+}
@@ -0,0 +1,113 @@
+package nullable
+
+import (
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestNullableDuration(t *testing.T) {
+	cases := []struct {
+		val           string
+		expectNull    bool
+		expectedValue time.Duration
+		expectErr     bool
+	}{
+		{
+			val:           "1h30m",
+			expectNull:    false,
+			expectedValue: 90 * time.Minute,
+		},
+		{
+			val:           "0s",
+			expectNull:    false,
+			expectedValue: 0,
+		},
+		{
+			val:           "",
+			expectNull:    true,
+			expectedValue: 0,
+		},
+		{
+			val:           "A",
+			expectNull:    false,
+			expectedValue: 0,
+			expectErr:     true,
+		},
+	}
+
+	for i, tc := range cases {
+		v := Duration(tc.val)
+
+		if null := v.IsNull(); null != tc.expectNull {
+			t.Fatalf("expected test case %d IsNull to return %t, got %t", i, null, tc.expectNull)
+		}
+
+		value, null, err := v.Value()
+		if value != tc.expectedValue {
+			t.Fatalf("expected test case %d Value to be %s, got %s", i, tc.expectedValue, value)
+		}
+		if null != tc.expectNull {
+			t.Fatalf("expected test case %d Value null flag to be %t, got %t", i, tc.expectNull, null)
+		}
+		if !tc.expectErr && err != nil {
+			t.Fatalf("expected test case %d to succeed, got error %s", i, err)
+		}
+		if tc.expectErr && err == nil {
+			t.Fatalf("expected test case %d to fail", i)
+		}
+	}
+}
+
+func TestNewDuration(t *testing.T) {
+	if got, expected := NewDuration(90*time.Second), Duration("1m30s"); got != expected {
+		t.Fatalf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestValidationDuration(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "30s",
+			f:   ValidateTypeStringNullableDuration,
+		},
+		{
+			val:         "A",
+			f:           ValidateTypeStringNullableDuration,
+			expectedErr: regexp.MustCompile(`[\w]+: cannot parse 'A' as duration: .*`),
+		},
+		{
+			val:         30,
+			f:           ValidateTypeStringNullableDuration,
+			expectedErr: regexp.MustCompile(`expected type of [\w]+ to be string`),
+		},
+	})
+}
+
+func TestValidationDurationAtLeast(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "1m",
+			f:   ValidateTypeStringNullableDurationAtLeast(time.Minute),
+		},
+		{
+			val:         "59s",
+			f:           ValidateTypeStringNullableDurationAtLeast(time.Minute),
+			expectedErr: regexp.MustCompile(`expected [\w]+ to be at least \(1m0s\), got 59s`),
+		},
+	})
+}
+
+func TestValidationDurationBetween(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "10s",
+			f:   ValidateTypeStringNullableDurationBetween(10*time.Second, time.Minute),
+		},
+		{
+			val:         "61s",
+			f:           ValidateTypeStringNullableDurationBetween(10*time.Second, time.Minute),
+			expectedErr: regexp.MustCompile(`expected [\w]+ to be at between \(10s\) and \(1m0s\), got 1m1s`),
+		},
+	})
+}
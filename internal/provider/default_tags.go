@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// applyDefaultTagsRules wraps the functions of resource types which use the provider's default_tags
+// to pass them a client with the default tags of the resource type, after applying any default_tags rules.
+func applyDefaultTagsRules(resources map[string]*schema.Resource) {
+	for typeName, r := range resources {
+		if strategy, ok := tftags.ResourceTaggingStrategy(typeName); !ok || strategy == tftags.TaggingStrategyNotTags {
+			continue
+		}
+
+		typeName := typeName
+		meta := func(meta interface{}) interface{} {
+			if client, ok := meta.(*conns.AWSClient); ok {
+				return client.ForResourceType(typeName)
+			}
+
+			return meta
+		}
+
+		if f := r.Create; f != nil {
+			r.Create = func(d *schema.ResourceData, m interface{}) error {
+				return f(d, meta(m))
+			}
+		}
+
+		if f := r.CreateContext; f != nil {
+			r.CreateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+				return f(ctx, d, meta(m))
+			}
+		}
+
+		if f := r.CreateWithoutTimeout; f != nil {
+			r.CreateWithoutTimeout = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+				return f(ctx, d, meta(m))
+			}
+		}
+
+		if f := r.Read; f != nil {
+			r.Read = func(d *schema.ResourceData, m interface{}) error {
+				return f(d, meta(m))
+			}
+		}
+
+		if f := r.ReadContext; f != nil {
+			r.ReadContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+				return f(ctx, d, meta(m))
+			}
+		}
+
+		if f := r.ReadWithoutTimeout; f != nil {
+			r.ReadWithoutTimeout = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+				return f(ctx, d, meta(m))
+			}
+		}
+
+		if f := r.Update; f != nil {
+			r.Update = func(d *schema.ResourceData, m interface{}) error {
+				return f(d, meta(m))
+			}
+		}
+
+		if f := r.UpdateContext; f != nil {
+			r.UpdateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+				return f(ctx, d, meta(m))
+			}
+		}
+
+		if f := r.UpdateWithoutTimeout; f != nil {
+			r.UpdateWithoutTimeout = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+				return f(ctx, d, meta(m))
+			}
+		}
+
+		if f := r.CustomizeDiff; f != nil {
+			r.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+				return f(ctx, d, meta(m))
+			}
+		}
+	}
+}
+
+// warnIgnoredDefaultTags wraps the create function of resource types which do not apply
+// the provider's default_tags to return a warning when default tags are configured.
+func warnIgnoredDefaultTags(resources map[string]*schema.Resource) {
+	for typeName, r := range resources {
+		strategy, ok := tftags.ResourceTaggingStrategy(typeName)
+
+		if !ok || !strategy.IgnoresDefaultTags() {
+			continue
+		}
+
+		warnIgnoredDefaultTagsOnCreate(typeName, strategy, r)
+	}
+}
+
+func warnIgnoredDefaultTagsOnCreate(typeName string, strategy tftags.TaggingStrategy, r *schema.Resource) {
+	var create schema.CreateContextFunc
+
+	switch {
+	case r.CreateContext != nil:
+		create = r.CreateContext
+	case r.CreateWithoutTimeout != nil:
+		create = r.CreateWithoutTimeout
+	case r.Create != nil:
+		createNoContext := r.Create
+		create = func(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return diag.FromErr(createNoContext(d, meta))
+		}
+	default:
+		return
+	}
+
+	wrapped := func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		diags := create(ctx, d, meta)
+
+		if client, ok := meta.(*conns.AWSClient); ok && len(client.DefaultTagsConfig.GetTags()) > 0 {
+			diags = append(diags, ignoredDefaultTagsDiagnostic(typeName, strategy))
+		}
+
+		return diags
+	}
+
+	// Keep the resource's timeout behavior.
+	if r.CreateWithoutTimeout != nil {
+		r.CreateWithoutTimeout = wrapped
+	} else {
+		r.Create = nil
+		r.CreateContext = wrapped
+	}
+}
+
+func ignoredDefaultTagsDiagnostic(typeName string, strategy tftags.TaggingStrategy) diag.Diagnostic {
+	detail := fmt.Sprintf("%s does not apply the provider's default_tags. Configure the tags on the resource directly.", typeName)
+
+	if strategy == tftags.TaggingStrategyTagResource {
+		detail = fmt.Sprintf("%s manages individual tags of a resource managed elsewhere and does not apply the provider's default_tags. "+
+			"Resources which are not managed by this provider do not receive the default tags.", typeName)
+	}
+
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  "Provider default_tags not applied",
+		Detail:   detail,
+	}
+}
+
+// validDefaultTagsRuleResourceType validates that a default_tags rule's resource type is a taggable resource type.
+// Resource types which do not apply the provider's default_tags are allowed with a warning, as the rule has no effect on them.
+func validDefaultTagsRuleResourceType(v interface{}, k string) (ws []string, errors []error) {
+	typeName, ok := v.(string)
+
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	strategy, ok := tftags.ResourceTaggingStrategy(typeName)
+
+	if !ok {
+		errors = append(errors, fmt.Errorf("%s: %q is not a taggable resource type", k, typeName))
+		return
+	}
+
+	if strategy != tftags.TaggingStrategyDefaultTags {
+		ws = append(ws, fmt.Sprintf("%s: %s does not apply the provider's default_tags (tagging strategy: %s)", k, typeName, strategy))
+	}
+
+	return
+}
@@ -0,0 +1,251 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestResourceTaggingStrategies(t *testing.T) {
+	p := Provider()
+
+	for typeName, r := range p.ResourcesMap {
+		_, hasTags := r.Schema["tags"]
+		_, hasTagsAll := r.Schema["tags_all"]
+		strategy, ok := tftags.ResourceTaggingStrategy(typeName)
+
+		if !ok {
+			if hasTags || hasTagsAll || strings.HasSuffix(typeName, "_tag") {
+				t.Errorf("%s: tagging strategy not registered in internal/tags", typeName)
+			}
+
+			continue
+		}
+
+		if strategy != tftags.TaggingStrategyDefaultTags {
+			continue
+		}
+
+		if !hasTags || !hasTagsAll {
+			t.Errorf("%s: %s tagging strategy requires tags and tags_all arguments", typeName, strategy)
+		}
+
+		if r.CustomizeDiff == nil {
+			t.Errorf("%s: %s tagging strategy requires CustomizeDiff with verify.SetTagsDiff", typeName, strategy)
+		}
+	}
+
+	for _, typeName := range tftags.TaggableResourceTypes() {
+		if _, ok := p.ResourcesMap[typeName]; !ok {
+			t.Errorf("%s: tagging strategy registered for unknown resource type", typeName)
+		}
+	}
+}
+
+func TestApplyDefaultTagsRules(t *testing.T) {
+	var got tftags.KeyValueTags
+	r := &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			got = meta.(*conns.AWSClient).DefaultTagsConfig.GetTags()
+			return nil
+		},
+	}
+
+	applyDefaultTagsRules(map[string]*schema.Resource{"aws_ebs_volume": r})
+
+	client := &conns.AWSClient{
+		DefaultTagsConfig: &tftags.DefaultConfig{
+			Tags: tftags.New(map[string]interface{}{"CostCenter": "1234"}),
+			Rules: []*tftags.DefaultRule{
+				{
+					ResourceTypes: []string{"aws_ebs_volume"},
+					Tags:          tftags.New(map[string]interface{}{"Backup": "true"}),
+				},
+				{
+					ResourceTypes:  []string{"aws_db_instance"},
+					ExcludeAllTags: true,
+				},
+			},
+		},
+	}
+
+	if err := r.Create(nil, client); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := tftags.New(map[string]interface{}{"Backup": "true", "CostCenter": "1234"}); !got.Equal(expected) {
+		t.Errorf("got default tags %s, expected %s", got, expected)
+	}
+
+	if got, expected := len(client.DefaultTagsConfig.Rules), 2; got != expected {
+		t.Errorf("got %d provider default tags rules, expected %d", got, expected)
+	}
+}
+
+func TestWarnIgnoredDefaultTags(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		TypeName         string
+		DefaultTags      map[string]interface{}
+		ExpectedWarnings int
+	}{
+		{
+			Name:             "tag resource with default tags",
+			TypeName:         "aws_ec2_tag",
+			DefaultTags:      map[string]interface{}{"CostCenter": "1234"},
+			ExpectedWarnings: 1,
+		},
+		{
+			Name:             "unsupported with default tags",
+			TypeName:         "aws_autoscaling_group",
+			DefaultTags:      map[string]interface{}{"CostCenter": "1234"},
+			ExpectedWarnings: 1,
+		},
+		{
+			Name:     "tag resource without default tags",
+			TypeName: "aws_ec2_tag",
+		},
+		{
+			Name:        "default tags",
+			TypeName:    "aws_vpc",
+			DefaultTags: map[string]interface{}{"CostCenter": "1234"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			var created bool
+			r := &schema.Resource{
+				Create: func(d *schema.ResourceData, meta interface{}) error {
+					created = true
+					return nil
+				},
+			}
+
+			warnIgnoredDefaultTags(map[string]*schema.Resource{testCase.TypeName: r})
+
+			client := &conns.AWSClient{
+				DefaultTagsConfig: &tftags.DefaultConfig{Tags: tftags.New(testCase.DefaultTags)},
+			}
+
+			var diags diag.Diagnostics
+
+			if r.CreateContext != nil {
+				diags = r.CreateContext(context.Background(), nil, client)
+			} else {
+				diags = diag.FromErr(r.Create(nil, client))
+			}
+
+			if !created {
+				t.Fatalf("resource not created")
+			}
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if got := len(diags); got != testCase.ExpectedWarnings {
+				t.Errorf("got %d warnings, expected %d: %v", got, testCase.ExpectedWarnings, diags)
+			}
+		})
+	}
+}
+
+func TestValidDefaultTagsRuleResourceType(t *testing.T) {
+	testCases := []struct {
+		Value           string
+		ExpectedError   string
+		ExpectedWarning string
+	}{
+		{
+			Value: "aws_ebs_volume",
+		},
+		{
+			Value:         "aws_ebs_volumes",
+			ExpectedError: `"aws_ebs_volumes" is not a taggable resource type`,
+		},
+		{
+			Value:         "aws_iam_policy_document",
+			ExpectedError: `"aws_iam_policy_document" is not a taggable resource type`,
+		},
+		{
+			Value:           "aws_ec2_tag",
+			ExpectedWarning: "aws_ec2_tag does not apply the provider's default_tags (tagging strategy: tag resource)",
+		},
+		{
+			Value:           "aws_autoscaling_group",
+			ExpectedWarning: "aws_autoscaling_group does not apply the provider's default_tags (tagging strategy: unsupported)",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Value, func(t *testing.T) {
+			warnings, errors := validDefaultTagsRuleResourceType(testCase.Value, "resource_types")
+
+			if testCase.ExpectedWarning == "" {
+				if len(warnings) > 0 {
+					t.Errorf("unexpected warnings: %v", warnings)
+				}
+			} else if len(warnings) != 1 || !strings.Contains(warnings[0], testCase.ExpectedWarning) {
+				t.Errorf("expected warning containing %q, got %v", testCase.ExpectedWarning, warnings)
+			}
+
+			if testCase.ExpectedError == "" {
+				if len(errors) > 0 {
+					t.Fatalf("unexpected errors: %v", errors)
+				}
+
+				return
+			}
+
+			if len(errors) != 1 || !strings.Contains(errors[0].Error(), testCase.ExpectedError) {
+				t.Fatalf("expected error containing %q, got %v", testCase.ExpectedError, errors)
+			}
+		})
+	}
+}
+
+func TestProviderDefaultTagsRuleResourceTypesValidation(t *testing.T) {
+	p := Provider()
+	raw := map[string]interface{}{
+		"region": "us-west-2", //lintignore:AWSAT003
+		"default_tags": []interface{}{
+			map[string]interface{}{
+				"rule": []interface{}{
+					map[string]interface{}{
+						"resource_types": []interface{}{"aws_ebs_volume", "aws_ebs_volumes"},
+						"tags":           map[string]interface{}{"team": "platform"},
+					},
+				},
+			},
+		},
+	}
+
+	diags := p.Validate(terraform.NewResourceConfigRaw(raw))
+
+	if !diags.HasError() {
+		t.Fatal("expected error, got none")
+	}
+
+	var found bool
+
+	for _, d := range diags {
+		if strings.Contains(d.Summary, `"aws_ebs_volumes" is not a taggable resource type`) {
+			found = true
+		}
+	}
+
+	if !found {
+		t.Errorf("expected error for aws_ebs_volumes, got %v", diags)
+	}
+}
@@ -1,7 +1,11 @@
 package provider
 
 import (
+	"fmt"
 	"log"
+	"os"
+	"strconv"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -49,6 +53,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/datapipeline"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/datasync"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/dax"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/detective"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/devicefarm"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/directconnect"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/dlm"
@@ -69,6 +74,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/elb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/elbv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/emr"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/emrcontainers"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/firehose"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/fms"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/fsx"
@@ -93,11 +99,13 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lexmodelbuilding"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/licensemanager"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/location"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/macie"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/macie2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediaconvert"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediapackage"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediastore"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/memorydb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mq"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mwaa"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/nas"
@@ -117,6 +125,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroups"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroupstagging"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/route53"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/route53domains"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/route53recoverycontrolconfig"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/route53recoveryreadiness"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/route53resolver"
@@ -186,6 +195,8 @@ func Provider() *schema.Provider {
 
 			"assume_role": assumeRoleSchema(),
 
+			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+
 			"shared_credentials_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -218,6 +229,56 @@ func Provider() *schema.Provider {
 				Description: descriptions["max_retries"],
 			},
 
+			"retry_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("AWS_RETRY_MODE", conns.RetryModeLegacy),
+				ValidateFunc: validation.StringInSlice(conns.RetryMode_Values(), false),
+				Description:  descriptions["retry_mode"],
+			},
+
+			"max_request_rates": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeFloat},
+				Description: descriptions["max_request_rates"],
+			},
+
+			"consistent_reads": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  descriptions["consistent_reads"],
+			},
+
+			"propagation_timeouts": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions["propagation_timeouts"],
+			},
+
+			"api_trace_logging": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				DefaultFunc: func() (interface{}, error) {
+					if v := os.Getenv("TF_AWS_API_TRACE_LOGGING"); v != "" {
+						return strconv.ParseBool(v)
+					}
+
+					return false, nil
+				},
+				Description: descriptions["api_trace_logging"],
+			},
+
+			"replay_fixtures": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("TF_AWS_REPLAY_FIXTURES", ""),
+				Description: descriptions["replay_fixtures"],
+			},
+
 			"allowed_account_ids": {
 				Type:          schema.TypeSet,
 				Elem:          &schema.Schema{Type: schema.TypeString},
@@ -234,6 +295,40 @@ func Provider() *schema.Provider {
 				Set:           schema.HashString,
 			},
 
+			"allowed_organizational_units": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: descriptions["allowed_organizational_units"],
+			},
+
+			"forbidden_organizational_units": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: descriptions["forbidden_organizational_units"],
+			},
+
+			"forbid_management_account": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: descriptions["forbid_management_account"],
+			},
+
+			"allowed_account_tags": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: descriptions["allowed_account_tags"],
+			},
+
+			"organization_guardrail_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+				Description:  descriptions["organization_guardrail_role_arn"],
+			},
+
 			"default_tags": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -247,6 +342,47 @@ func Provider() *schema.Provider {
 							Elem:        &schema.Schema{Type: schema.TypeString},
 							Description: "Resource tags to default across all resources",
 						},
+						"rule": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Rules customizing the default tags of particular resource types, applied in order.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"exclude_all_tags": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Description: "Exclude all default tags, including those added by previous rules.",
+									},
+									"exclude_key_prefixes": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Default tag key prefixes to exclude.",
+									},
+									"exclude_keys": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Default tag keys to exclude.",
+									},
+									"resource_types": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validDefaultTagsRuleResourceType,
+										},
+										Description: "Resource types the rule applies to, e.g. `aws_ebs_volume`. If omitted, the rule applies to all resource types.",
+									},
+									"tags": {
+										Type:        schema.TypeMap,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Resource tags to add after any exclusions.",
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -257,6 +393,28 @@ func Provider() *schema.Provider {
 				Description: descriptions["http_proxy"],
 			},
 
+			"custom_ca_bundle": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AWS_CA_BUNDLE", ""),
+				Description: descriptions["custom_ca_bundle"],
+			},
+
+			"client_certificate": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"client_private_key"},
+				Description:  descriptions["client_certificate"],
+			},
+
+			"client_private_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"client_certificate"},
+				Description:  descriptions["client_private_key"],
+			},
+
 			"endpoints": endpointsSchema(),
 
 			"ignore_tags": {
@@ -332,6 +490,20 @@ func Provider() *schema.Provider {
 				Default:     false,
 				Description: descriptions["s3_force_path_style"],
 			},
+
+			"use_dualstack_endpoint": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["use_dualstack_endpoint"],
+			},
+
+			"use_fips_endpoint": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["use_fips_endpoint"],
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -457,6 +629,7 @@ func Provider() *schema.Provider {
 			"aws_elb":                                        elb.DataSourceLoadBalancer(),
 			"aws_elb_hosted_zone_id":                         elb.DataSourceHostedZoneID(),
 			"aws_elb_service_account":                        elb.DataSourceServiceAccount(),
+			"aws_emrcontainers_virtual_cluster":              emrcontainers.DataSourceVirtualCluster(),
 			"aws_globalaccelerator_accelerator":              globalaccelerator.DataSourceAccelerator(),
 			"aws_glue_connection":                            glue.DataSourceConnection(),
 			"aws_glue_data_catalog_encryption_settings":      glue.DataSourceDataCatalogEncryptionSettings(),
@@ -510,6 +683,17 @@ func Provider() *schema.Provider {
 			"aws_lex_bot":                                    lexmodelbuilding.DataSourceBot(),
 			"aws_lex_intent":                                 lexmodelbuilding.DataSourceIntent(),
 			"aws_lex_slot_type":                              lexmodelbuilding.DataSourceSlotType(),
+			"aws_location_geofence_collection":               location.DataSourceGeofenceCollection(),
+			"aws_location_map":                               location.DataSourceMap(),
+			"aws_location_place_index":                       location.DataSourcePlaceIndex(),
+			"aws_location_route_calculator":                  location.DataSourceRouteCalculator(),
+			"aws_location_tracker":                           location.DataSourceTracker(),
+			"aws_memorydb_acl":                               memorydb.DataSourceACL(),
+			"aws_memorydb_cluster":                           memorydb.DataSourceCluster(),
+			"aws_memorydb_parameter_group":                   memorydb.DataSourceParameterGroup(),
+			"aws_memorydb_snapshot":                          memorydb.DataSourceSnapshot(),
+			"aws_memorydb_subnet_group":                      memorydb.DataSourceSubnetGroup(),
+			"aws_memorydb_user":                              memorydb.DataSourceUser(),
 			"aws_mq_broker":                                  mq.DataSourceBroker(),
 			"aws_msk_broker_nodes":                           kafka.DataSourceBrokerNodes(),
 			"aws_msk_cluster":                                kafka.DataSourceCluster(),
@@ -834,6 +1018,10 @@ func Provider() *schema.Provider {
 			"aws_db_security_group":                                   rds.ResourceSecurityGroup(),
 			"aws_db_snapshot":                                         rds.ResourceSnapshot(),
 			"aws_db_subnet_group":                                     rds.ResourceSubnetGroup(),
+			"aws_detective_graph":                                     detective.ResourceGraph(),
+			"aws_detective_invitation_accepter":                       detective.ResourceInvitationAccepter(),
+			"aws_detective_member":                                    detective.ResourceMember(),
+			"aws_detective_organization_admin_account":                detective.ResourceOrganizationAdminAccount(),
 			"aws_devicefarm_project":                                  devicefarm.ResourceProject(),
 			"aws_directory_service_directory":                         ds.ResourceDirectory(),
 			"aws_directory_service_conditional_forwarder":             ds.ResourceConditionalForwarder(),
@@ -955,6 +1143,7 @@ func Provider() *schema.Provider {
 			"aws_emr_instance_fleet":                                  emr.ResourceInstanceFleet(),
 			"aws_emr_managed_scaling_policy":                          emr.ResourceManagedScalingPolicy(),
 			"aws_emr_security_configuration":                          emr.ResourceSecurityConfiguration(),
+			"aws_emrcontainers_virtual_cluster":                       emrcontainers.ResourceVirtualCluster(),
 			"aws_flow_log":                                            ec2.ResourceFlowLog(),
 			"aws_fsx_backup":                                          fsx.ResourceBackup(),
 			"aws_fsx_lustre_file_system":                              fsx.ResourceLustreFileSystem(),
@@ -1079,6 +1268,12 @@ func Provider() *schema.Provider {
 			"aws_lightsail_key_pair":                                  lightsail.ResourceKeyPair(),
 			"aws_lightsail_static_ip":                                 lightsail.ResourceStaticIP(),
 			"aws_lightsail_static_ip_attachment":                      lightsail.ResourceStaticIPAttachment(),
+			"aws_location_geofence_collection":                        location.ResourceGeofenceCollection(),
+			"aws_location_map":                                        location.ResourceMap(),
+			"aws_location_place_index":                                location.ResourcePlaceIndex(),
+			"aws_location_route_calculator":                           location.ResourceRouteCalculator(),
+			"aws_location_tracker":                                    location.ResourceTracker(),
+			"aws_location_tracker_association":                        location.ResourceTrackerAssociation(),
 			"aws_lb_cookie_stickiness_policy":                         elb.ResourceCookieStickinessPolicy(),
 			"aws_load_balancer_policy":                                elb.ResourcePolicy(),
 			"aws_load_balancer_backend_server_policy":                 elb.ResourceBackendServerPolicy(),
@@ -1100,6 +1295,12 @@ func Provider() *schema.Provider {
 			"aws_media_package_channel":                               mediapackage.ResourceChannel(),
 			"aws_media_store_container":                               mediastore.ResourceContainer(),
 			"aws_media_store_container_policy":                        mediastore.ResourceContainerPolicy(),
+			"aws_memorydb_acl":                                        memorydb.ResourceACL(),
+			"aws_memorydb_cluster":                                    memorydb.ResourceCluster(),
+			"aws_memorydb_parameter_group":                            memorydb.ResourceParameterGroup(),
+			"aws_memorydb_snapshot":                                   memorydb.ResourceSnapshot(),
+			"aws_memorydb_subnet_group":                               memorydb.ResourceSubnetGroup(),
+			"aws_memorydb_user":                                       memorydb.ResourceUser(),
 			"aws_msk_cluster":                                         kafka.ResourceCluster(),
 			"aws_msk_configuration":                                   kafka.ResourceConfiguration(),
 			"aws_msk_scram_secret_association":                        kafka.ResourceScramSecretAssociation(),
@@ -1193,6 +1394,7 @@ func Provider() *schema.Provider {
 			"aws_route53_resolver_query_log_config_association":       route53resolver.ResourceQueryLogConfigAssociation(),
 			"aws_route53_resolver_rule_association":                   route53resolver.ResourceRuleAssociation(),
 			"aws_route53_resolver_rule":                               route53resolver.ResourceRule(),
+			"aws_route53domains_registered_domain":                    route53domains.ResourceRegisteredDomain(),
 			"aws_route53recoverycontrolconfig_cluster":                route53recoverycontrolconfig.ResourceCluster(),
 			"aws_route53recoverycontrolconfig_control_panel":          route53recoverycontrolconfig.ResourceControlPanel(),
 			"aws_route53recoverycontrolconfig_routing_control":        route53recoverycontrolconfig.ResourceRoutingControl(),
@@ -1448,6 +1650,10 @@ func Provider() *schema.Provider {
 	provider.DataSourcesMap["aws_serverlessapplicationrepository_application"] = serverlessapprepo.DataSourceApplication()
 	provider.ResourcesMap["aws_serverlessapplicationrepository_cloudformation_stack"] = serverlessapprepo.ResourceCloudFormationStack()
 
+	// Warnings use the default tags of the resource type, so rules are applied last.
+	warnIgnoredDefaultTags(provider.ResourcesMap)
+	applyDefaultTagsRules(provider.ResourcesMap)
+
 	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
 		terraformVersion := provider.TerraformVersion
 		if terraformVersion == "" {
@@ -1488,9 +1694,51 @@ func init() {
 			"being executed. If the API request still fails, an error is\n" +
 			"thrown.",
 
+		"retry_mode": "Specifies how retries are attempted. Valid values are `legacy`, `standard` and `adaptive`. " +
+			"Can also be configured using the `AWS_RETRY_MODE` environment variable.",
+
+		"consistent_reads": "The number of consecutive successful reads required before a newly created resource " +
+			"is considered visible. Increase it to reduce \"not found\" errors right after create caused by eventual consistency.",
+
+		"propagation_timeouts": "Map of service names to the maximum time to wait for changes to propagate, " +
+			"e.g. `iam = \"5m\"`. Supported services are `iam`, `route53` and `s3`.",
+
+		"api_trace_logging": "Log a structured JSON trace of each AWS API call, with sensitive values redacted, " +
+			"instead of raw request and response dumps. Can also be configured using the `TF_AWS_API_TRACE_LOGGING` environment variable.",
+
+		"replay_fixtures": "Path to a file of recorded AWS API interactions to replay from a local server " +
+			"instead of calling AWS. Credentials are not required. " +
+			"Can also be configured using the `TF_AWS_REPLAY_FIXTURES` environment variable.",
+
+		"allowed_organizational_units": "List of organization root or organizational unit IDs. " +
+			"The account must be in one of them, directly or through nested organizational units.",
+
+		"forbidden_organizational_units": "List of organization root or organizational unit IDs. " +
+			"The account must not be in any of them, directly or through nested organizational units. " +
+			"Can be combined with `allowed_organizational_units` to exclude part of an allowed organizational unit.",
+
+		"forbid_management_account": "Whether the organization's management account is forbidden.",
+
+		"allowed_account_tags": "Map of Organizations account tags the account must have.",
+
+		"organization_guardrail_role_arn": "ARN of an IAM role in the organization's management account or a delegated administrator " +
+			"to assume to look up the account's organizational units and tags.",
+
+		"max_request_rates": "Map of service names to the maximum number of requests per second " +
+			"sent to that service, e.g. `route53`.",
+
 		"http_proxy": "The address of an HTTP proxy to use when accessing the AWS API. " +
 			"Can also be configured using the `HTTP_PROXY` or `HTTPS_PROXY` environment variables.",
 
+		"custom_ca_bundle": "File containing custom root and intermediate certificates, or their PEM-encoded content. " +
+			"Can also be configured using the `AWS_CA_BUNDLE` environment variable.",
+
+		"client_certificate": "File containing the client certificate used for mutual TLS authentication, " +
+			"or its PEM-encoded content.",
+
+		"client_private_key": "File containing the private key of the client certificate used for mutual TLS authentication, " +
+			"or its PEM-encoded content.",
+
 		"endpoint": "Use this to override the default service endpoint URL",
 
 		"insecure": "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, " +
@@ -1515,6 +1763,13 @@ func init() {
 			"i.e., http://s3.amazonaws.com/BUCKET/KEY. By default, the S3 client will\n" +
 			"use virtual hosted bucket addressing when possible\n" +
 			"(http://BUCKET.s3.amazonaws.com/KEY). Specific to the Amazon S3 service.",
+
+		"use_dualstack_endpoint": "Resolve an endpoint with DualStack capability. " +
+			"Services without a known dual-stack endpoint continue to use their default endpoint.",
+
+		"use_fips_endpoint": "Resolve an endpoint with FIPS capability. " +
+			"Service endpoints configured in the `endpoints` block take precedence. " +
+			"Services without a known FIPS endpoint must be configured in the `endpoints` block.",
 	}
 
 	EndpointServiceNames = []string{
@@ -1694,9 +1949,16 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		DefaultTagsConfig:       expandProviderDefaultTags(d.Get("default_tags").([]interface{})),
 		Endpoints:               make(map[string]string),
 		MaxRetries:              d.Get("max_retries").(int),
+		RetryMode:               d.Get("retry_mode").(string),
+		APITraceLogging:         d.Get("api_trace_logging").(bool),
+		ConsistentReads:         d.Get("consistent_reads").(int),
+		ReplayFixtures:          d.Get("replay_fixtures").(string),
 		IgnoreTagsConfig:        expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
 		Insecure:                d.Get("insecure").(bool),
 		HTTPProxy:               d.Get("http_proxy").(string),
+		CustomCABundle:          d.Get("custom_ca_bundle").(string),
+		ClientCertificate:       d.Get("client_certificate").(string),
+		ClientPrivateKey:        d.Get("client_private_key").(string),
 		SkipCredsValidation:     d.Get("skip_credentials_validation").(bool),
 		SkipGetEC2Platforms:     d.Get("skip_get_ec2_platforms").(bool),
 		SkipRegionValidation:    d.Get("skip_region_validation").(bool),
@@ -1704,70 +1966,56 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		SkipMetadataApiCheck:    d.Get("skip_metadata_api_check").(bool),
 		S3ForcePathStyle:        d.Get("s3_force_path_style").(bool),
 		TerraformVersion:        terraformVersion,
+		UseDualStackEndpoint:    d.Get("use_dualstack_endpoint").(bool),
+		UseFIPSEndpoint:         d.Get("use_fips_endpoint").(bool),
 	}
 
-	if l, ok := d.Get("assume_role").([]interface{}); ok && len(l) > 0 && l[0] != nil {
-		m := l[0].(map[string]interface{})
+	if l, ok := d.Get("assume_role").([]interface{}); ok {
+		for _, tfMapRaw := range l {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
 
-		if v, ok := m["duration_seconds"].(int); ok && v != 0 {
-			config.AssumeRoleDurationSeconds = v
-		}
-
-		if v, ok := m["external_id"].(string); ok && v != "" {
-			config.AssumeRoleExternalID = v
-		}
-
-		if v, ok := m["policy"].(string); ok && v != "" {
-			config.AssumeRolePolicy = v
-		}
-
-		if policyARNSet, ok := m["policy_arns"].(*schema.Set); ok && policyARNSet.Len() > 0 {
-			for _, policyARNRaw := range policyARNSet.List() {
-				policyARN, ok := policyARNRaw.(string)
+			if !ok {
+				continue
+			}
 
-				if !ok {
-					continue
-				}
+			assumeRole := expandProviderAssumeRole(tfMap)
 
-				config.AssumeRolePolicyARNs = append(config.AssumeRolePolicyARNs, policyARN)
+			if assumeRole.RoleARN == "" {
+				continue
 			}
-		}
 
-		if v, ok := m["role_arn"].(string); ok && v != "" {
-			config.AssumeRoleARN = v
-		}
+			config.AssumeRole = append(config.AssumeRole, assumeRole)
 
-		if v, ok := m["session_name"].(string); ok && v != "" {
-			config.AssumeRoleSessionName = v
+			log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", assumeRole.RoleARN, assumeRole.SessionName, assumeRole.ExternalID)
 		}
+	}
 
-		if tagMapRaw, ok := m["tags"].(map[string]interface{}); ok && len(tagMapRaw) > 0 {
-			config.AssumeRoleTags = make(map[string]string)
+	if l, ok := d.Get("assume_role_with_web_identity").([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		config.AssumeRoleWithWebIdentity = expandProviderAssumeRoleWithWebIdentity(l[0].(map[string]interface{}))
 
-			for k, vRaw := range tagMapRaw {
-				v, ok := vRaw.(string)
+		log.Printf("[INFO] assume_role_with_web_identity configuration set: (ARN: %q, SessionID: %q)", config.AssumeRoleWithWebIdentity.RoleARN, config.AssumeRoleWithWebIdentity.SessionName)
+	}
 
-				if !ok {
-					continue
-				}
+	if v, ok := d.GetOk("max_request_rates"); ok {
+		config.MaxRequestRates = make(map[string]float64)
 
-				config.AssumeRoleTags[k] = v
-			}
+		for service, rate := range v.(map[string]interface{}) {
+			config.MaxRequestRates[service] = rate.(float64)
 		}
+	}
 
-		if transitiveTagKeySet, ok := m["transitive_tag_keys"].(*schema.Set); ok && transitiveTagKeySet.Len() > 0 {
-			for _, transitiveTagKeyRaw := range transitiveTagKeySet.List() {
-				transitiveTagKey, ok := transitiveTagKeyRaw.(string)
+	if v, ok := d.GetOk("propagation_timeouts"); ok {
+		config.PropagationTimeouts = make(map[string]time.Duration)
 
-				if !ok {
-					continue
-				}
+		for service, timeout := range v.(map[string]interface{}) {
+			duration, err := time.ParseDuration(timeout.(string))
 
-				config.AssumeRoleTransitiveTagKeys = append(config.AssumeRoleTransitiveTagKeys, transitiveTagKey)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing propagation timeout for %s (%s): %w", service, timeout, err)
 			}
-		}
 
-		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", config.AssumeRoleARN, config.AssumeRoleSessionName, config.AssumeRoleExternalID)
+			config.PropagationTimeouts[service] = duration
+		}
 	}
 
 	endpointsSet := d.Get("endpoints").(*schema.Set)
@@ -1791,14 +2039,38 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		}
 	}
 
+	if v, ok := d.GetOk("allowed_organizational_units"); ok {
+		for _, idRaw := range v.(*schema.Set).List() {
+			config.AllowedOrganizationalUnits = append(config.AllowedOrganizationalUnits, idRaw.(string))
+		}
+	}
+
+	if v, ok := d.GetOk("forbidden_organizational_units"); ok {
+		for _, idRaw := range v.(*schema.Set).List() {
+			config.ForbiddenOrganizationalUnits = append(config.ForbiddenOrganizationalUnits, idRaw.(string))
+		}
+	}
+
+	config.ForbidManagementAccount = d.Get("forbid_management_account").(bool)
+
+	if v, ok := d.GetOk("allowed_account_tags"); ok {
+		config.AllowedAccountTags = make(map[string]string)
+
+		for k, v := range v.(map[string]interface{}) {
+			config.AllowedAccountTags[k] = v.(string)
+		}
+	}
+
+	config.OrganizationGuardrailRoleARN = d.Get("organization_guardrail_role_arn").(string)
+
 	return config.Client()
 }
 
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeList,
-		Optional: true,
-		MaxItems: 1,
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Roles to assume prior to making API calls. Multiple roles are assumed in order, each using the credentials of the previous role.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"duration_seconds": {
@@ -1854,6 +2126,64 @@ func assumeRoleSchema() *schema.Schema {
 	}
 }
 
+func assumeRoleWithWebIdentitySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"duration": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
+					ValidateFunc: validAssumeRoleDuration,
+				},
+				"policy": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "IAM Policy JSON describing further restricting permissions for the IAM Role being assumed.",
+					ValidateFunc: validation.StringIsJSON,
+				},
+				"policy_arns": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "Amazon Resource Names (ARNs) of IAM Policies describing further restricting permissions for the IAM Role being assumed.",
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: verify.ValidARN,
+					},
+				},
+				"role_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "Amazon Resource Name of an IAM Role to assume prior to making API calls.",
+					ValidateFunc: verify.ValidARN,
+				},
+				"session_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Identifier for the assumed role session.",
+				},
+				"web_identity_token": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Sensitive:    true,
+					Description:  "The OAuth 2.0 access token or OpenID Connect ID token provided by the identity provider.",
+					ValidateFunc: validation.StringLenBetween(4, 20000),
+					ExactlyOneOf: []string{"assume_role_with_web_identity.0.web_identity_token", "assume_role_with_web_identity.0.web_identity_token_file"},
+				},
+				"web_identity_token_file": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The path to a file containing the OAuth 2.0 access token or OpenID Connect ID token provided by the identity provider.",
+					ExactlyOneOf: []string{"assume_role_with_web_identity.0.web_identity_token", "assume_role_with_web_identity.0.web_identity_token_file"},
+				},
+			},
+		},
+	}
+}
+
 func endpointsSchema() *schema.Schema {
 	endpointsAttributes := make(map[string]*schema.Schema)
 
@@ -1886,9 +2216,158 @@ func expandProviderDefaultTags(l []interface{}) *tftags.DefaultConfig {
 	if v, ok := m["tags"].(map[string]interface{}); ok {
 		defaultConfig.Tags = tftags.New(v)
 	}
+
+	if v, ok := m["rule"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			defaultConfig.Rules = append(defaultConfig.Rules, expandProviderDefaultTagsRule(tfMap))
+		}
+	}
+
 	return defaultConfig
 }
 
+func expandProviderDefaultTagsRule(m map[string]interface{}) *tftags.DefaultRule {
+	rule := &tftags.DefaultRule{}
+
+	if v, ok := m["exclude_all_tags"].(bool); ok {
+		rule.ExcludeAllTags = v
+	}
+
+	if v, ok := m["exclude_key_prefixes"].(*schema.Set); ok {
+		rule.ExcludeKeyPrefixes = tftags.New(v.List())
+	}
+
+	if v, ok := m["exclude_keys"].(*schema.Set); ok {
+		rule.ExcludeKeys = tftags.New(v.List())
+	}
+
+	if v, ok := m["resource_types"].(*schema.Set); ok {
+		for _, typeNameRaw := range v.List() {
+			rule.ResourceTypes = append(rule.ResourceTypes, typeNameRaw.(string))
+		}
+	}
+
+	if v, ok := m["tags"].(map[string]interface{}); ok {
+		rule.Tags = tftags.New(v)
+	}
+
+	return rule
+}
+
+func expandProviderAssumeRole(m map[string]interface{}) *conns.AssumeRole {
+	assumeRole := &conns.AssumeRole{}
+
+	if v, ok := m["duration_seconds"].(int); ok && v != 0 {
+		assumeRole.DurationSeconds = v
+	}
+
+	if v, ok := m["external_id"].(string); ok && v != "" {
+		assumeRole.ExternalID = v
+	}
+
+	if v, ok := m["policy"].(string); ok && v != "" {
+		assumeRole.Policy = v
+	}
+
+	if policyARNSet, ok := m["policy_arns"].(*schema.Set); ok && policyARNSet.Len() > 0 {
+		for _, policyARNRaw := range policyARNSet.List() {
+			policyARN, ok := policyARNRaw.(string)
+
+			if !ok {
+				continue
+			}
+
+			assumeRole.PolicyARNs = append(assumeRole.PolicyARNs, policyARN)
+		}
+	}
+
+	if v, ok := m["role_arn"].(string); ok && v != "" {
+		assumeRole.RoleARN = v
+	}
+
+	if v, ok := m["session_name"].(string); ok && v != "" {
+		assumeRole.SessionName = v
+	}
+
+	if tagMapRaw, ok := m["tags"].(map[string]interface{}); ok && len(tagMapRaw) > 0 {
+		assumeRole.Tags = make(map[string]string)
+
+		for k, vRaw := range tagMapRaw {
+			v, ok := vRaw.(string)
+
+			if !ok {
+				continue
+			}
+
+			assumeRole.Tags[k] = v
+		}
+	}
+
+	if transitiveTagKeySet, ok := m["transitive_tag_keys"].(*schema.Set); ok && transitiveTagKeySet.Len() > 0 {
+		for _, transitiveTagKeyRaw := range transitiveTagKeySet.List() {
+			transitiveTagKey, ok := transitiveTagKeyRaw.(string)
+
+			if !ok {
+				continue
+			}
+
+			assumeRole.TransitiveTagKeys = append(assumeRole.TransitiveTagKeys, transitiveTagKey)
+		}
+	}
+
+	return assumeRole
+}
+
+func expandProviderAssumeRoleWithWebIdentity(m map[string]interface{}) *conns.AssumeRoleWithWebIdentity {
+	webIdentity := &conns.AssumeRoleWithWebIdentity{}
+
+	if v, ok := m["duration"].(string); ok && v != "" {
+		// Validated by the schema.
+		duration, _ := time.ParseDuration(v)
+		webIdentity.Duration = duration
+	}
+
+	if v, ok := m["policy"].(string); ok && v != "" {
+		webIdentity.Policy = v
+	}
+
+	if v, ok := m["policy_arns"].(*schema.Set); ok && v.Len() > 0 {
+		for _, policyARNRaw := range v.List() {
+			policyARN, ok := policyARNRaw.(string)
+
+			if !ok {
+				continue
+			}
+
+			webIdentity.PolicyARNs = append(webIdentity.PolicyARNs, policyARN)
+		}
+	}
+
+	if v, ok := m["role_arn"].(string); ok && v != "" {
+		webIdentity.RoleARN = v
+	}
+
+	if v, ok := m["session_name"].(string); ok && v != "" {
+		webIdentity.SessionName = v
+	}
+
+	if v, ok := m["web_identity_token"].(string); ok && v != "" {
+		webIdentity.WebIdentityToken = v
+	}
+
+	if v, ok := m["web_identity_token_file"].(string); ok && v != "" {
+		webIdentity.WebIdentityTokenFile = v
+	}
+
+	return webIdentity
+}
+
 func expandProviderIgnoreTags(l []interface{}) *tftags.IgnoreConfig {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -1907,3 +2386,20 @@ func expandProviderIgnoreTags(l []interface{}) *tftags.IgnoreConfig {
 
 	return ignoreConfig
 }
+
+// validAssumeRoleDuration validates a string can be parsed as a valid time.Duration
+// and is within the 15 minute to 12 hour range allowed by STS.
+func validAssumeRoleDuration(v interface{}, k string) (ws []string, errors []error) {
+	duration, err := time.ParseDuration(v.(string))
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q cannot be parsed as a duration: %w", k, err))
+		return
+	}
+
+	if duration.Minutes() < 15 || duration.Hours() > 12 {
+		errors = append(errors, fmt.Errorf("duration %q must be between 15 minutes (15m) and 12 hours (12h), inclusive", k))
+	}
+
+	return
+}
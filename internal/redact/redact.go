@@ -0,0 +1,50 @@
+// Package redact identifies sensitive AWS API parameters, such as credentials and secrets,
+// whose values must not be logged or recorded.
+package redact
+
+import (
+	"reflect"
+	"strings"
+)
+
+// Redacted replaces sensitive values.
+const Redacted = "***REDACTED***"
+
+// sensitiveFieldNameSuffixes are the suffixes of the names of parameters whose values are sensitive,
+// in addition to fields the AWS SDK for Go marks as sensitive.
+var sensitiveFieldNameSuffixes = []string{
+	"AuthToken",
+	"Passphrase",
+	"Password",
+	"Plaintext",
+	"PrivateKey",
+	"SAMLAssertion",
+	"SecretAccessKey",
+	"SecretBinary",
+	"SecretKey",
+	"SecretString",
+	"SessionToken",
+	"WebIdentityToken",
+}
+
+// IsSensitiveField returns whether the value of an AWS SDK for Go shape's field is sensitive.
+func IsSensitiveField(field reflect.StructField) bool {
+	if field.Tag.Get("sensitive") == "true" {
+		return true
+	}
+
+	return IsSensitiveFieldName(field.Name)
+}
+
+// IsSensitiveFieldName returns whether the value of the parameter with the specified name is sensitive.
+// It is used where the AWS SDK for Go shape, and so its sensitive tags, is not available,
+// e.g. for the JSON object keys and XML element names of API request and response bodies.
+func IsSensitiveFieldName(name string) bool {
+	for _, suffix := range sensitiveFieldNameSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,102 @@
+package redact
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIsSensitiveField(t *testing.T) {
+	type shape struct {
+		AccessKeyId     *string
+		Plaintext       []byte
+		SecretAccessKey *string
+		SecretString    *string `type:"string" sensitive:"true"`
+		SessionToken    *string
+		Value           *string `type:"string" sensitive:"true"`
+		Name            *string `type:"string"`
+	}
+
+	testCases := []struct {
+		Field    string
+		Expected bool
+	}{
+		{
+			Field:    "AccessKeyId",
+			Expected: false,
+		},
+		{
+			Field:    "Plaintext",
+			Expected: true,
+		},
+		{
+			Field:    "SecretAccessKey",
+			Expected: true,
+		},
+		{
+			Field:    "SecretString",
+			Expected: true,
+		},
+		{
+			Field:    "SessionToken",
+			Expected: true,
+		},
+		{
+			Field:    "Value",
+			Expected: true,
+		},
+		{
+			Field:    "Name",
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		field, ok := reflect.TypeOf(shape{}).FieldByName(testCase.Field)
+
+		if !ok {
+			t.Fatalf("field %s not found", testCase.Field)
+		}
+
+		if got := IsSensitiveField(field); got != testCase.Expected {
+			t.Errorf("%s: got %t, expected %t", testCase.Field, got, testCase.Expected)
+		}
+	}
+}
+
+func TestIsSensitiveFieldName(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Expected bool
+	}{
+		{
+			Name:     "MasterUserPassword",
+			Expected: true,
+		},
+		{
+			Name:     "SecretString",
+			Expected: true,
+		},
+		{
+			Name:     "SecretAccessKey",
+			Expected: true,
+		},
+		{
+			Name:     "AccessKeyId",
+			Expected: false,
+		},
+		{
+			Name:     "Key",
+			Expected: false,
+		},
+		{
+			Name:     "PasswordLastUsed",
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		if got := IsSensitiveFieldName(testCase.Name); got != testCase.Expected {
+			t.Errorf("%s: got %t, expected %t", testCase.Name, got, testCase.Expected)
+		}
+	}
+}
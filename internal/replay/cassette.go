@@ -0,0 +1,82 @@
+// Package replay records AWS API interactions and serves them back from a local
+// server, allowing the provider to be exercised without an AWS account.
+package replay
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Cassette holds the recorded AWS API interactions of a provider instance.
+type Cassette struct {
+	// AccountID, Partition and Region are those of the recorded provider.
+	AccountID string `json:"account_id"`
+	Partition string `json:"partition"`
+	Region    string `json:"region"`
+
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction is a recorded AWS API request and its response.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request identifies a recorded AWS API request.
+type Request struct {
+	// Service is the SigV4 signing name of the service, e.g. "sts".
+	Service string `json:"service"`
+	// Operation is the API operation name for RPC-style protocols, e.g. "GetCallerIdentity".
+	// It is empty for REST-style protocols, which are identified by Method and Path.
+	Operation string `json:"operation,omitempty"`
+	Method    string `json:"method"`
+	Path      string `json:"path"`
+	// Body is the normalized request body.
+	Body string `json:"body,omitempty"`
+}
+
+// Response is a recorded AWS API response.
+type Response struct {
+	StatusCode int               `json:"status_code"`
+	Header     map[string]string `json:"header,omitempty"`
+	Body       string            `json:"body,omitempty"`
+}
+
+// Load reads a cassette from a JSON file.
+func Load(filename string) (*Cassette, error) {
+	b, err := os.ReadFile(filename)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading replay fixtures (%s): %w", filename, err)
+	}
+
+	cassette := &Cassette{}
+
+	if err := json.Unmarshal(b, cassette); err != nil {
+		return nil, fmt.Errorf("error decoding replay fixtures (%s): %w", filename, err)
+	}
+
+	return cassette, nil
+}
+
+// Save writes the cassette to a JSON file, creating its directory if necessary.
+func (c *Cassette) Save(filename string) error {
+	b, err := json.MarshalIndent(c, "", "  ")
+
+	if err != nil {
+		return fmt.Errorf("error encoding replay fixtures: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		return fmt.Errorf("error creating replay fixtures directory: %w", err)
+	}
+
+	if err := os.WriteFile(filename, b, 0644); err != nil {
+		return fmt.Errorf("error writing replay fixtures (%s): %w", filename, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package replay
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// recordedResponseHeaders are the response headers recorded with each interaction.
+var recordedResponseHeaders = []string{
+	"Content-Type",
+	"Etag",
+	"Location",
+	"X-Amz-Id-2",
+	"X-Amz-Request-Id",
+	"X-Amz-Version-Id",
+	"X-Amzn-Errortype",
+	"X-Amzn-Requestid",
+}
+
+// Recorder records AWS API interactions into a cassette.
+// It is safe for concurrent use by multiple HTTP clients.
+type Recorder struct {
+	mu       sync.Mutex
+	cassette *Cassette
+}
+
+// NewRecorder returns a recorder with an empty cassette.
+func NewRecorder() *Recorder {
+	return &Recorder{
+		cassette: &Cassette{},
+	}
+}
+
+// SetProvider records the account ID, partition and region of the provider, if not already recorded.
+func (r *Recorder) SetProvider(accountID, partition, region string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.cassette.Region != "" {
+		return
+	}
+
+	r.cassette.AccountID = accountID
+	r.cassette.Partition = partition
+	r.cassette.Region = region
+}
+
+// Len returns the number of interactions recorded.
+func (r *Recorder) Len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.cassette.Interactions)
+}
+
+// Save writes the recorded interactions to a JSON file.
+func (r *Recorder) Save(filename string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.cassette.Save(filename)
+}
+
+// Transport returns an http.RoundTripper that records the interactions made using transport.
+// If transport is nil, http.DefaultTransport is used.
+func (r *Recorder) Transport(transport http.RoundTripper) http.RoundTripper {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	return &recordingTransport{
+		recorder:  r,
+		transport: transport,
+	}
+}
+
+func (r *Recorder) record(interaction *Interaction) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+}
+
+type recordingTransport struct {
+	recorder  *Recorder
+	transport http.RoundTripper
+}
+
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+
+	if req.Body != nil && req.Body != http.NoBody {
+		b, err := io.ReadAll(req.Body)
+		req.Body.Close()
+
+		if err != nil {
+			return nil, err
+		}
+
+		body = b
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	resp, err := t.transport.RoundTrip(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	// Sensitive values are redacted from the recorded interaction, not from the response returned.
+	interaction := &Interaction{
+		Request: newRequest(req, body),
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Header:     make(map[string]string),
+			Body:       string(redactBody(resp.Header.Get("Content-Type"), respBody)),
+		},
+	}
+
+	for _, k := range recordedResponseHeaders {
+		if v := resp.Header.Get(k); v != "" {
+			interaction.Response.Header[k] = v
+		}
+	}
+
+	t.recorder.record(interaction)
+
+	return resp, nil
+}
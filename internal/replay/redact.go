@@ -0,0 +1,125 @@
+package replay
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/redact"
+)
+
+// xmlElementRegexp matches XML elements that contain only text, e.g. "<SessionToken>...</".
+// An element's text ends at the first "<", which for such an element starts its end tag.
+var xmlElementRegexp = regexp.MustCompile(`<((?:[\w.-]+:)?([\w.-]+))(\s[^>]*)?>[^<]*</`)
+
+// redactBody returns the body of an AWS API request or response with the values of sensitive
+// parameters, e.g. temporary security credentials, IAM access keys and secrets, redacted.
+// JSON object keys, XML element names and query parameter names are matched against the
+// sensitive parameter names of the redact package.
+func redactBody(contentType string, body []byte) []byte {
+	if len(body) == 0 {
+		return body
+	}
+
+	switch {
+	case strings.HasPrefix(contentType, "application/x-www-form-urlencoded"):
+		return redactQueryBody(body)
+	case strings.Contains(contentType, "json"):
+		return redactJSONBody(body)
+	case strings.Contains(contentType, "xml"):
+		return redactXMLBody(body)
+	}
+
+	return body
+}
+
+// redactQueryBody redacts the parameters of a query protocol request, e.g. "Credentials.SessionToken".
+func redactQueryBody(body []byte) []byte {
+	v, err := url.ParseQuery(string(body))
+
+	if err != nil {
+		return body
+	}
+
+	redacted := false
+
+	for k := range v {
+		if redact.IsSensitiveFieldName(k[strings.LastIndex(k, ".")+1:]) {
+			v.Set(k, redact.Redacted)
+			redacted = true
+		}
+	}
+
+	if !redacted {
+		return body
+	}
+
+	return []byte(v.Encode())
+}
+
+func redactJSONBody(body []byte) []byte {
+	var v interface{}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&v); err != nil {
+		return body
+	}
+
+	if !redactJSONValue(v) {
+		return body
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(v); err != nil {
+		return body
+	}
+
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n"))
+}
+
+// redactJSONValue redacts sensitive values in place, returning whether any were redacted.
+func redactJSONValue(v interface{}) bool {
+	redacted := false
+
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if e != nil && redact.IsSensitiveFieldName(k) {
+				v[k] = redact.Redacted
+				redacted = true
+				continue
+			}
+
+			if redactJSONValue(e) {
+				redacted = true
+			}
+		}
+	case []interface{}:
+		for _, e := range v {
+			if redactJSONValue(e) {
+				redacted = true
+			}
+		}
+	}
+
+	return redacted
+}
+
+func redactXMLBody(body []byte) []byte {
+	return xmlElementRegexp.ReplaceAllFunc(body, func(element []byte) []byte {
+		m := xmlElementRegexp.FindSubmatch(element)
+
+		if !redact.IsSensitiveFieldName(string(m[2])) {
+			return element
+		}
+
+		return []byte("<" + string(m[1]) + string(m[3]) + ">" + redact.Redacted + "</")
+	})
+}
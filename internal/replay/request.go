@@ -0,0 +1,75 @@
+package replay
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var credentialScopeRegexp = regexp.MustCompile(`Credential=[^/]+/[^/]+/[^/]+/([^/]+)/aws4_request`)
+
+// newRequest identifies an AWS API request from its HTTP request and body.
+// Sensitive parameter values are redacted from the body, so that recorded and replayed requests
+// are compared in the same form.
+func newRequest(r *http.Request, body []byte) Request {
+	contentType := r.Header.Get("Content-Type")
+	request := Request{
+		Method: r.Method,
+		Path:   r.URL.EscapedPath(),
+		Body:   normalizeBody(contentType, redactBody(contentType, body)),
+	}
+
+	if m := credentialScopeRegexp.FindStringSubmatch(r.Header.Get("Authorization")); m != nil {
+		request.Service = m[1]
+	}
+
+	if v := r.Header.Get("X-Amz-Target"); v != "" {
+		// JSON protocols, e.g. "DynamoDB_20120810.GetItem".
+		request.Operation = v[strings.LastIndex(v, ".")+1:]
+	} else if v, err := url.ParseQuery(string(body)); err == nil && v.Get("Action") != "" {
+		// Query and EC2 protocols.
+		request.Operation = v.Get("Action")
+	}
+
+	return request
+}
+
+// normalizeBody returns the request body in a form that does not depend on parameter ordering.
+func normalizeBody(contentType string, body []byte) string {
+	switch {
+	case strings.HasPrefix(contentType, "application/x-www-form-urlencoded"):
+		if v, err := url.ParseQuery(string(body)); err == nil {
+			return v.Encode()
+		}
+	case strings.Contains(contentType, "json"):
+		var v interface{}
+
+		if err := json.Unmarshal(body, &v); err == nil {
+			var buf bytes.Buffer
+			encoder := json.NewEncoder(&buf)
+			encoder.SetEscapeHTML(false)
+
+			if err := encoder.Encode(v); err == nil {
+				return strings.TrimSuffix(buf.String(), "\n")
+			}
+		}
+	}
+
+	return string(body)
+}
+
+// sameOperation returns whether two requests are for the same API operation.
+func (r Request) sameOperation(other Request) bool {
+	if r.Service != other.Service {
+		return false
+	}
+
+	if r.Operation != "" || other.Operation != "" {
+		return r.Operation == other.Operation
+	}
+
+	return r.Method == other.Method && r.Path == other.Path
+}
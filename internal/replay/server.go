@@ -0,0 +1,163 @@
+package replay
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// ErrCodeFixtureNotFound is the error code returned for requests that do not match any recorded interaction.
+const ErrCodeFixtureNotFound = "ReplayFixtureNotFound"
+
+// Server serves recorded responses to AWS API requests.
+//
+// A request is matched to the recorded interactions for the same operation,
+// preferring one with an identical request body. Interactions are served once each,
+// in recorded order, after which the last interaction for the operation is repeated,
+// e.g. when polling for a resource's status.
+type Server struct {
+	cassette *Cassette
+
+	mu     sync.Mutex
+	served []bool
+
+	server *http.Server
+}
+
+// NewServer returns a server for the recorded interactions.
+func NewServer(cassette *Cassette) *Server {
+	return &Server{
+		cassette: cassette,
+		served:   make([]bool, len(cassette.Interactions)),
+	}
+}
+
+// Start serves requests on a local port, returning the server's URL.
+func (s *Server) Start() (string, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+
+	if err != nil {
+		return "", fmt.Errorf("error starting replay server: %w", err)
+	}
+
+	s.server = &http.Server{Handler: s}
+
+	go func() {
+		if err := s.server.Serve(listener); err != nil && err != http.ErrServerClosed {
+			log.Printf("[ERROR] replay server: %s", err)
+		}
+	}()
+
+	return "http://" + listener.Addr().String(), nil
+}
+
+// Close stops the server.
+func (s *Server) Close() error {
+	if s.server == nil {
+		return nil
+	}
+
+	return s.server.Close()
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	request := newRequest(r, body)
+	interaction := s.match(request)
+
+	if interaction == nil {
+		log.Printf("[WARN] No replay fixture for %s %s (%s %s)", request.Service, request.Operation, request.Method, request.Path)
+		writeFixtureNotFound(w, r, request)
+		return
+	}
+
+	for k, v := range interaction.Response.Header {
+		w.Header().Set(k, v)
+	}
+
+	w.WriteHeader(interaction.Response.StatusCode)
+	io.WriteString(w, interaction.Response.Body) //nolint:errcheck
+}
+
+// match returns the interaction to serve for the request, or nil if there is none.
+func (s *Server) match(request Request) *Interaction {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	next, last := -1, -1
+
+	for i, interaction := range s.cassette.Interactions {
+		if !interaction.Request.sameOperation(request) {
+			continue
+		}
+
+		last = i
+
+		if s.served[i] {
+			continue
+		}
+
+		if interaction.Request.Body == request.Body {
+			next = i
+			break
+		}
+
+		if next == -1 {
+			next = i
+		}
+	}
+
+	if next == -1 {
+		next = last
+	}
+
+	if next == -1 {
+		return nil
+	}
+
+	s.served[next] = true
+
+	return s.cassette.Interactions[next]
+}
+
+// writeFixtureNotFound writes an AWS API error response in the request's protocol.
+func writeFixtureNotFound(w http.ResponseWriter, r *http.Request, request Request) {
+	message := fmt.Sprintf("no replay fixture recorded for %s %s (%s %s)", request.Service, request.Operation, request.Method, request.Path)
+
+	if r.Header.Get("X-Amz-Target") != "" || strings.Contains(r.Header.Get("Content-Type"), "json") {
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		w.Header().Set("X-Amzn-Errortype", ErrCodeFixtureNotFound)
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{ //nolint:errcheck
+			"__type":  ErrCodeFixtureNotFound,
+			"message": message,
+		})
+
+		return
+	}
+
+	var response struct {
+		XMLName xml.Name `xml:"ErrorResponse"`
+		Code    string   `xml:"Error>Code"`
+		Message string   `xml:"Error>Message"`
+	}
+
+	response.Code = ErrCodeFixtureNotFound
+	response.Message = message
+
+	w.Header().Set("Content-Type", "text/xml")
+	w.WriteHeader(http.StatusBadRequest)
+	xml.NewEncoder(w).Encode(response) //nolint:errcheck
+}
@@ -122,6 +122,7 @@ func resourceAnalyzerRead(d *schema.ResourceData, meta interface{}) error {
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, accessanalyzer.ErrCodeResourceNotFoundException) {
 		log.Printf("[WARN] Access Analyzer Analyzer (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -316,6 +316,7 @@ func resourceCertificateRead(d *schema.ResourceData, meta interface{}) error {
 
 		if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, acm.ErrCodeResourceNotFoundException) {
 			log.Printf("[WARN] ACM Certificate (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -330,6 +331,7 @@ func resourceCertificateRead(d *schema.ResourceData, meta interface{}) error {
 
 		if !d.IsNewResource() && aws.StringValue(resp.Certificate.Status) == acm.CertificateStatusValidationTimedOut {
 			log.Printf("[WARN] ACM Certificate (%s) validation timed out, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -182,6 +182,7 @@ func resourceCertificateValidationRead(d *schema.ResourceData, meta interface{})
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, acm.ErrCodeResourceNotFoundException) {
 		log.Printf("[WARN] ACM Certificate (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -200,6 +201,7 @@ func resourceCertificateValidationRead(d *schema.ResourceData, meta interface{})
 		}
 
 		log.Printf("[WARN] ACM Certificate (%s) status not issued (%s), removing from state", d.Id(), status)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
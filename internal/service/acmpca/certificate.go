@@ -174,6 +174,7 @@ func resourceCertificateRead(d *schema.ResourceData, meta interface{}) error {
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, acmpca.ErrCodeResourceNotFoundException) {
 		log.Printf("[WARN] ACM PCA Certificate (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -338,6 +338,7 @@ func resourceCertificateAuthorityRead(d *schema.ResourceData, meta interface{})
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, acmpca.ErrCodeResourceNotFoundException) {
 		log.Printf("[WARN] ACM PCA Certificate Authority (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -352,6 +353,7 @@ func resourceCertificateAuthorityRead(d *schema.ResourceData, meta interface{})
 		}
 
 		log.Printf("[WARN] ACM PCA Certificate Authority (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -384,6 +386,7 @@ func resourceCertificateAuthorityRead(d *schema.ResourceData, meta interface{})
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, acmpca.ErrCodeResourceNotFoundException) {
 		log.Printf("[WARN] ACM PCA Certificate Authority (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -411,6 +414,7 @@ func resourceCertificateAuthorityRead(d *schema.ResourceData, meta interface{})
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, acmpca.ErrCodeResourceNotFoundException) {
 		log.Printf("[WARN] ACM PCA Certificate Authority (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -40,7 +40,7 @@ func sweepApps(region string) error {
 			r := ResourceApp()
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(app.AppId))
-			err = r.Delete(d, client)
+			err = sweep.DeleteResource(r, d, client)
 
 			if err != nil {
 				log.Printf("[ERROR] %s", err)
@@ -111,6 +111,7 @@ func resourceAPIKeyRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, apigateway.ErrCodeNotFoundException, "") {
 			log.Printf("[WARN] API Gateway API Key (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
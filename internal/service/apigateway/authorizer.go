@@ -174,6 +174,7 @@ func resourceAuthorizerRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, apigateway.ErrCodeNotFoundException, "") {
 			log.Printf("[WARN] No API Gateway Authorizer found: %s", input)
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
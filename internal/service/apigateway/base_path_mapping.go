@@ -163,6 +163,7 @@ func resourceBasePathMappingRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, apigateway.ErrCodeNotFoundException, "") {
 			log.Printf("[WARN] API Gateway Base Path Mapping (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
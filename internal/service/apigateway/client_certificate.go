@@ -88,6 +88,7 @@ func resourceClientCertificateRead(d *schema.ResourceData, meta interface{}) err
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, apigateway.ErrCodeNotFoundException, "") {
 			log.Printf("[WARN] API Gateway Client Certificate %s not found, removing", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -116,6 +116,7 @@ func resourceDeploymentRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, apigateway.ErrCodeNotFoundException, "") {
 			log.Printf("[WARN] API Gateway Deployment (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
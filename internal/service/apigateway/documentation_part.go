@@ -106,6 +106,7 @@ func resourceDocumentationPartRead(d *schema.ResourceData, meta interface{}) err
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, apigateway.ErrCodeNotFoundException, "") {
 			log.Printf("[WARN] API Gateway Documentation Part (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
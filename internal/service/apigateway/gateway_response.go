@@ -118,6 +118,7 @@ func resourceGatewayResponseRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, apigateway.ErrCodeNotFoundException, "") {
 			log.Printf("[WARN] API Gateway Gateway Response (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
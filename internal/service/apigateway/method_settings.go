@@ -146,6 +146,7 @@ func resourceMethodSettingsRead(d *schema.ResourceData, meta interface{}) error
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, apigateway.ErrCodeNotFoundException) {
 		log.Printf("[WARN] API Gateway Stage Method Settings (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -159,6 +160,7 @@ func resourceMethodSettingsRead(d *schema.ResourceData, meta interface{}) error
 
 	if !d.IsNewResource() && !ok {
 		log.Printf("[WARN] API Gateway Stage Method Settings (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -91,6 +91,7 @@ func resourceRequestValidatorRead(d *schema.ResourceData, meta interface{}) erro
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, apigateway.ErrCodeNotFoundException, "") {
 			log.Printf("[WARN] API Gateway Request Validator (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -340,6 +340,7 @@ func resourceRestAPIRead(d *schema.ResourceData, meta interface{}) error {
 	})
 	if tfawserr.ErrMessageContains(err, apigateway.ErrCodeNotFoundException, "") {
 		log.Printf("[WARN] API Gateway (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
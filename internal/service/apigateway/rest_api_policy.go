@@ -82,6 +82,7 @@ func resourceRestAPIPolicyRead(d *schema.ResourceData, meta interface{}) error {
 	})
 	if tfawserr.ErrMessageContains(err, apigateway.ErrCodeNotFoundException, "") {
 		log.Printf("[WARN] API Gateway REST API Policy (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -222,6 +222,7 @@ func resourceStageRead(d *schema.ResourceData, meta interface{}) error {
 
 	if tfawserr.ErrMessageContains(err, apigateway.ErrCodeNotFoundException, "") {
 		log.Printf("[WARN] API Gateway Stage (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
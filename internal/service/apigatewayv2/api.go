@@ -283,6 +283,7 @@ func resourceAPIRead(d *schema.ResourceData, meta interface{}) error {
 	})
 	if tfawserr.ErrMessageContains(err, apigatewayv2.ErrCodeNotFoundException, "") {
 		log.Printf("[WARN] API Gateway v2 API (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
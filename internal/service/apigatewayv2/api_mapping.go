@@ -77,6 +77,7 @@ func resourceAPIMappingRead(d *schema.ResourceData, meta interface{}) error {
 	})
 	if tfawserr.ErrMessageContains(err, apigatewayv2.ErrCodeNotFoundException, "") {
 		log.Printf("[WARN] API Gateway v2 API mapping (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -157,6 +157,7 @@ func resourceAuthorizerRead(d *schema.ResourceData, meta interface{}) error {
 	})
 	if tfawserr.ErrMessageContains(err, apigatewayv2.ErrCodeNotFoundException, "") {
 		log.Printf("[WARN] API Gateway v2 authorizer (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
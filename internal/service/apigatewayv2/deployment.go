@@ -79,6 +79,7 @@ func resourceDeploymentRead(d *schema.ResourceData, meta interface{}) error {
 	outputRaw, _, err := StatusDeployment(conn, d.Get("api_id").(string), d.Id())()
 	if tfawserr.ErrMessageContains(err, apigatewayv2.ErrCodeNotFoundException, "") {
 		log.Printf("[WARN] API Gateway v2 deployment (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -100,6 +100,7 @@ func resourceIntegrationResponseRead(d *schema.ResourceData, meta interface{}) e
 	})
 	if tfawserr.ErrMessageContains(err, apigatewayv2.ErrCodeNotFoundException, "") {
 		log.Printf("[WARN] API Gateway v2 integration response (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -154,6 +154,7 @@ func resourceRouteRead(d *schema.ResourceData, meta interface{}) error {
 
 	if tfawserr.ErrCodeEquals(err, apigatewayv2.ErrCodeNotFoundException) {
 		log.Printf("[WARN] API Gateway v2 route (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -93,6 +93,7 @@ func resourceVPCLinkRead(d *schema.ResourceData, meta interface{}) error {
 	outputRaw, _, err := StatusVPCLink(conn, d.Id())()
 	if tfawserr.ErrMessageContains(err, apigatewayv2.ErrCodeNotFoundException, "") {
 		log.Printf("[WARN] API Gateway v2 VPC Link (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
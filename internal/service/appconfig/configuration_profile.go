@@ -156,6 +156,7 @@ func resourceConfigurationProfileRead(d *schema.ResourceData, meta interface{})
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, appconfig.ErrCodeResourceNotFoundException) {
 		log.Printf("[WARN] AppConfig Configuration Profile (%s) for Application (%s) not found, removing from state", confProfID, appID)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
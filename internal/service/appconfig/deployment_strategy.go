@@ -123,6 +123,7 @@ func resourceDeploymentStrategyRead(d *schema.ResourceData, meta interface{}) er
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, appconfig.ErrCodeResourceNotFoundException) {
 		log.Printf("[WARN] Appconfig Deployment Strategy (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -142,6 +142,7 @@ func resourceEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, appconfig.ErrCodeResourceNotFoundException) {
 		log.Printf("[WARN] Appconfig Environment (%s) for Application (%s) not found, removing from state", envID, appID)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
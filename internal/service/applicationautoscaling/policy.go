@@ -3,7 +3,6 @@ package applicationautoscaling
 import (
 	"fmt"
 	"log"
-	"strconv"
 	"strings"
 	"time"
 
@@ -14,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/nullable"
 	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -88,12 +88,14 @@ func ResourcePolicy() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"metric_interval_lower_bound": {
-										Type:     schema.TypeString,
-										Optional: true,
+										Type:         nullable.TypeNullableFloat,
+										Optional:     true,
+										ValidateFunc: nullable.ValidateTypeStringNullableFloat,
 									},
 									"metric_interval_upper_bound": {
-										Type:     schema.TypeString,
-										Optional: true,
+										Type:         nullable.TypeNullableFloat,
+										Optional:     true,
+										ValidateFunc: nullable.ValidateTypeStringNullableFloat,
 									},
 									"scaling_adjustment": {
 										Type:     schema.TypeInt,
@@ -273,6 +275,7 @@ func resourcePolicyRead(d *schema.ResourceData, meta interface{}) error {
 
 	if p == nil {
 		log.Printf("[WARN] Application AutoScaling Policy (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -434,46 +437,31 @@ func expandAppautoscalingStepAdjustments(configured []interface{}) ([]*applicati
 	var adjustments []*applicationautoscaling.StepAdjustment
 
 	// Loop over our configured step adjustments and create an array
-	// of aws-sdk-go compatible objects. We're forced to convert strings
-	// to floats here because there's no way to detect whether or not
-	// an uninitialized, optional schema element is "0.0" deliberately.
-	// With strings, we can test for "", which is definitely an empty
-	// struct value.
+	// of aws-sdk-go compatible objects. The interval bounds are nullable
+	// floats because there's no way to detect whether or not an
+	// uninitialized, optional schema element is "0.0" deliberately.
 	for _, raw := range configured {
 		data := raw.(map[string]interface{})
 		a := &applicationautoscaling.StepAdjustment{
 			ScalingAdjustment: aws.Int64(int64(data["scaling_adjustment"].(int))),
 		}
-		if data["metric_interval_lower_bound"] != "" {
-			bound := data["metric_interval_lower_bound"]
-			switch bound := bound.(type) {
-			case string:
-				f, err := strconv.ParseFloat(bound, 64)
-				if err != nil {
-					return nil, fmt.Errorf(
-						"metric_interval_lower_bound must be a float value represented as a string")
-				}
-				a.MetricIntervalLowerBound = aws.Float64(f)
-			default:
-				return nil, fmt.Errorf(
-					"metric_interval_lower_bound isn't a string. This is a bug. Please file an issue.")
-			}
+
+		lowerBound, null, err := nullable.Float(data["metric_interval_lower_bound"].(string)).Value()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing metric_interval_lower_bound: %w", err)
 		}
-		if data["metric_interval_upper_bound"] != "" {
-			bound := data["metric_interval_upper_bound"]
-			switch bound := bound.(type) {
-			case string:
-				f, err := strconv.ParseFloat(bound, 64)
-				if err != nil {
-					return nil, fmt.Errorf(
-						"metric_interval_upper_bound must be a float value represented as a string")
-				}
-				a.MetricIntervalUpperBound = aws.Float64(f)
-			default:
-				return nil, fmt.Errorf(
-					"metric_interval_upper_bound isn't a string. This is a bug. Please file an issue.")
-			}
+		if !null {
+			a.MetricIntervalLowerBound = aws.Float64(lowerBound)
+		}
+
+		upperBound, null, err := nullable.Float(data["metric_interval_upper_bound"].(string)).Value()
+		if err != nil {
+			return nil, fmt.Errorf("error parsing metric_interval_upper_bound: %w", err)
 		}
+		if !null {
+			a.MetricIntervalUpperBound = aws.Float64(upperBound)
+		}
+
 		adjustments = append(adjustments, a)
 	}
 
@@ -663,11 +651,11 @@ func flattenStepScalingPolicyConfiguration(cfg *applicationautoscaling.StepScali
 		stepAdjustmentsResource := &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"metric_interval_lower_bound": {
-					Type:     schema.TypeString,
+					Type:     nullable.TypeNullableFloat,
 					Optional: true,
 				},
 				"metric_interval_upper_bound": {
-					Type:     schema.TypeString,
+					Type:     nullable.TypeNullableFloat,
 					Optional: true,
 				},
 				"scaling_adjustment": {
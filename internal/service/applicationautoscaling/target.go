@@ -133,6 +133,7 @@ func resourceTargetRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	if t == nil {
 		log.Printf("[WARN] Application AutoScaling Target (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -355,6 +355,7 @@ func resourceGatewayRouteRead(d *schema.ResourceData, meta interface{}) error {
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, appmesh.ErrCodeNotFoundException) {
 		log.Printf("[WARN] App Mesh Gateway Route (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -369,6 +370,7 @@ func resourceGatewayRouteRead(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[WARN] App Mesh Gateway Route (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -379,6 +381,7 @@ func resourceGatewayRouteRead(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[WARN] App Mesh Gateway Route (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -159,6 +159,7 @@ func resourceMeshRead(d *schema.ResourceData, meta interface{}) error {
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, appmesh.ErrCodeNotFoundException) {
 		log.Printf("[WARN] App Mesh Service Mesh (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -177,6 +178,7 @@ func resourceMeshRead(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[WARN] App Mesh Service Mesh (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
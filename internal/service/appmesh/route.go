@@ -766,6 +766,7 @@ func resourceRouteRead(d *schema.ResourceData, meta interface{}) error {
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, appmesh.ErrCodeNotFoundException) {
 		log.Printf("[WARN] App Mesh Route (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -784,6 +785,7 @@ func resourceRouteRead(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[WARN] App Mesh Route (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -104,7 +104,7 @@ func sweepGatewayRoutes(region string) error {
 							d.Set("mesh_name", meshName)
 							d.Set("name", gatewayRouteName)
 							d.Set("virtual_gateway_name", virtualGatewayName)
-							err := r.Delete(d, client)
+							err := sweep.DeleteResource(r, d, client)
 
 							if err != nil {
 								log.Printf("[ERROR] %s", err)
@@ -293,7 +293,7 @@ func sweepVirtualGateways(region string) error {
 					d.SetId("????????????????") // ID not used in Delete.
 					d.Set("mesh_name", meshName)
 					d.Set("name", virtualGatewayName)
-					err := r.Delete(d, client)
+					err := sweep.DeleteResource(r, d, client)
 
 					if err != nil {
 						log.Printf("[ERROR] %s", err)
@@ -742,6 +742,7 @@ func resourceVirtualGatewayRead(d *schema.ResourceData, meta interface{}) error
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, appmesh.ErrCodeNotFoundException) {
 		log.Printf("[WARN] App Mesh Virtual Gateway (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -756,6 +757,7 @@ func resourceVirtualGatewayRead(d *schema.ResourceData, meta interface{}) error
 		}
 
 		log.Printf("[WARN] App Mesh Virtual Gateway (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -766,6 +768,7 @@ func resourceVirtualGatewayRead(d *schema.ResourceData, meta interface{}) error
 		}
 
 		log.Printf("[WARN] App Mesh Virtual Gateway (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
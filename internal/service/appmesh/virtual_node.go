@@ -1122,6 +1122,7 @@ func resourceVirtualNodeRead(d *schema.ResourceData, meta interface{}) error {
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, appmesh.ErrCodeNotFoundException) {
 		log.Printf("[WARN] App Mesh Virtual Node (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -1140,6 +1141,7 @@ func resourceVirtualNodeRead(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[WARN] App Mesh Virtual Node (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -189,6 +189,7 @@ func resourceVirtualRouterRead(d *schema.ResourceData, meta interface{}) error {
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, appmesh.ErrCodeNotFoundException) {
 		log.Printf("[WARN] App Mesh Virtual Router (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -207,6 +208,7 @@ func resourceVirtualRouterRead(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[WARN] App Mesh Virtual Router (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -197,6 +197,7 @@ func resourceVirtualServiceRead(d *schema.ResourceData, meta interface{}) error
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, appmesh.ErrCodeNotFoundException) {
 		log.Printf("[WARN] App Mesh Virtual Service (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -215,6 +216,7 @@ func resourceVirtualServiceRead(d *schema.ResourceData, meta interface{}) error
 		}
 
 		log.Printf("[WARN] App Mesh Virtual Service (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
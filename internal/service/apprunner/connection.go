@@ -100,6 +100,7 @@ func resourceConnectionRead(ctx context.Context, d *schema.ResourceData, meta in
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, apprunner.ErrCodeResourceNotFoundException) {
 		log.Printf("[WARN] App Runner Connection (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -113,6 +114,7 @@ func resourceConnectionRead(ctx context.Context, d *schema.ResourceData, meta in
 			return diag.FromErr(fmt.Errorf("error reading App Runner Connection (%s): empty output after creation", d.Id()))
 		}
 		log.Printf("[WARN] App Runner Connection (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -125,6 +125,7 @@ func resourceCustomDomainAssociationRead(ctx context.Context, d *schema.Resource
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, apprunner.ErrCodeResourceNotFoundException) {
 		log.Printf("[WARN] App Runner Custom Domain Association (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -134,6 +135,7 @@ func resourceCustomDomainAssociationRead(ctx context.Context, d *schema.Resource
 			return diag.FromErr(fmt.Errorf("error reading App Runner Custom Domain Association (%s): empty output after creation", d.Id()))
 		}
 		log.Printf("[WARN] App Runner Custom Domain Association (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
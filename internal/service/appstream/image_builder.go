@@ -245,6 +245,7 @@ func resourceImageBuilderRead(ctx context.Context, d *schema.ResourceData, meta
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) {
 		log.Printf("[WARN] Appstream ImageBuilder (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -92,6 +92,7 @@ func resourceAPIKeyRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	if key == nil {
 		log.Printf("[WARN] AppSync API Key %q not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
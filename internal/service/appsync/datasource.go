@@ -202,6 +202,7 @@ func resourceDataSourceRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, appsync.ErrCodeNotFoundException, "") {
 			log.Printf("[WARN] AppSync Datasource %q not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -125,6 +125,7 @@ func resourceFunctionRead(d *schema.ResourceData, meta interface{}) error {
 	resp, err := conn.GetFunction(input)
 	if tfawserr.ErrMessageContains(err, appsync.ErrCodeNotFoundException, "") {
 		log.Printf("[WARN] No such entity found for Appsync Function (%s)", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
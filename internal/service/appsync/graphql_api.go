@@ -272,6 +272,7 @@ func resourceGraphQLAPIRead(d *schema.ResourceData, meta interface{}) error {
 
 	if tfawserr.ErrMessageContains(err, appsync.ErrCodeNotFoundException, "") {
 		log.Printf("[WARN] No such entity found for Appsync Graphql API (%s)", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -179,6 +179,7 @@ func resourceResolverRead(d *schema.ResourceData, meta interface{}) error {
 
 	if tfawserr.ErrMessageContains(err, appsync.ErrCodeNotFoundException, "") {
 		log.Printf("[WARN] AppSync Resolver (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
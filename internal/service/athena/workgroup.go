@@ -186,6 +186,7 @@ func resourceWorkGroupRead(d *schema.ResourceData, meta interface{}) error {
 
 	if tfawserr.ErrMessageContains(err, athena.ErrCodeInvalidRequestException, "is not found") {
 		log.Printf("[WARN] Athena WorkGroup (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
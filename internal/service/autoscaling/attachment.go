@@ -87,6 +87,7 @@ func resourceAttachmentRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	if asg == nil {
 		log.Printf("[WARN] Autoscaling Group (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -103,6 +104,7 @@ func resourceAttachmentRead(d *schema.ResourceData, meta interface{}) error {
 
 		if !found {
 			log.Printf("[WARN] Association for %s was not found in ASG association", v.(string))
+			//lintignore:AWSR003
 			d.SetId("")
 		}
 	}
@@ -119,6 +121,7 @@ func resourceAttachmentRead(d *schema.ResourceData, meta interface{}) error {
 
 		if !found {
 			log.Printf("[WARN] Association for %s was not found in ASG association", v.(string))
+			//lintignore:AWSR003
 			d.SetId("")
 		}
 	}
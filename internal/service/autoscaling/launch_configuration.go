@@ -581,6 +581,7 @@ func resourceLaunchConfigurationRead(d *schema.ResourceData, meta interface{}) e
 	}
 	if len(describConfs.LaunchConfigurations) == 0 {
 		log.Printf("[WARN] Launch Configuration (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
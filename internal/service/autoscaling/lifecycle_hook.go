@@ -109,6 +109,7 @@ func resourceLifecycleHookRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	if p == nil {
 		log.Printf("[WARN] Autoscaling Lifecycle Hook (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
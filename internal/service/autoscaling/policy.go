@@ -14,7 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
-	"github.com/hashicorp/terraform-provider-aws/internal/experimental/nullable"
+	"github.com/hashicorp/terraform-provider-aws/internal/nullable"
 )
 
 func ResourcePolicy() *schema.Resource {
@@ -331,6 +331,7 @@ func resourcePolicyRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	if p == nil {
 		log.Printf("[WARN] Autoscaling Policy (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -171,6 +171,7 @@ func resourceScheduleRead(d *schema.ResourceData, meta interface{}) error {
 
 	if !exists {
 		log.Printf("[WARN] Autoscaling Scheduled Action (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -91,11 +91,13 @@ func resourceVaultRead(d *schema.ResourceData, meta interface{}) error {
 	resp, err := conn.DescribeBackupVault(input)
 	if tfawserr.ErrMessageContains(err, backup.ErrCodeResourceNotFoundException, "") {
 		log.Printf("[WARN] Backup Vault %s not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
 	if tfawserr.ErrMessageContains(err, "AccessDeniedException", "") {
 		log.Printf("[WARN] Backup Vault %s not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -83,6 +83,7 @@ func resourceVaultNotificationsRead(d *schema.ResourceData, meta interface{}) er
 	resp, err := conn.GetBackupVaultNotifications(input)
 	if tfawserr.ErrMessageContains(err, backup.ErrCodeResourceNotFoundException, "") {
 		log.Printf("[WARN] Backup Vault Notifcations %s not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -117,6 +117,7 @@ func resourceJobQueueRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	if jq == nil {
 		log.Printf("[WARN] Batch Job Queue (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
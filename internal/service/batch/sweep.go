@@ -141,7 +141,7 @@ func sweepComputeEnvironments(region string) error {
 				}
 			}
 
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 
 			if err != nil {
 				sweeperErr := fmt.Errorf("error deleting Batch Compute Environment (%s): %w", name, err)
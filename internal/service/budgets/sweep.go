@@ -60,7 +60,7 @@ func sweepBudgetActionss(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 			if err != nil {
 				sweeperErr := fmt.Errorf("error deleting Budget Action (%s): %w", name, err)
 				log.Printf("[ERROR] %s", sweeperErr)
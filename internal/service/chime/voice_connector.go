@@ -81,6 +81,7 @@ func resourceVoiceConnectorRead(ctx context.Context, d *schema.ResourceData, met
 	resp, err := conn.GetVoiceConnectorWithContext(ctx, getInput)
 	if !d.IsNewResource() && tfawserr.ErrMessageContains(err, chime.ErrCodeNotFoundException, "") {
 		log.Printf("[WARN] Chime Voice connector %s not found", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -84,6 +84,7 @@ func resourceVoiceConnectorGroupRead(ctx context.Context, d *schema.ResourceData
 	resp, err := conn.GetVoiceConnectorGroupWithContext(ctx, getInput)
 	if !d.IsNewResource() && tfawserr.ErrMessageContains(err, chime.ErrCodeNotFoundException, "") {
 		log.Printf("[WARN] Chime Voice conector group %s not found", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -67,6 +67,7 @@ func resourceVoiceConnectorLoggingRead(ctx context.Context, d *schema.ResourceDa
 	resp, err := conn.GetVoiceConnectorLoggingConfigurationWithContext(ctx, input)
 	if !d.IsNewResource() && tfawserr.ErrMessageContains(err, chime.ErrCodeNotFoundException, "") {
 		log.Printf("[WARN] Chime Voice Connector logging configuration %s not found", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -110,6 +110,7 @@ func resourceVoiceConnectorOriginationRead(ctx context.Context, d *schema.Resour
 
 	if !d.IsNewResource() && tfawserr.ErrMessageContains(err, chime.ErrCodeNotFoundException, "") {
 		log.Printf("[WARN] Chime Voice Connector (%s) origination not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -92,6 +92,7 @@ func resourceVoiceConnectorStreamingRead(ctx context.Context, d *schema.Resource
 	resp, err := conn.GetVoiceConnectorStreamingConfigurationWithContext(ctx, input)
 	if !d.IsNewResource() && tfawserr.ErrMessageContains(err, chime.ErrCodeNotFoundException, "") {
 		log.Printf("[WARN] Chime Voice Connector (%s) streaming not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
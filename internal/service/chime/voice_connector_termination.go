@@ -117,6 +117,7 @@ func resourceVoiceConnectorTerminationRead(ctx context.Context, d *schema.Resour
 
 	if !d.IsNewResource() && tfawserr.ErrMessageContains(err, chime.ErrCodeNotFoundException, "") {
 		log.Printf("[WARN] Chime Voice Connector (%s) termination not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
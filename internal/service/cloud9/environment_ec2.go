@@ -169,6 +169,7 @@ func resourceEnvironmentEC2Read(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, cloud9.ErrCodeNotFoundException, "") {
 			log.Printf("[WARN] Cloud9 Environment EC2 (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -176,6 +177,7 @@ func resourceEnvironmentEC2Read(d *schema.ResourceData, meta interface{}) error
 	}
 	if len(out.Environments) == 0 {
 		log.Printf("[WARN] Cloud9 Environment EC2 (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+//waiter:status Name=ProgressEventOperation Status=OperationStatus
 func FindProgressEventByRequestToken(ctx context.Context, conn *cloudcontrolapi.CloudControlApi, requestToken string) (*cloudcontrolapi.ProgressEvent, error) {
 	input := &cloudcontrolapi.GetResourceRequestStatusInput{
 		RequestToken: aws.String(requestToken),
@@ -214,6 +214,7 @@ func resourceStackRead(d *schema.ResourceData, meta interface{}) error {
 	resp, err := conn.DescribeStacks(input)
 	if tfawserr.ErrCodeEquals(err, "ValidationError") {
 		log.Printf("[WARN] CloudFormation stack (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -224,6 +225,7 @@ func resourceStackRead(d *schema.ResourceData, meta interface{}) error {
 	stacks := resp.Stacks
 	if len(stacks) < 1 {
 		log.Printf("[WARN] CloudFormation stack (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -231,6 +233,7 @@ func resourceStackRead(d *schema.ResourceData, meta interface{}) error {
 	stack := stacks[0]
 	if aws.StringValue(stack.StackStatus) == cloudformation.StackStatusDeleteComplete {
 		log.Printf("[WARN] CloudFormation stack (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -865,6 +865,7 @@ func resourceDistributionRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if errcode, ok := err.(awserr.Error); ok && errcode.Code() == "NoSuchDistribution" {
 			log.Printf("[WARN] No Distribution found: %s", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -77,6 +77,7 @@ func resourceKeyGroupRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if !d.IsNewResource() && tfawserr.ErrMessageContains(err, cloudfront.ErrCodeNoSuchResource, "") {
 			log.Printf("[WARN] No key group found: %s, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
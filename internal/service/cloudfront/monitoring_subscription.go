@@ -86,6 +86,7 @@ func resourceMonitoringSubscriptionRead(d *schema.ResourceData, meta interface{}
 
 	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchDistribution) {
 		log.Printf("[WARN] CloudFront Distribution (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -99,6 +100,7 @@ func resourceMonitoringSubscriptionRead(d *schema.ResourceData, meta interface{}
 			return fmt.Errorf("error reading CloudFront Monitoring Subscription (%s): not found", d.Id())
 		}
 		log.Printf("[WARN] CloudFront Monitoring Subscription (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -77,6 +77,7 @@ func resourceOriginAccessIdentityRead(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, cloudfront.ErrCodeNoSuchCloudFrontOriginAccessIdentity, "") {
 			log.Printf("[WARN] CloudFront Origin Access Identity (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -151,6 +151,7 @@ func resourceOriginRequestPolicyRead(d *schema.ResourceData, meta interface{}) e
 	resp, err := conn.GetOriginRequestPolicy(request)
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		log.Printf("[WARN] CloudFront Origin Request Policy (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
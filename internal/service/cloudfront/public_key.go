@@ -96,6 +96,7 @@ func resourcePublicKeyRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, cloudfront.ErrCodeNoSuchPublicKey, "") {
 			log.Printf("[WARN] No PublicKey found: %s, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -104,6 +105,7 @@ func resourcePublicKeyRead(d *schema.ResourceData, meta interface{}) error {
 
 	if output == nil || output.PublicKey == nil || output.PublicKey.PublicKeyConfig == nil {
 		log.Printf("[WARN] No PublicKey found: %s, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
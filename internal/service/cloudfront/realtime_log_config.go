@@ -119,6 +119,7 @@ func resourceRealtimeLogConfigRead(d *schema.ResourceData, meta interface{}) err
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchRealtimeLogConfig) {
 		log.Printf("[WARN] CloudFront Real-time Log Config (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -132,6 +133,7 @@ func resourceRealtimeLogConfigRead(d *schema.ResourceData, meta interface{}) err
 			return fmt.Errorf("error reading CloudFront Real-time Log Config (%s): not found", d.Id())
 		}
 		log.Printf("[WARN] CloudFront Real-time Log Config (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
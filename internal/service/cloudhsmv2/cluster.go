@@ -170,6 +170,7 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[WARN] CloudHSMv2 Cluster (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -180,6 +181,7 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[WARN] CloudHSMv2 Cluster (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
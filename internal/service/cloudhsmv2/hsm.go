@@ -136,6 +136,7 @@ func resourceHSMRead(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[WARN] CloudHSMv2 HSM (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
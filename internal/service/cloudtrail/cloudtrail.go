@@ -360,6 +360,7 @@ func resourceCloudTrailRead(d *schema.ResourceData, meta interface{}) error {
 
 	if trail == nil {
 		log.Printf("[WARN] CloudTrail (%s) not found", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -118,6 +118,7 @@ func resourceCompositeAlarmRead(ctx context.Context, d *schema.ResourceData, met
 	alarm, err := FindCompositeAlarmByName(ctx, conn, name)
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, cloudwatch.ErrCodeResourceNotFound) {
 		log.Printf("[WARN] CloudWatch Composite Alarm %s not found, removing from state", name)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -132,6 +133,7 @@ func resourceCompositeAlarmRead(ctx context.Context, d *schema.ResourceData, met
 		}
 
 		log.Printf("[WARN] CloudWatch Composite Alarm %s not found, removing from state", name)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
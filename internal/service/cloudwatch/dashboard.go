@@ -67,6 +67,7 @@ func resourceDashboardRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if IsDashboardNotFoundErr(err) {
 			log.Printf("[WARN] CloudWatch Dashboard %q not found, removing", dashboardName)
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -313,6 +313,7 @@ func resourceMetricAlarmRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 	if resp == nil {
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -116,6 +116,7 @@ func resourceAPIDestinationRead(d *schema.ResourceData, meta interface{}) error
 	output, err := conn.DescribeApiDestination(input)
 	if tfawserr.ErrMessageContains(err, events.ErrCodeResourceNotFoundException, "") {
 		log.Printf("[WARN] CloudWatchEvent API Destination (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
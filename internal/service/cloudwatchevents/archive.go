@@ -96,6 +96,7 @@ func resourceArchiveRead(d *schema.ResourceData, meta interface{}) error {
 
 	if tfawserr.ErrMessageContains(err, events.ErrCodeResourceNotFoundException, "") {
 		log.Printf("[WARN] CloudWatch Events archive (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -93,6 +93,7 @@ func resourceBusRead(d *schema.ResourceData, meta interface{}) error {
 	output, err := conn.DescribeEventBus(input)
 	if tfawserr.ErrMessageContains(err, events.ErrCodeResourceNotFoundException, "") {
 		log.Printf("[WARN] CloudWatch Events event bus (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -181,7 +181,7 @@ func sweepBuses(region string) error {
 			r := ResourceBus()
 			d := r.Data(nil)
 			d.SetId(name)
-			err = r.Delete(d, client)
+			err = sweep.DeleteResource(r, d, client)
 
 			if err != nil {
 				log.Printf("[ERROR] %s", err)
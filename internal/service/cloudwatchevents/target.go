@@ -433,6 +433,7 @@ func resourceTargetRead(d *schema.ResourceData, meta interface{}) error {
 			tfawserr.ErrCodeEquals(err, events.ErrCodeResourceNotFoundException) ||
 			regexp.MustCompile(" not found$").MatchString(err.Error()) {
 			log.Printf("[WARN] CloudWatch Events Target (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -106,6 +106,7 @@ func resourceDestinationRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if !exists {
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -70,6 +70,7 @@ func resourceDestinationPolicyRead(d *schema.ResourceData, meta interface{}) err
 
 	if !exists || destination.AccessPolicy == nil {
 		log.Printf("[WARN] CloudWatch Log Destination Policy (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
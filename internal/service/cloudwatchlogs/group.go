@@ -135,6 +135,7 @@ func resourceGroupRead(d *schema.ResourceData, meta interface{}) error {
 
 	if lg == nil {
 		log.Printf("[DEBUG] CloudWatch Group %q Not Found", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
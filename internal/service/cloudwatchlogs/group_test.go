@@ -2,6 +2,7 @@ package cloudwatchlogs_test
 
 import (
 	"fmt"
+	"path/filepath"
 	"regexp"
 	"testing"
 
@@ -20,10 +21,10 @@ func TestAccCloudWatchLogsGroup_basic(t *testing.T) {
 	resourceName := "aws_cloudwatch_log_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(t) },
-		ErrorCheck:   acctest.ErrorCheck(t, cloudwatchlogs.EndpointsID),
-		Providers:    acctest.Providers,
-		CheckDestroy: testAccCheckGroupDestroy,
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudwatchlogs.EndpointsID),
+		ProviderFactories: acctest.FactoriesRecordFixtures(t),
+		CheckDestroy:      testAccCheckGroupDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccGroupConfig(rInt),
@@ -45,6 +46,35 @@ func TestAccCloudWatchLogsGroup_basic(t *testing.T) {
 	})
 }
 
+// TestGroupReplay runs TestAccCloudWatchLogsGroup_basic against the AWS API interactions it recorded,
+// without an AWS account.
+// Re-record them by running the acceptance test with TF_ACC_RECORD_FIXTURES_DIR=test-fixtures.
+func TestGroupReplay(t *testing.T) {
+	resourceName := "aws_cloudwatch_log_group.test"
+
+	// The recorded log group does not exist after the test, so there is nothing to check on destroy.
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: acctest.FactoriesReplayFixtures(t, filepath.Join("test-fixtures", acctest.FixturesFilename("TestAccCloudWatchLogsGroup_basic"))),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupReplayConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "arn", "arn:aws:logs:us-west-2:123456789012:log-group:foo-bar-1559762024720475269"), //lintignore:AWSAT003,AWSAT005
+					resource.TestCheckResourceAttr(resourceName, "name", "foo-bar-1559762024720475269"),
+					resource.TestCheckResourceAttr(resourceName, "retention_in_days", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"retention_in_days"}, //this has a default value
+			},
+		},
+	})
+}
+
 func TestAccCloudWatchLogsGroup_namePrefix(t *testing.T) {
 	var lg cloudwatchlogs.LogGroup
 	resourceName := "aws_cloudwatch_log_group.test"
@@ -385,6 +415,13 @@ resource "aws_cloudwatch_log_group" "test" {
 `, rInt)
 }
 
+// testAccGroupReplayConfig is testAccGroupConfig for the log group recorded in the replay fixtures.
+const testAccGroupReplayConfig = `
+resource "aws_cloudwatch_log_group" "test" {
+  name = "foo-bar-1559762024720475269"
+}
+`
+
 func testAccGroupWithTagsConfig(rInt int) string {
 	return fmt.Sprintf(`
 resource "aws_cloudwatch_log_group" "test" {
@@ -3,7 +3,6 @@ package cloudwatchlogs
 import (
 	"fmt"
 	"log"
-	"strconv"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -14,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/nullable"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -78,9 +78,9 @@ func ResourceMetricFilter() *schema.Resource {
 							ValidateFunc: validation.StringLenBetween(0, 100),
 						},
 						"default_value": {
-							Type:         schema.TypeString,
+							Type:         nullable.TypeNullableFloat,
 							Optional:     true,
-							ValidateFunc: verify.ValidTypeStringNullableFloat,
+							ValidateFunc: nullable.ValidateTypeStringNullableFloat,
 						},
 						"dimensions": {
 							Type:     schema.TypeMap,
@@ -245,9 +245,8 @@ func expandCloudWatchLogMetricTransformations(m map[string]interface{}) []*cloud
 		MetricValue:     aws.String(m["value"].(string)),
 	}
 
-	if m["default_value"].(string) != "" {
-		value, _ := strconv.ParseFloat(m["default_value"].(string), 64)
-		transformation.DefaultValue = aws.Float64(value)
+	if v, null, _ := nullable.Float(m["default_value"].(string)).Value(); !null {
+		transformation.DefaultValue = aws.Float64(v)
 	}
 
 	if dims := m["dimensions"].(map[string]interface{}); len(dims) > 0 {
@@ -273,7 +272,7 @@ func flattenCloudWatchLogMetricTransformations(ts []*cloudwatchlogs.MetricTransf
 	if transform.DefaultValue == nil {
 		m["default_value"] = ""
 	} else {
-		m["default_value"] = strconv.FormatFloat(aws.Float64Value(transform.DefaultValue), 'f', -1, 64)
+		m["default_value"] = string(nullable.NewFloat(aws.Float64Value(transform.DefaultValue)))
 	}
 
 	if dims := transform.Dimensions; len(dims) > 0 {
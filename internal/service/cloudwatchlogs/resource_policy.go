@@ -71,6 +71,7 @@ func resourceResourcePolicyRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if !exists {
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -98,6 +98,7 @@ func resourceStreamRead(d *schema.ResourceData, meta interface{}) error {
 
 	if !exists {
 		log.Printf("[DEBUG] CloudWatch Stream %q Not Found. Removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
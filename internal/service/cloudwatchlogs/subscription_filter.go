@@ -169,6 +169,7 @@ func resourceSubscriptionFilterRead(d *schema.ResourceData, meta interface{}) er
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "ResourceNotFoundException" {
 			log.Printf("[WARN] SubscriptionFilters (%q) Not Found", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -188,6 +189,7 @@ func resourceSubscriptionFilterRead(d *schema.ResourceData, meta interface{}) er
 	}
 
 	log.Printf("[DEBUG] Subscription Filter%q Not Found", name)
+	//lintignore:AWSR003
 	d.SetId("")
 	return nil
 }
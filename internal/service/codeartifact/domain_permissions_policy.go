@@ -97,6 +97,7 @@ func resourceDomainPermissionsPolicyRead(d *schema.ResourceData, meta interface{
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, codeartifact.ErrCodeResourceNotFoundException, "") {
 			log.Printf("[WARN] CodeArtifact Domain Permissions Policy %q not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -104,6 +104,7 @@ func resourceRepositoryPermissionsPolicyRead(d *schema.ResourceData, meta interf
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, codeartifact.ErrCodeResourceNotFoundException, "") {
 			log.Printf("[WARN] CodeArtifact Repository Permissions Policy %q not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -1255,6 +1255,7 @@ func resourceProjectRead(d *schema.ResourceData, meta interface{}) error {
 	// if nothing was found, then return no state
 	if len(resp.Projects) == 0 {
 		log.Printf("[INFO]: No projects were found, removing from state")
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
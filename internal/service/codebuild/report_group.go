@@ -138,6 +138,7 @@ func resourceReportGroupRead(d *schema.ResourceData, meta interface{}) error {
 
 	if reportGroup == nil {
 		log.Printf("[WARN] CodeBuild Report Group (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -105,6 +105,7 @@ func resourceSourceCredentialRead(d *schema.ResourceData, meta interface{}) erro
 
 	if info == nil {
 		log.Printf("[WARN] CodeBuild Source Credential (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
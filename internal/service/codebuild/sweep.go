@@ -45,7 +45,7 @@ func sweepReportGroups(region string) error {
 			d.SetId(id)
 			d.Set("delete_reports", true)
 
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 			if err != nil {
 				sweeperErr := fmt.Errorf("error deleting CodeBuild Report Group (%s): %w", id, err)
 				log.Printf("[ERROR] %s", sweeperErr)
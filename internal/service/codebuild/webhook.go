@@ -172,6 +172,7 @@ func resourceWebhookRead(d *schema.ResourceData, meta interface{}) error {
 
 	if len(resp.Projects) == 0 {
 		log.Printf("[WARN] CodeBuild Project %q not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -180,6 +181,7 @@ func resourceWebhookRead(d *schema.ResourceData, meta interface{}) error {
 
 	if project.Webhook == nil {
 		log.Printf("[WARN] CodeBuild Project %q webhook not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
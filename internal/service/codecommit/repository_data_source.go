@@ -58,6 +58,7 @@ func dataSourceRepositoryRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, codecommit.ErrCodeRepositoryDoesNotExistException, "") {
 			log.Printf("[WARN] CodeCommit Repository (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return fmt.Errorf("Resource codecommit repository not found for %s", repositoryName)
 		} else {
@@ -138,6 +138,7 @@ func resourceAppRead(d *schema.ResourceData, meta interface{}) error {
 	})
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, codedeploy.ErrCodeApplicationDoesNotExistException, "") {
+			//lintignore:AWSR003
 			d.SetId("")
 			log.Printf("[WARN] CodeDeploy Application (%s) not found, removing from state", d.Id())
 			return nil
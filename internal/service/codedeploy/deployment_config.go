@@ -171,6 +171,7 @@ func resourceDeploymentConfigRead(d *schema.ResourceData, meta interface{}) erro
 		if awsErr, ok := err.(awserr.Error); ok {
 			if awsErr.Code() == "DeploymentConfigDoesNotExistException" {
 				log.Printf("[DEBUG] CodeDeploy Deployment Config (%s) not found", d.Id())
+				//lintignore:AWSR003
 				d.SetId("")
 				return nil
 			}
@@ -601,6 +601,7 @@ func resourceDeploymentGroupRead(d *schema.ResourceData, meta interface{}) error
 		if tfawserr.ErrMessageContains(err, codedeploy.ErrCodeDeploymentGroupDoesNotExistException, "") ||
 			tfawserr.ErrMessageContains(err, codedeploy.ErrCodeApplicationDoesNotExistException, "") {
 			log.Printf("[INFO] CodeDeployment DeploymentGroup %s not found", deploymentGroupName)
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -105,6 +105,7 @@ func resourceConnectionRead(d *schema.ResourceData, meta interface{}) error {
 	connection, err := findConnectionByARN(conn, d.Id())
 	if tfawserr.ErrCodeEquals(err, codestarconnections.ErrCodeResourceNotFoundException) {
 		log.Printf("[WARN] CodeStar connection (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -167,6 +167,7 @@ func resourceNotificationRuleRead(d *schema.ResourceData, meta interface{}) erro
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, codestarnotifications.ErrCodeResourceNotFoundException, "") {
 			log.Printf("[WARN] codestar notification rule (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -174,6 +174,7 @@ func resourcePoolRead(d *schema.ResourceData, meta interface{}) error {
 	})
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == cognitoidentity.ErrCodeResourceNotFoundException {
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
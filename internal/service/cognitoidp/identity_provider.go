@@ -132,6 +132,7 @@ func resourceIdentityProviderRead(d *schema.ResourceData, meta interface{}) erro
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
 			log.Printf("[WARN] Cognito Identity Provider %q not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -140,6 +141,7 @@ func resourceIdentityProviderRead(d *schema.ResourceData, meta interface{}) erro
 
 	if ret == nil || ret.IdentityProvider == nil {
 		log.Printf("[WARN] Cognito Identity Provider %q not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -121,6 +121,7 @@ func resourceResourceServerRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
 			log.Printf("[WARN] Cognito Resource Server %q not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -129,6 +130,7 @@ func resourceResourceServerRead(d *schema.ResourceData, meta interface{}) error
 
 	if resp == nil || resp.ResourceServer == nil {
 		log.Printf("[WARN] Cognito Resource Server %q not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -104,6 +104,7 @@ func resourceUserGroupRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, "ResourceNotFoundException", "") {
 			log.Printf("[WARN] Cognito User Group %s is already gone", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -353,6 +353,7 @@ func resourceUserPoolClientRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
 			log.Printf("[WARN] Cognito User Pool Client %s is already gone", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
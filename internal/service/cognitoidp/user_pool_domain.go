@@ -107,6 +107,7 @@ func resourceUserPoolDomainRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
 			log.Printf("[WARN] Cognito User Pool Domain %q not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -117,6 +118,7 @@ func resourceUserPoolDomainRead(d *schema.ResourceData, meta interface{}) error
 
 	if desc.Status == nil {
 		log.Printf("[WARN] Cognito User Pool Domain %q not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
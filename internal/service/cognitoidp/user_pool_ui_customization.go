@@ -120,6 +120,7 @@ func resourceUserPoolUICustomizationRead(d *schema.ResourceData, meta interface{
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, cognitoidentityprovider.ErrCodeResourceNotFoundException) {
 		log.Printf("[WARN] Cognito User Pool UI customization (UserPoolId: %s, ClientId: %s) not found, removing from state", userPoolId, clientId)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -134,6 +135,7 @@ func resourceUserPoolUICustomizationRead(d *schema.ResourceData, meta interface{
 		}
 
 		log.Printf("[WARN] Cognito User Pool UI customization (UserPoolId: %s, ClientId: %s) not found, removing from state", userPoolId, clientId)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -100,6 +100,7 @@ func resourceAggregateAuthorizationRead(d *schema.ResourceData, meta interface{}
 
 	if aggregationAuthorization == nil {
 		log.Printf("[WARN] Aggregate Authorization not found, removing from state: %s", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -223,6 +223,7 @@ func resourceConfigRuleRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoSuchConfigRuleException" {
 			log.Printf("[WARN] Config Rule %q is gone (NoSuchConfigRuleException)", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -232,6 +233,7 @@ func resourceConfigRuleRead(d *schema.ResourceData, meta interface{}) error {
 	numberOfRules := len(out.ConfigRules)
 	if numberOfRules < 1 {
 		log.Printf("[WARN] Config Rule %q is gone (no rules found)", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
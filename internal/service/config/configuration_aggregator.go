@@ -167,6 +167,7 @@ func resourceConfigurationAggregatorRead(d *schema.ResourceData, meta interface{
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, configservice.ErrCodeNoSuchConfigurationAggregatorException, "") {
 			log.Printf("[WARN] No such configuration aggregator (%s), removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -175,6 +176,7 @@ func resourceConfigurationAggregatorRead(d *schema.ResourceData, meta interface{
 
 	if res == nil || len(res.ConfigurationAggregators) == 0 {
 		log.Printf("[WARN] No aggregators returned (%s), removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
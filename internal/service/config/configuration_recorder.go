@@ -102,6 +102,7 @@ func resourceConfigurationRecorderRead(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, configservice.ErrCodeNoSuchConfigurationRecorderException, "") {
 			log.Printf("[WARN] Configuration Recorder %q is gone (NoSuchConfigurationRecorderException)", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -111,6 +112,7 @@ func resourceConfigurationRecorderRead(d *schema.ResourceData, meta interface{})
 	numberOfRecorders := len(out.ConfigurationRecorders)
 	if numberOfRecorders < 1 {
 		log.Printf("[WARN] Configuration Recorder %q is gone (no recorders found)", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
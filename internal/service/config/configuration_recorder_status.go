@@ -81,6 +81,7 @@ func resourceConfigurationRecorderStatusRead(d *schema.ResourceData, meta interf
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, configservice.ErrCodeNoSuchConfigurationRecorderException, "") {
 			log.Printf("[WARN] Configuration Recorder (status) %q is gone (NoSuchConfigurationRecorderException)", name)
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -91,6 +92,7 @@ func resourceConfigurationRecorderStatusRead(d *schema.ResourceData, meta interf
 	numberOfStatuses := len(statusOut.ConfigurationRecordersStatus)
 	if numberOfStatuses < 1 {
 		log.Printf("[WARN] Configuration Recorder (status) %q is gone (no recorders found)", name)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
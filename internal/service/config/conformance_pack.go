@@ -143,6 +143,7 @@ func resourceConformancePackRead(d *schema.ResourceData, meta interface{}) error
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchConformancePackException) {
 		log.Printf("[WARN] Config Conformance Pack (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -157,6 +158,7 @@ func resourceConformancePackRead(d *schema.ResourceData, meta interface{}) error
 		}
 
 		log.Printf("[WARN] Config Conformance Pack (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
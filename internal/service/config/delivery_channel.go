@@ -140,6 +140,7 @@ func resourceDeliveryChannelRead(d *schema.ResourceData, meta interface{}) error
 		if awsErr, ok := err.(awserr.Error); ok {
 			if awsErr.Code() == "NoSuchDeliveryChannelException" {
 				log.Printf("[WARN] Delivery Channel %q is gone (NoSuchDeliveryChannelException)", d.Id())
+				//lintignore:AWSR003
 				d.SetId("")
 				return nil
 			}
@@ -149,6 +150,7 @@ func resourceDeliveryChannelRead(d *schema.ResourceData, meta interface{}) error
 
 	if len(out.DeliveryChannels) < 1 {
 		log.Printf("[WARN] Delivery Channel %q is gone (no channels found)", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
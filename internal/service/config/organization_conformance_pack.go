@@ -165,6 +165,7 @@ func resourceOrganizationConformancePackRead(d *schema.ResourceData, meta interf
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchOrganizationConformancePackException) {
 		log.Printf("[WARN] Config Organization Conformance Pack (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -179,6 +180,7 @@ func resourceOrganizationConformancePackRead(d *schema.ResourceData, meta interf
 		}
 
 		log.Printf("[WARN] Config Organization Conformance Pack (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -190,6 +190,7 @@ func resourceOrganizationCustomRuleRead(d *schema.ResourceData, meta interface{}
 
 	if tfawserr.ErrMessageContains(err, configservice.ErrCodeNoSuchOrganizationConfigRuleException, "") {
 		log.Printf("[WARN] Config Organization Custom Rule (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -200,6 +201,7 @@ func resourceOrganizationCustomRuleRead(d *schema.ResourceData, meta interface{}
 
 	if rule == nil {
 		log.Printf("[WARN] Config Organization Custom Rule (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -175,6 +175,7 @@ func resourceOrganizationManagedRuleRead(d *schema.ResourceData, meta interface{
 
 	if tfawserr.ErrMessageContains(err, configservice.ErrCodeNoSuchOrganizationConfigRuleException, "") {
 		log.Printf("[WARN] Config Organization Managed Rule (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -185,6 +186,7 @@ func resourceOrganizationManagedRuleRead(d *schema.ResourceData, meta interface{
 
 	if rule == nil {
 		log.Printf("[WARN] Config Organization Managed Rule (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
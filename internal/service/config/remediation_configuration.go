@@ -185,6 +185,7 @@ func resourceRemediationConfigurationRead(d *schema.ResourceData, meta interface
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, configservice.ErrCodeNoSuchConfigRuleException, "") {
 			log.Printf("[WARN] Config Rule %q is gone (NoSuchConfigRuleException)", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -194,6 +195,7 @@ func resourceRemediationConfigurationRead(d *schema.ResourceData, meta interface
 	numberOfRemediationConfigurations := len(out.RemediationConfigurations)
 	if numberOfRemediationConfigurations < 1 {
 		log.Printf("[WARN] No Remediation Configuration for Config Rule %q (no remediation configuration found)", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -161,6 +161,7 @@ func resourceContactFlowRead(ctx context.Context, d *schema.ResourceData, meta i
 
 	if !d.IsNewResource() && tfawserr.ErrMessageContains(err, connect.ErrCodeResourceNotFoundException, "") {
 		log.Printf("[WARN] Connect Contact Flow (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -172,6 +172,7 @@ func resourceReportDefinitionRead(d *schema.ResourceData, meta interface{}) erro
 			return fmt.Errorf("error reading Cost And Usage Report Definition (%s): not found after creation", d.Id())
 		}
 		log.Printf("[WARN] Cost And Usage Report Definition (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -50,7 +50,7 @@ func sweepReportDefinitions(region string) error {
 			r := ResourceReportDefinition()
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(reportDefinition.ReportName))
-			err = r.Delete(d, client)
+			err = sweep.DeleteResource(r, d, client)
 
 			if err != nil {
 				log.Printf("[ERROR] %s", err)
@@ -82,6 +82,7 @@ func resourcePipelineRead(d *schema.ResourceData, meta interface{}) error {
 	v, err := PipelineRetrieve(d.Id(), conn)
 	if tfawserr.ErrMessageContains(err, datapipeline.ErrCodePipelineNotFoundException, "") || tfawserr.ErrMessageContains(err, datapipeline.ErrCodePipelineDeletedException, "") || v == nil {
 		log.Printf("[WARN] DataPipeline (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
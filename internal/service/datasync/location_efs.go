@@ -123,6 +123,7 @@ func resourceLocationEFSRead(d *schema.ResourceData, meta interface{}) error {
 
 	if tfawserr.ErrMessageContains(err, "InvalidRequestException", "not found") {
 		log.Printf("[WARN] DataSync Location EFS %q not found - removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -151,6 +151,7 @@ func resourceLocationFSxWindowsFileSystemRead(d *schema.ResourceData, meta inter
 
 	if tfawserr.ErrMessageContains(err, datasync.ErrCodeInvalidRequestException, "not found") {
 		log.Printf("[WARN] DataSync Location Fsx Windows %q not found - removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
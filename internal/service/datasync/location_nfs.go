@@ -139,6 +139,7 @@ func resourceLocationNFSRead(d *schema.ResourceData, meta interface{}) error {
 
 	if tfawserr.ErrMessageContains(err, "InvalidRequestException", "not found") {
 		log.Printf("[WARN] DataSync Location NFS %q not found - removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
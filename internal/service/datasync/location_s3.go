@@ -169,6 +169,7 @@ func resourceLocationS3Read(d *schema.ResourceData, meta interface{}) error {
 
 	if tfawserr.ErrMessageContains(err, "InvalidRequestException", "not found") {
 		log.Printf("[WARN] DataSync Location S3 %q not found - removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
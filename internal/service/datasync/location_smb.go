@@ -149,6 +149,7 @@ func resourceLocationSMBRead(d *schema.ResourceData, meta interface{}) error {
 
 	if tfawserr.ErrMessageContains(err, "InvalidRequestException", "not found") {
 		log.Printf("[WARN] DataSync Location SMB %q not found - removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -257,7 +257,7 @@ func sweepLocationNFSs(region string) error {
 			r := ResourceLocationNFS()
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(location.LocationArn))
-			err = r.Delete(d, client)
+			err = sweep.DeleteResource(r, d, client)
 			if tfawserr.ErrMessageContains(err, "InvalidRequestException", "not found") {
 				continue
 			}
@@ -370,7 +370,7 @@ func sweepLocationSMBs(region string) error {
 			r := ResourceLocationSMB()
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(location.LocationArn))
-			err = r.Delete(d, client)
+			err = sweep.DeleteResource(r, d, client)
 			if tfawserr.ErrMessageContains(err, "InvalidRequestException", "not found") {
 				continue
 			}
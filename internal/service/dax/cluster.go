@@ -291,6 +291,7 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, dax.ErrCodeClusterNotFoundFault, "") {
 			log.Printf("[WARN] DAX cluster (%s) not found", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -300,6 +301,7 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 
 	if len(res.Clusters) == 0 {
 		log.Printf("[WARN] DAX cluster (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -85,6 +85,7 @@ func resourceParameterGroupRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, dax.ErrCodeParameterGroupNotFoundFault, "") {
 			log.Printf("[WARN] DAX ParameterGroup %q not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -93,6 +94,7 @@ func resourceParameterGroupRead(d *schema.ResourceData, meta interface{}) error
 
 	if len(resp.ParameterGroups) == 0 {
 		log.Printf("[WARN] DAX ParameterGroup %q not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -105,6 +107,7 @@ func resourceParameterGroupRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, dax.ErrCodeParameterGroupNotFoundFault, "") {
 			log.Printf("[WARN] DAX ParameterGroup %q not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
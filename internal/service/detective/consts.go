@@ -0,0 +1,14 @@
+package detective
+
+import (
+	"time"
+)
+
+const (
+	invitationAccepterDefaultCreateTimeout = 1 * time.Minute
+
+	memberInvitedTimeout = 5 * time.Minute
+
+	organizationAdminAccountEnabledTimeout  = 5 * time.Minute
+	organizationAdminAccountNotFoundTimeout = 5 * time.Minute
+)
@@ -0,0 +1,152 @@
+package detective
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindGraphByARN(ctx context.Context, conn *detective.Detective, arn string) (*detective.Graph, error) {
+	input := &detective.ListGraphsInput{}
+	var result *detective.Graph
+
+	err := conn.ListGraphsPagesWithContext(ctx, input, func(page *detective.ListGraphsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, graph := range page.GraphList {
+			if graph == nil {
+				continue
+			}
+
+			if aws.StringValue(graph.Arn) == arn {
+				result = graph
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return result, nil
+}
+
+// FindInvitationByGraphARN returns the behavior graph invitation received by the caller's account.
+func FindInvitationByGraphARN(ctx context.Context, conn *detective.Detective, graphARN string) (*detective.MemberDetail, error) {
+	input := &detective.ListInvitationsInput{}
+	var result *detective.MemberDetail
+
+	err := conn.ListInvitationsPagesWithContext(ctx, input, func(page *detective.ListInvitationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, invitation := range page.Invitations {
+			if invitation == nil {
+				continue
+			}
+
+			if aws.StringValue(invitation.GraphArn) == graphARN {
+				result = invitation
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return result, nil
+}
+
+func FindOrganizationAdminAccountByAccountID(ctx context.Context, conn *detective.Detective, accountID string) (*detective.Administrator, error) {
+	input := &detective.ListOrganizationAdminAccountsInput{}
+	var result *detective.Administrator
+
+	err := conn.ListOrganizationAdminAccountsPagesWithContext(ctx, input, func(page *detective.ListOrganizationAdminAccountsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, administrator := range page.Administrators {
+			if administrator == nil {
+				continue
+			}
+
+			if aws.StringValue(administrator.AccountId) == accountID {
+				result = administrator
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return result, nil
+}
+
+//waiter:status Name=Member Status=Status
+//waiter:wait Name=MemberInvited Status=Member Pending=detective.MemberStatusVerificationInProgress Target=detective.MemberStatusInvited,detective.MemberStatusEnabled,detective.MemberStatusAcceptedButDisabled
+func FindMemberByGraphARNAndAccountID(ctx context.Context, conn *detective.Detective, graphARN, accountID string) (*detective.MemberDetail, error) {
+	input := &detective.GetMembersInput{
+		AccountIds: aws.StringSlice([]string{accountID}),
+		GraphArn:   aws.String(graphARN),
+	}
+
+	output, err := conn.GetMembersWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, detective.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.MemberDetails) == 0 || output.MemberDetails[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.MemberDetails); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.MemberDetails[0], nil
+}
@@ -0,0 +1,26 @@
+package detective
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Graph ARNs contain colons, so use a slash to separate the ID parts.
+const memberResourceIDSeparator = "/"
+
+func MemberCreateResourceID(graphARN, accountID string) string {
+	parts := []string{graphARN, accountID}
+	id := strings.Join(parts, memberResourceIDSeparator)
+
+	return id
+}
+
+func MemberParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, memberResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected graph-arn%[2]saccount-id", id, memberResourceIDSeparator)
+}
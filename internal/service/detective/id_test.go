@@ -0,0 +1,61 @@
+package detective_test
+
+import (
+	"testing"
+
+	tfdetective "github.com/hashicorp/terraform-provider-aws/internal/service/detective"
+)
+
+func TestMemberParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName          string
+		InputID           string
+		ExpectError       bool
+		ExpectedGraphARN  string
+		ExpectedAccountID string
+	}{
+		{
+			TestName:    "empty ID",
+			InputID:     "",
+			ExpectError: true,
+		},
+		{
+			TestName:    "incorrect format",
+			InputID:     "test",
+			ExpectError: true,
+		},
+		{
+			TestName:    "missing account ID",
+			InputID:     "arn:aws:detective:us-east-1:123456789012:graph:abcdef0123456789/",
+			ExpectError: true,
+		},
+		{
+			TestName:          "valid ID",
+			InputID:           tfdetective.MemberCreateResourceID("arn:aws:detective:us-east-1:123456789012:graph:abcdef0123456789", "111111111111"),
+			ExpectedGraphARN:  "arn:aws:detective:us-east-1:123456789012:graph:abcdef0123456789",
+			ExpectedAccountID: "111111111111",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotGraphARN, gotAccountID, err := tfdetective.MemberParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error")
+			}
+
+			if gotGraphARN != testCase.ExpectedGraphARN {
+				t.Errorf("got graph ARN %s, expected %s", gotGraphARN, testCase.ExpectedGraphARN)
+			}
+
+			if gotAccountID != testCase.ExpectedAccountID {
+				t.Errorf("got account ID %s, expected %s", gotAccountID, testCase.ExpectedAccountID)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package detective
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceInvitationAccepter() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceInvitationAccepterCreate,
+		ReadContext:   resourceInvitationAccepterRead,
+		DeleteContext: resourceInvitationAccepterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(invitationAccepterDefaultCreateTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"administrator_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"graph_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceInvitationAccepterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DetectiveConn
+
+	graphARN := d.Get("graph_arn").(string)
+
+	// The invitation may take some time to be visible to the member account.
+	_, err := tfresource.RetryWhenNotFoundContext(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+		return FindInvitationByGraphARN(ctx, conn, graphARN)
+	})
+
+	if err != nil {
+		return diag.Errorf("error reading Detective Invitation (%s): %s", graphARN, err)
+	}
+
+	input := &detective.AcceptInvitationInput{
+		GraphArn: aws.String(graphARN),
+	}
+
+	log.Printf("[DEBUG] Accepting Detective Invitation: %s", input)
+	_, err = conn.AcceptInvitationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error accepting Detective Invitation (%s): %s", graphARN, err)
+	}
+
+	d.SetId(graphARN)
+
+	return resourceInvitationAccepterRead(ctx, d, meta)
+}
+
+func resourceInvitationAccepterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DetectiveConn
+
+	invitation, err := FindInvitationByGraphARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Detective Invitation Accepter (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Detective Invitation Accepter (%s): %s", d.Id(), err)
+	}
+
+	d.Set("administrator_id", invitation.AdministratorId)
+	d.Set("graph_arn", invitation.GraphArn)
+	d.Set("status", invitation.Status)
+
+	return nil
+}
+
+func resourceInvitationAccepterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DetectiveConn
+
+	log.Printf("[DEBUG] Disassociating from Detective Graph: (%s)", d.Id())
+	_, err := conn.DisassociateMembershipWithContext(ctx, &detective.DisassociateMembershipInput{
+		GraphArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, detective.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error disassociating from Detective Graph (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
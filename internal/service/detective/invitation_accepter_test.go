@@ -0,0 +1,142 @@
+package detective_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdetective "github.com/hashicorp/terraform-provider-aws/internal/service/detective"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccInvitationAccepter_basic(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_detective_invitation_accepter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, detective.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckInvitationAccepterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInvitationAccepterConfig(acctest.DefaultEmailAddress),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInvitationAccepterExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "administrator_id", "data.aws_caller_identity.admin", "account_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "graph_arn", "aws_detective_graph.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "status", detective.MemberStatusEnabled),
+				),
+			},
+			{
+				Config:            testAccInvitationAccepterConfig(acctest.DefaultEmailAddress),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccInvitationAccepter_disappears(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_detective_invitation_accepter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, detective.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckInvitationAccepterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInvitationAccepterConfig(acctest.DefaultEmailAddress),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInvitationAccepterExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfdetective.ResourceInvitationAccepter(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckInvitationAccepterDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DetectiveConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_detective_invitation_accepter" {
+			continue
+		}
+
+		_, err := tfdetective.FindInvitationByGraphARN(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Detective Invitation Accepter %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckInvitationAccepterExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Detective Invitation Accepter ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DetectiveConn
+
+		_, err := tfdetective.FindInvitationByGraphARN(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccInvitationAccepterConfig(email string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+data "aws_caller_identity" "admin" {
+  provider = "awsalternate"
+}
+
+data "aws_caller_identity" "member" {}
+
+resource "aws_detective_graph" "test" {
+  provider = "awsalternate"
+}
+
+resource "aws_detective_member" "test" {
+  provider = "awsalternate"
+
+  account_id    = data.aws_caller_identity.member.account_id
+  email_address = %[1]q
+  graph_arn     = aws_detective_graph.test.id
+}
+
+resource "aws_detective_invitation_accepter" "test" {
+  graph_arn = aws_detective_member.test.graph_arn
+}
+`, email))
+}
@@ -0,0 +1,211 @@
+package detective
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceMember() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMemberCreate,
+		ReadContext:   resourceMemberRead,
+		DeleteContext: resourceMemberDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"administrator_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"disable_email_notification": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"disabled_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"email_address": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"graph_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"invited_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"message": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"volume_usage_in_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DetectiveConn
+
+	accountID := d.Get("account_id").(string)
+	graphARN := d.Get("graph_arn").(string)
+	id := MemberCreateResourceID(graphARN, accountID)
+	input := &detective.CreateMembersInput{
+		Accounts: []*detective.Account{{
+			AccountId:    aws.String(accountID),
+			EmailAddress: aws.String(d.Get("email_address").(string)),
+		}},
+		GraphArn: aws.String(graphARN),
+	}
+
+	if v, ok := d.GetOk("disable_email_notification"); ok {
+		input.DisableEmailNotification = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("message"); ok {
+		input.Message = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Detective Member: %s", input)
+	output, err := conn.CreateMembersWithContext(ctx, input)
+
+	if err == nil && output != nil {
+		err = unprocessedAccountsError(output.UnprocessedAccounts)
+	}
+
+	if err != nil {
+		return diag.Errorf("error creating Detective Member (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitMemberInvited(ctx, conn, graphARN, accountID, memberInvitedTimeout); err != nil {
+		return diag.Errorf("error waiting for Detective Member (%s) invite: %s", d.Id(), err)
+	}
+
+	return resourceMemberRead(ctx, d, meta)
+}
+
+func resourceMemberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DetectiveConn
+
+	graphARN, accountID, err := MemberParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	member, err := FindMemberByGraphARNAndAccountID(ctx, conn, graphARN, accountID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Detective Member (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Detective Member (%s): %s", d.Id(), err)
+	}
+
+	d.Set("account_id", member.AccountId)
+	d.Set("administrator_id", member.AdministratorId)
+	d.Set("disabled_reason", member.DisabledReason)
+	d.Set("email_address", member.EmailAddress)
+	d.Set("graph_arn", member.GraphArn)
+	d.Set("invited_time", flattenTime(member.InvitedTime))
+	d.Set("status", member.Status)
+	d.Set("updated_time", flattenTime(member.UpdatedTime))
+	d.Set("volume_usage_in_bytes", member.VolumeUsageInBytes)
+
+	return nil
+}
+
+func resourceMemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DetectiveConn
+
+	graphARN, accountID, err := MemberParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Detective Member: (%s)", d.Id())
+	output, err := conn.DeleteMembersWithContext(ctx, &detective.DeleteMembersInput{
+		AccountIds: aws.StringSlice([]string{accountID}),
+		GraphArn:   aws.String(graphARN),
+	})
+
+	if tfawserr.ErrCodeEquals(err, detective.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err == nil && output != nil {
+		err = unprocessedAccountsError(output.UnprocessedAccounts)
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Detective Member (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func flattenTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return aws.TimeValue(t).Format(time.RFC3339)
+}
+
+func unprocessedAccountsError(apiObjects []*detective.UnprocessedAccount) error {
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		return fmt.Errorf("unprocessed account (%s): %s", aws.StringValue(apiObject.AccountId), aws.StringValue(apiObject.Reason))
+	}
+
+	return nil
+}
@@ -93,6 +93,7 @@ func resourceProjectRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, devicefarm.ErrCodeNotFoundException, "") {
 			log.Printf("[WARN] DeviceFarm Project (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
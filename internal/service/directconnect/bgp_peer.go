@@ -142,6 +142,7 @@ func resourceBGPPeerRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	if state == directconnect.BGPPeerStateDeleted {
 		log.Printf("[WARN] Direct Connect BGP peer (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -162,6 +162,7 @@ func resourceHostedPrivateVirtualInterfaceRead(d *schema.ResourceData, meta inte
 	}
 	if vif == nil {
 		log.Printf("[WARN] Direct Connect hosted private virtual interface (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -114,6 +114,7 @@ func resourceHostedPrivateVirtualInterfaceAccepterRead(d *schema.ResourceData, m
 	}
 	if vif == nil {
 		log.Printf("[WARN] Direct Connect hosted private virtual interface (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -121,6 +122,7 @@ func resourceHostedPrivateVirtualInterfaceAccepterRead(d *schema.ResourceData, m
 	if vifState != directconnect.VirtualInterfaceStateAvailable &&
 		vifState != directconnect.VirtualInterfaceStateDown {
 		log.Printf("[WARN] Direct Connect hosted private virtual interface (%s) is '%s', removing from state", vifState, d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -159,6 +159,7 @@ func resourceHostedPublicVirtualInterfaceRead(d *schema.ResourceData, meta inter
 	}
 	if vif == nil {
 		log.Printf("[WARN] Direct Connect virtual interface (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -89,6 +89,7 @@ func resourceHostedPublicVirtualInterfaceAccepterRead(d *schema.ResourceData, me
 	}
 	if vif == nil {
 		log.Printf("[WARN] Direct Connect hosted public virtual interface (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -97,6 +98,7 @@ func resourceHostedPublicVirtualInterfaceAccepterRead(d *schema.ResourceData, me
 		vifState != directconnect.VirtualInterfaceStateDown &&
 		vifState != directconnect.VirtualInterfaceStateVerifying {
 		log.Printf("[WARN] Direct Connect hosted public virtual interface (%s) is '%s', removing from state", vifState, d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
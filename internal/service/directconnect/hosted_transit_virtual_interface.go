@@ -159,6 +159,7 @@ func resourceHostedTransitVirtualInterfaceRead(d *schema.ResourceData, meta inte
 	}
 	if vif == nil {
 		log.Printf("[WARN] Direct Connect hosted transit virtual interface (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
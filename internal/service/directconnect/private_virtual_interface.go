@@ -190,6 +190,7 @@ func resourcePrivateVirtualInterfaceRead(d *schema.ResourceData, meta interface{
 	}
 	if vif == nil {
 		log.Printf("[WARN] Direct Connect private virtual interface (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
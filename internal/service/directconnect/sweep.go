@@ -94,7 +94,7 @@ func sweepConnections(region string) error {
 		d := r.Data(nil)
 		d.SetId(id)
 
-		err = r.Delete(d, client)
+		err = sweep.DeleteResource(r, d, client)
 
 		if err != nil {
 			sweeperErr := fmt.Errorf("error deleting Direct Connect Connection (%s): %w", id, err)
@@ -427,7 +427,7 @@ func sweepLags(region string) error {
 		d := r.Data(nil)
 		d.SetId(id)
 
-		err = r.Delete(d, client)
+		err = sweep.DeleteResource(r, d, client)
 
 		if err != nil {
 			sweeperErr := fmt.Errorf("error deleting Direct Connect LAG (%s): %w", id, err)
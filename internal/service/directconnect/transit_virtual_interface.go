@@ -171,6 +171,7 @@ func resourceTransitVirtualInterfaceRead(d *schema.ResourceData, meta interface{
 	}
 	if vif == nil {
 		log.Printf("[WARN] Direct Connect transit virtual interface (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
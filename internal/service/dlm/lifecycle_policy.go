@@ -188,6 +188,7 @@ func resourceLifecyclePolicyRead(d *schema.ResourceData, meta interface{}) error
 
 	if tfawserr.ErrMessageContains(err, dlm.ErrCodeResourceNotFoundException, "") {
 		log.Printf("[WARN] DLM Lifecycle Policy (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
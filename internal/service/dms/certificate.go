@@ -120,6 +120,7 @@ func resourceCertificateRead(d *schema.ResourceData, meta interface{}) error {
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, dms.ErrCodeResourceNotFoundFault) {
 		log.Printf("[WARN] DMS Certificate (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -133,6 +134,7 @@ func resourceCertificateRead(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("error reading DMS Certificate (%s): not found", d.Id())
 		}
 		log.Printf("[WARN] DMS Certificate (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -192,6 +192,7 @@ func resourceEventSubscriptionRead(d *schema.ResourceData, meta interface{}) err
 
 	if tfawserr.ErrMessageContains(err, dms.ErrCodeResourceNotFoundFault, "") {
 		log.Printf("[WARN] DMS event subscription (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -202,6 +203,7 @@ func resourceEventSubscriptionRead(d *schema.ResourceData, meta interface{}) err
 
 	if response == nil || len(response.EventSubscriptionsList) == 0 || response.EventSubscriptionsList[0] == nil {
 		log.Printf("[WARN] DMS event subscription (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -219,6 +219,7 @@ func resourceReplicationInstanceRead(d *schema.ResourceData, meta interface{}) e
 
 	if tfawserr.ErrMessageContains(err, dms.ErrCodeResourceNotFoundFault, "") {
 		log.Printf("[WARN] DMS Replication Instance (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -229,6 +230,7 @@ func resourceReplicationInstanceRead(d *schema.ResourceData, meta interface{}) e
 
 	if response == nil || len(response.ReplicationInstances) == 0 || response.ReplicationInstances[0] == nil {
 		log.Printf("[WARN] DMS Replication Instance (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
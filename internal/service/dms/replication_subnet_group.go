@@ -98,6 +98,7 @@ func resourceReplicationSubnetGroupRead(d *schema.ResourceData, meta interface{}
 		return err
 	}
 	if len(response.ReplicationSubnetGroups) == 0 {
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
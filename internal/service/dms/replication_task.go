@@ -173,6 +173,7 @@ func resourceReplicationTaskRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		if dmserr, ok := err.(awserr.Error); ok && dmserr.Code() == "ResourceNotFoundFault" {
 			log.Printf("[DEBUG] DMS Replication Task %q Not Found", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
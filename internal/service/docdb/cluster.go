@@ -506,6 +506,7 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 
 	if tfawserr.ErrMessageContains(err, docdb.ErrCodeDBClusterNotFoundFault, "") {
 		log.Printf("[WARN] DocDB Cluster (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -528,6 +529,7 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 
 	if dbc == nil {
 		log.Printf("[WARN] DocDB Cluster (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -269,6 +269,7 @@ func resourceClusterInstanceRead(d *schema.ResourceData, meta interface{}) error
 	// A nil response means "not found"
 	if db == nil {
 		log.Printf("[WARN] DocDB Cluster Instance (%s): not found, removing from state.", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
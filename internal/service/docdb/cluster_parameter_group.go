@@ -145,6 +145,7 @@ func resourceClusterParameterGroupRead(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, docdb.ErrCodeDBParameterGroupNotFoundFault, "") {
 			log.Printf("[WARN] DocDB Cluster Parameter Group (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -132,6 +132,7 @@ func resourceClusterSnapshotRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, docdb.ErrCodeDBClusterSnapshotNotFoundFault, "") {
 			log.Printf("[WARN] DocDB Cluster Snapshot %q not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -140,6 +141,7 @@ func resourceClusterSnapshotRead(d *schema.ResourceData, meta interface{}) error
 
 	if resp == nil || len(resp.DBClusterSnapshots) == 0 || resp.DBClusterSnapshots[0] == nil || aws.StringValue(resp.DBClusterSnapshots[0].DBClusterSnapshotIdentifier) != d.Id() {
 		log.Printf("[WARN] DocDB Cluster Snapshot %q not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -122,6 +122,7 @@ func resourceSubnetGroupRead(d *schema.ResourceData, meta interface{}) error {
 	}); err != nil {
 		if tfawserr.ErrMessageContains(err, docdb.ErrCodeDBSubnetGroupNotFoundFault, "") {
 			log.Printf("[WARN] DocDB Subnet Group (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -91,6 +91,7 @@ func resourceConditionalForwarderRead(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, directoryservice.ErrCodeEntityDoesNotExistException, "") {
 			log.Printf("[WARN] Directory Service Conditional Forwarder (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -99,6 +100,7 @@ func resourceConditionalForwarderRead(d *schema.ResourceData, meta interface{})
 
 	if len(res.ConditionalForwarders) == 0 {
 		log.Printf("[WARN] Directory Service Conditional Forwarder (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
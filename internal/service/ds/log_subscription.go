@@ -71,6 +71,7 @@ func resourceLogSubscriptionRead(d *schema.ResourceData, meta interface{}) error
 
 	if len(out.LogSubscriptions) == 0 {
 		log.Printf("[WARN] No log subscriptions for directory %s found", directoryId)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
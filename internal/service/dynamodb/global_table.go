@@ -106,6 +106,7 @@ func resourceGlobalTableRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	if globalTableDescription == nil {
 		log.Printf("[WARN] DynamoDB Global Table %q not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -84,6 +84,7 @@ func resourceKinesisStreamingDestinationRead(ctx context.Context, d *schema.Reso
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
 		log.Printf("[WARN] DynamoDB Kinesis Streaming Destination (stream: %s, table: %s) not found, removing from state", streamArn, tableName)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -97,6 +98,7 @@ func resourceKinesisStreamingDestinationRead(ctx context.Context, d *schema.Reso
 			return diag.FromErr(fmt.Errorf("error retrieving DynamoDB Kinesis streaming destination (stream: %s, table: %s): empty output after creation", streamArn, tableName))
 		}
 		log.Printf("[WARN] DynamoDB Kinesis Streaming Destination (stream: %s, table: %s) not found, removing from state", streamArn, tableName)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
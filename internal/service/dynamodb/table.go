@@ -483,6 +483,7 @@ func resourceTableRead(d *schema.ResourceData, meta interface{}) error {
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
 		log.Printf("[WARN] Dynamodb Table (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -496,6 +497,7 @@ func resourceTableRead(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("error reading Dynamodb Table (%s): empty output after creation", d.Id())
 		}
 		log.Printf("[WARN] Dynamodb Table (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -178,6 +178,7 @@ func resourceTableItemRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, dynamodb.ErrCodeResourceNotFoundException, "") {
 			log.Printf("[WARN] Dynamodb Table Item (%s) not found, error code (404)", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -187,6 +188,7 @@ func resourceTableItemRead(d *schema.ResourceData, meta interface{}) error {
 
 	if result.Item == nil {
 		log.Printf("[WARN] Dynamodb Table Item (%s) not found", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
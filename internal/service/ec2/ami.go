@@ -351,6 +351,7 @@ func resourceAMIRead(d *schema.ResourceData, meta interface{}) error {
 					return resource.RetryableError(err)
 				}
 				log.Printf("[WARN] AMI (%s) not found, removing from state", d.Id())
+				//lintignore:AWSR003
 				d.SetId("")
 				return nil
 			}
@@ -372,6 +373,7 @@ func resourceAMIRead(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[WARN] AMI (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -398,6 +400,7 @@ func resourceAMIRead(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[WARN] AMI (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -83,6 +83,7 @@ func resourceAMILaunchPermissionRead(d *schema.ResourceData, meta interface{}) e
 		}
 
 		log.Printf("[WARN] AMI launch permission (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -194,6 +194,7 @@ func resourceCapacityReservationRead(d *schema.ResourceData, meta interface{}) e
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, "InvalidCapacityReservationId.NotFound", "") {
 			log.Printf("[WARN] EC2 Capacity Reservation (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -208,6 +209,7 @@ func resourceCapacityReservationRead(d *schema.ResourceData, meta interface{}) e
 
 	if aws.StringValue(reservation.State) == ec2.CapacityReservationStateCancelled || aws.StringValue(reservation.State) == ec2.CapacityReservationStateExpired {
 		log.Printf("[WARN] EC2 Capacity Reservation (%s) no longer active, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -86,6 +86,7 @@ func resourceCarrierGatewayRead(d *schema.ResourceData, meta interface{}) error
 
 	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidCarrierGatewayIDNotFound) {
 		log.Printf("[WARN] EC2 Carrier Gateway (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -96,6 +97,7 @@ func resourceCarrierGatewayRead(d *schema.ResourceData, meta interface{}) error
 
 	if carrierGateway == nil || aws.StringValue(carrierGateway.State) == ec2.CarrierGatewayStateDeleted {
 		log.Printf("[WARN] EC2 Carrier Gateway (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
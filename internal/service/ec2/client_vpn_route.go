@@ -96,6 +96,7 @@ func resourceClientVPNRouteRead(d *schema.ResourceData, meta interface{}) error
 
 	if tfawserr.ErrMessageContains(err, ErrCodeClientVPNRouteNotFound, "") {
 		log.Printf("[WARN] EC2 Client VPN Route (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -106,6 +107,7 @@ func resourceClientVPNRouteRead(d *schema.ResourceData, meta interface{}) error
 
 	if resp == nil || len(resp.Routes) == 0 || resp.Routes[0] == nil {
 		log.Printf("[WARN] EC2 Client VPN Route (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -226,6 +226,7 @@ func resourceCustomerGatewayRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, "InvalidCustomerGatewayID.NotFound", "") {
 			log.Printf("[WARN] Customer Gateway (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		} else {
@@ -240,6 +241,7 @@ func resourceCustomerGatewayRead(d *schema.ResourceData, meta interface{}) error
 
 	if aws.StringValue(resp.CustomerGateways[0].State) == "deleted" {
 		log.Printf("[INFO] Customer Gateway is in `deleted` state: %s", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -136,6 +136,7 @@ func resourceEBSSnapshotRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, "InvalidSnapshot.NotFound", "") {
 			log.Printf("[WARN] EBS Snapshot %q Not found - removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -144,6 +145,7 @@ func resourceEBSSnapshotRead(d *schema.ResourceData, meta interface{}) error {
 
 	if len(res.Snapshots) == 0 {
 		log.Printf("[WARN] EBS Snapshot %q Not found - removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
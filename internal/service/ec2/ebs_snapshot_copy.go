@@ -128,6 +128,7 @@ func resourceEBSSnapshotCopyRead(d *schema.ResourceData, meta interface{}) error
 	res, err := conn.DescribeSnapshots(req)
 	if tfawserr.ErrMessageContains(err, "InvalidSnapshot.NotFound", "") {
 		log.Printf("Snapshot %q Not found - removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
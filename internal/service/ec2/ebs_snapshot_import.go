@@ -263,6 +263,7 @@ func resourceEBSSnapshotImportRead(d *schema.ResourceData, meta interface{}) err
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, "InvalidSnapshot.NotFound", "") {
 			log.Printf("[WARN] EBS Snapshot %q Not found - removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -271,6 +272,7 @@ func resourceEBSSnapshotImportRead(d *schema.ResourceData, meta interface{}) err
 
 	if len(res.Snapshots) == 0 {
 		log.Printf("[WARN] EBS Snapshot %q Not found - removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
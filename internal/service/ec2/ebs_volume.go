@@ -268,6 +268,7 @@ func resourceEBSVolumeRead(d *schema.ResourceData, meta interface{}) error {
 	response, err := conn.DescribeVolumes(request)
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, "InvalidVolume.NotFound", "") {
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
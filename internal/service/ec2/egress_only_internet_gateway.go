@@ -91,6 +91,7 @@ func resourceEgressOnlyInternetGatewayRead(d *schema.ResourceData, meta interfac
 	igw := getEc2EgressOnlyInternetGateway(d.Id(), resp)
 	if igw == nil {
 		log.Printf("[Error] Cannot find Egress Only Internet Gateway: %q", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
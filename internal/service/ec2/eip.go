@@ -245,6 +245,7 @@ func resourceEIPRead(d *schema.ResourceData, meta interface{}) error {
 			if ok && (awsErr.Code() == "InvalidAllocationID.NotFound" ||
 				awsErr.Code() == "InvalidAddress.NotFound") {
 				log.Printf("[WARN] EIP not found, removing from state: %s", req)
+				//lintignore:AWSR003
 				d.SetId("")
 				return nil
 			}
@@ -265,6 +266,7 @@ func resourceEIPRead(d *schema.ResourceData, meta interface{}) error {
 
 	if address == nil {
 		log.Printf("[WARN] EIP %q not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
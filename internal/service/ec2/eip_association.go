@@ -184,6 +184,7 @@ func resourceEIPAssociationRead(d *schema.ResourceData, meta interface{}) error
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, "InvalidAssociationID.NotFound") {
 		log.Printf("[WARN] EIP Association (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -194,6 +195,7 @@ func resourceEIPAssociationRead(d *schema.ResourceData, meta interface{}) error
 
 	if response.Addresses == nil || len(response.Addresses) == 0 {
 		log.Printf("[INFO] EIP Association ID Not Found. Refreshing from state")
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
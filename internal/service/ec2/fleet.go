@@ -416,6 +416,7 @@ func resourceFleetRead(d *schema.ResourceData, meta interface{}) error {
 
 	if tfawserr.ErrMessageContains(err, "InvalidFleetId.NotFound", "") {
 		log.Printf("[WARN] EC2 Fleet (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -426,6 +427,7 @@ func resourceFleetRead(d *schema.ResourceData, meta interface{}) error {
 
 	if output == nil || len(output.Fleets) == 0 {
 		log.Printf("[WARN] EC2 Fleet (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -444,6 +446,7 @@ func resourceFleetRead(d *schema.ResourceData, meta interface{}) error {
 
 	if fleet == nil {
 		log.Printf("[WARN] EC2 Fleet (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -459,6 +462,7 @@ func resourceFleetRead(d *schema.ResourceData, meta interface{}) error {
 	for _, deletedState := range deletedStates {
 		if aws.StringValue(fleet.FleetState) == deletedState {
 			log.Printf("[WARN] EC2 Fleet (%s) in deleted state (%s), removing from state", d.Id(), aws.StringValue(fleet.FleetState))
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -898,6 +898,7 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		// that the instance is gone.
 		if tfawserr.ErrMessageContains(err, "InvalidInstanceID.NotFound", "") {
 			log.Printf("[WARN] EC2 Instance (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -909,6 +910,7 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	// If nothing was found, then return no state
 	if instance == nil {
 		log.Printf("[WARN] EC2 Instance (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -916,6 +918,7 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	if instance.State != nil {
 		// If the instance is terminated, then it is gone
 		if aws.StringValue(instance.State.Name) == ec2.InstanceStateNameTerminated {
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
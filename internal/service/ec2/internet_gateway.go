@@ -111,6 +111,7 @@ func resourceInternetGatewayRead(d *schema.ResourceData, meta interface{}) error
 	}
 	if igRaw == nil {
 		log.Printf("[WARN] Internet Gateway (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
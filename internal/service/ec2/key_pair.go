@@ -124,6 +124,7 @@ func resourceKeyPairRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, "InvalidKeyPair.NotFound", "") {
 			log.Printf("[WARN] Key Pair (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -132,6 +133,7 @@ func resourceKeyPairRead(d *schema.ResourceData, meta interface{}) error {
 
 	if len(resp.KeyPairs) == 0 {
 		log.Printf("[WARN] Key Pair (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -709,6 +709,7 @@ func resourceLaunchTemplateRead(d *schema.ResourceData, meta interface{}) error
 
 	if tfawserr.ErrMessageContains(err, ec2.LaunchTemplateErrorCodeLaunchTemplateIdDoesNotExist, "") {
 		log.Printf("[WARN] launch template (%s) not found - removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -716,6 +717,7 @@ func resourceLaunchTemplateRead(d *schema.ResourceData, meta interface{}) error
 	// AWS SDK constant above is currently incorrect
 	if tfawserr.ErrMessageContains(err, "InvalidLaunchTemplateId.NotFound", "") {
 		log.Printf("[WARN] launch template (%s) not found - removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -726,6 +728,7 @@ func resourceLaunchTemplateRead(d *schema.ResourceData, meta interface{}) error
 
 	if dlt == nil || len(dlt.LaunchTemplates) == 0 {
 		log.Printf("[WARN] launch template (%s) not found - removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -103,6 +103,7 @@ func resourceLocalGatewayRouteRead(d *schema.ResourceData, meta interface{}) err
 
 	if tfawserr.ErrMessageContains(err, "InvalidRouteTableID.NotFound", "") {
 		log.Printf("[WARN] EC2 Local Gateway Route Table (%s) not found, removing from state", localGatewayRouteTableID)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -119,6 +120,7 @@ func resourceLocalGatewayRouteRead(d *schema.ResourceData, meta interface{}) err
 
 	if localGatewayRoute == nil {
 		log.Printf("[WARN] EC2 Local Gateway Route (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -126,6 +128,7 @@ func resourceLocalGatewayRouteRead(d *schema.ResourceData, meta interface{}) err
 	state := aws.StringValue(localGatewayRoute.State)
 	if state == ec2.LocalGatewayRouteStateDeleted || state == ec2.LocalGatewayRouteStateDeleting {
 		log.Printf("[WARN] EC2 Local Gateway Route (%s) deleted, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
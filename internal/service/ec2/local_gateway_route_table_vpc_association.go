@@ -90,12 +90,14 @@ func resourceLocalGatewayRouteTableVPCAssociationRead(d *schema.ResourceData, me
 
 	if association == nil {
 		log.Printf("[WARN] EC2 Local Gateway Route Table VPC Association (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
 
 	if aws.StringValue(association.State) != ec2.RouteTableAssociationStateCodeAssociated {
 		log.Printf("[WARN] EC2 Local Gateway Route Table VPC Association (%s) status (%s), removing from state", d.Id(), aws.StringValue(association.State))
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
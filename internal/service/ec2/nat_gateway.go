@@ -140,6 +140,7 @@ func resourceNatGatewayRead(d *schema.ResourceData, meta interface{}) error {
 
 	if _, ok := status[strings.ToLower(state)]; ngRaw == nil || ok {
 		log.Printf("[INFO] Removing %s from Terraform state as it is not found or in the deleted state.", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -302,6 +302,7 @@ func resourceNetworkACLRead(d *schema.ResourceData, meta interface{}) error {
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, "InvalidNetworkAclID.NotFound") {
 		log.Printf("[WARN] EC2 Network ACL (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -316,6 +317,7 @@ func resourceNetworkACLRead(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[WARN] EC2 Network ACL (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -237,6 +237,7 @@ func resourceNetworkACLRuleRead(d *schema.ResourceData, meta interface{}) error
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, "InvalidNetworkAclID.NotFound") {
 		log.Printf("[WARN] EC2 Network ACL (%s) not found, removing from state", networkAclID)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -251,6 +252,7 @@ func resourceNetworkACLRuleRead(d *schema.ResourceData, meta interface{}) error
 		}
 
 		log.Printf("[WARN] EC2 Network ACL (%s) Egress (%t) Rule (%d) not found, removing from state", networkAclID, egress, ruleNumber)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -242,6 +242,7 @@ func resourceNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) erro
 		if tfawserr.ErrMessageContains(err, "InvalidNetworkInterfaceID.NotFound", "") {
 			// The ENI is gone now, so just remove it from the state
 			log.Printf("[WARN] EC2 Network Interface (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
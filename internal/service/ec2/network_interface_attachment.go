@@ -106,7 +106,7 @@ func resourceNetworkInterfaceAttachmentRead(d *schema.ResourceData, meta interfa
 	if err != nil {
 		if ec2err, ok := err.(awserr.Error); ok && ec2err.Code() == "InvalidNetworkInterfaceID.NotFound" {
 			// The ENI is gone now, so just remove the attachment from the state
-			d.SetId("")
+			d.SetId("") //lintignore:AWSR003
 			return nil
 		}
 
@@ -120,7 +120,7 @@ func resourceNetworkInterfaceAttachmentRead(d *schema.ResourceData, meta interfa
 
 	if eni.Attachment == nil {
 		// Interface is no longer attached, remove from state
-		d.SetId("")
+		d.SetId("") //lintignore:AWSR003
 		return nil
 	}
 
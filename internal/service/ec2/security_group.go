@@ -347,6 +347,7 @@ func resourceSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
 	var nfe *resource.NotFoundError
 	if !d.IsNewResource() && errors.As(err, &nfe) {
 		log.Printf("[WARN] Security group (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -311,6 +311,7 @@ func resourceSecurityGroupRuleRead(d *schema.ResourceData, meta interface{}) err
 
 	if !d.IsNewResource() && len(rules) == 0 {
 		log.Printf("[WARN] No %s rules were found for Security Group (%s) looking for Security Group Rule (%s)", ruleType, aws.StringValue(sg.GroupName), d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -319,6 +320,7 @@ func resourceSecurityGroupRuleRead(d *schema.ResourceData, meta interface{}) err
 
 	if !d.IsNewResource() && rule == nil {
 		log.Printf("[DEBUG] Unable to find matching %s Security Group Rule (%s) for Group %s", ruleType, d.Id(), sg_id)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
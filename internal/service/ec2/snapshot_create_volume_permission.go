@@ -87,6 +87,7 @@ func resourceSnapshotCreateVolumePermissionRead(d *schema.ResourceData, meta int
 	}
 	if !exists {
 		log.Printf("[WARN] snapshot createVolumePermission (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -64,6 +64,7 @@ func resourceSpotDataFeedSubscriptionRead(d *schema.ResourceData, meta interface
 		cgw, ok := err.(awserr.Error)
 		if ok && cgw.Code() == "InvalidSpotDatafeed.NotFound" {
 			log.Printf("[WARNING] Spot Datafeed Subscription Not Found so refreshing from state")
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -72,6 +73,7 @@ func resourceSpotDataFeedSubscriptionRead(d *schema.ResourceData, meta interface
 
 	if resp == nil {
 		log.Printf("[WARNING] Spot Datafeed Subscription Not Found so refreshing from state")
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -1227,6 +1227,7 @@ func resourceSpotFleetRequestRead(d *schema.ResourceData, meta interface{}) erro
 		// If the spot request was not found, return nil so that we can show
 		// that it is gone.
 		if tfawserr.ErrMessageContains(err, "InvalidSpotFleetRequestId.NotFound", "") {
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -1244,6 +1245,7 @@ func resourceSpotFleetRequestRead(d *schema.ResourceData, meta interface{}) erro
 		ec2.BatchStateCancelledTerminating: true,
 	}
 	if _, ok := cancelledStates[*sfr.SpotFleetRequestState]; ok {
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
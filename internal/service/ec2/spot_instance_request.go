@@ -319,6 +319,7 @@ func resourceSpotInstanceRequestRead(d *schema.ResourceData, meta interface{}) e
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, ErrCodeInvalidSpotInstanceRequestIDNotFound) {
 		log.Printf("[WARN] EC2 Spot Instance Request (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -333,6 +334,7 @@ func resourceSpotInstanceRequestRead(d *schema.ResourceData, meta interface{}) e
 		}
 
 		log.Printf("[WARN] EC2 Spot Instance Request (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -343,6 +345,7 @@ func resourceSpotInstanceRequestRead(d *schema.ResourceData, meta interface{}) e
 		}
 
 		log.Printf("[WARN] EC2 Spot Instance Request (%s) %s, removing from state", d.Id(), aws.StringValue(request.State))
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -265,6 +265,7 @@ func resourceSubnetRead(d *schema.ResourceData, meta interface{}) error {
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, "InvalidSubnetID.NotFound") {
 		log.Printf("[WARN] EC2 Subnet (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -279,6 +280,7 @@ func resourceSubnetRead(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[WARN] EC2 Subnet (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
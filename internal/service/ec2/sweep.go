@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -336,7 +337,7 @@ func sweepCarrierGateway(region string) error {
 			r := ResourceCarrierGateway()
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(carrierGateway.CarrierGatewayId))
-			err = r.Delete(d, client)
+			err = sweep.DeleteResource(r, d, client)
 
 			if err != nil {
 				log.Printf("[ERROR] %s", err)
@@ -1748,7 +1749,7 @@ func sweepVPCEndpointServices(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 
 			if err != nil {
 				sweeperErr := fmt.Errorf("error deleting EC2 VPC Endpoint Service (%s): %w", id, err)
@@ -1808,7 +1809,7 @@ func sweepVPCEndpoints(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 
 			if err != nil {
 				sweeperErr := fmt.Errorf("error deleting EC2 VPC Endpoint (%s): %w", id, err)
@@ -1927,11 +1928,13 @@ func sweepVPCs(region string) error {
 				continue
 			}
 
-			r := ResourceVPC()
-			d := r.Data(nil)
-			d.SetId(id)
+			networkInterfaceIDs, natGatewayIDs, vpcEndpointIDs, err := findVPCDependencyIDs(conn, id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error describing EC2 VPC (%s) dependencies for %s: %w", id, region, err))
+			}
+
+			sweepResources = append(sweepResources, vpcSweepResources(client, id, networkInterfaceIDs, natGatewayIDs, vpcEndpointIDs)...)
 		}
 
 		return !lastPage
@@ -1953,6 +1956,104 @@ func sweepVPCs(region string) error {
 	return errs.ErrorOrNil()
 }
 
+// findVPCDependencyIDs returns the IDs of the available network interfaces, the NAT gateways
+// and the available VPC endpoints in a VPC.
+func findVPCDependencyIDs(conn *ec2.EC2, vpcID string) ([]string, []string, []string, error) {
+	var networkInterfaceIDs, natGatewayIDs, vpcEndpointIDs []string
+
+	filters := BuildAttributeFilterList(map[string]string{
+		"vpc-id": vpcID,
+	})
+
+	err := conn.DescribeNetworkInterfacesPages(&ec2.DescribeNetworkInterfacesInput{Filters: filters}, func(page *ec2.DescribeNetworkInterfacesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, networkInterface := range page.NetworkInterfaces {
+			if aws.StringValue(networkInterface.Status) == ec2.NetworkInterfaceStatusAvailable {
+				networkInterfaceIDs = append(networkInterfaceIDs, aws.StringValue(networkInterface.NetworkInterfaceId))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error describing EC2 Network Interfaces: %w", err)
+	}
+
+	err = conn.DescribeNatGatewaysPages(&ec2.DescribeNatGatewaysInput{Filter: filters}, func(page *ec2.DescribeNatGatewaysOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, natGateway := range page.NatGateways {
+			switch aws.StringValue(natGateway.State) {
+			case ec2.NatGatewayStateDeleted, ec2.NatGatewayStateDeleting:
+				continue
+			}
+
+			natGatewayIDs = append(natGatewayIDs, aws.StringValue(natGateway.NatGatewayId))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error describing EC2 NAT Gateways: %w", err)
+	}
+
+	err = conn.DescribeVpcEndpointsPages(&ec2.DescribeVpcEndpointsInput{Filters: filters}, func(page *ec2.DescribeVpcEndpointsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, vpcEndpoint := range page.VpcEndpoints {
+			if aws.StringValue(vpcEndpoint.State) == "available" {
+				vpcEndpointIDs = append(vpcEndpointIDs, aws.StringValue(vpcEndpoint.VpcEndpointId))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error describing EC2 VPC Endpoints: %w", err)
+	}
+
+	return networkInterfaceIDs, natGatewayIDs, vpcEndpointIDs, nil
+}
+
+// vpcSweepResources returns sweep resources for a VPC and for the network interfaces, NAT gateways
+// and VPC endpoints in it. The VPC depends on the others so that it is only deleted once they are gone.
+func vpcSweepResources(client interface{}, vpcID string, networkInterfaceIDs, natGatewayIDs, vpcEndpointIDs []string) []*sweep.SweepResource {
+	var dependencies []*sweep.SweepResource
+
+	for _, v := range []struct {
+		resource func() *schema.Resource
+		ids      []string
+	}{
+		{ResourceNetworkInterface, networkInterfaceIDs},
+		{ResourceNatGateway, natGatewayIDs},
+		{ResourceVPCEndpoint, vpcEndpointIDs},
+	} {
+		for _, id := range v.ids {
+			r := v.resource()
+			d := r.Data(nil)
+			d.SetId(id)
+
+			dependencies = append(dependencies, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	r := ResourceVPC()
+	d := r.Data(nil)
+	d.SetId(vpcID)
+
+	return append(dependencies, sweep.NewSweepResource(r, d, client).DependsOn(dependencies...))
+}
+
 func sweepVPNConnections(region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
@@ -2040,7 +2141,7 @@ func sweepVPNGateways(region string) error {
 			d := r.Data(nil)
 			d.Set("vpc_id", vpcAttachment.VpcId)
 			d.Set("vpn_gateway_id", vpng.VpnGatewayId)
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 
 			if err != nil {
 				log.Printf("[ERROR] %s", err)
@@ -2052,7 +2153,7 @@ func sweepVPNGateways(region string) error {
 		r := ResourceVPNGateway()
 		d := r.Data(nil)
 		d.SetId(aws.StringValue(vpng.VpnGatewayId))
-		err := r.Delete(d, client)
+		err := sweep.DeleteResource(r, d, client)
 
 		if err != nil {
 			log.Printf("[ERROR] %s", err)
@@ -0,0 +1,58 @@
+//go:build sweep
+// +build sweep
+
+package ec2
+
+import (
+	"testing"
+)
+
+func TestVPCSweepResources(t *testing.T) {
+	sweepResources := vpcSweepResources(nil, "vpc-1", []string{"eni-1", "eni-2"}, []string{"nat-1"}, []string{"vpce-1"})
+
+	if got, expected := len(sweepResources), 5; got != expected {
+		t.Fatalf("got %d sweep resources, expected %d", got, expected)
+	}
+
+	vpc := sweepResources[len(sweepResources)-1]
+
+	if got, expected := vpc.ID(), "vpc-1"; got != expected {
+		t.Fatalf("got %s, expected VPC %s to be swept last", got, expected)
+	}
+
+	var got []string
+
+	for _, dependency := range vpc.Dependencies() {
+		got = append(got, dependency.ID())
+	}
+
+	expected := []string{"eni-1", "eni-2", "nat-1", "vpce-1"}
+
+	if len(got) != len(expected) {
+		t.Fatalf("got VPC dependencies %v, expected %v", got, expected)
+	}
+
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Fatalf("got VPC dependencies %v, expected %v", got, expected)
+		}
+	}
+
+	for _, sweepResource := range sweepResources[:len(sweepResources)-1] {
+		if n := len(sweepResource.Dependencies()); n != 0 {
+			t.Errorf("got %d dependencies for %s, expected none", n, sweepResource.ID())
+		}
+	}
+}
+
+func TestVPCSweepResources_noDependencies(t *testing.T) {
+	sweepResources := vpcSweepResources(nil, "vpc-1", nil, nil, nil)
+
+	if got, expected := len(sweepResources), 1; got != expected {
+		t.Fatalf("got %d sweep resources, expected %d", got, expected)
+	}
+
+	if got := len(sweepResources[0].Dependencies()); got != 0 {
+		t.Errorf("got %d VPC dependencies, expected none", got)
+	}
+}
@@ -34,16 +34,7 @@ func getInstanceTagValue(conn *ec2.EC2, instanceId string, tagKey string) (*stri
 
 // ec2TagSpecificationsFromKeyValueTags returns the tag specifications for the given KeyValueTags object and resource type.
 func ec2TagSpecificationsFromKeyValueTags(tags tftags.KeyValueTags, t string) []*ec2.TagSpecification {
-	if len(tags) == 0 {
-		return nil
-	}
-
-	return []*ec2.TagSpecification{
-		{
-			ResourceType: aws.String(t),
-			Tags:         Tags(tags.IgnoreAWS()),
-		},
-	}
+	return TagSpecificationsOnCreate(tags, t)
 }
 
 // ec2TagSpecificationsFromMap returns the tag specifications for the given tag key/value map and resource type.
@@ -147,3 +147,18 @@ func UpdateTags(conn *ec2.EC2, identifier string, oldTagsMap interface{}, newTag
 
 	return nil
 }
+
+// TagSpecificationsOnCreate returns the ec2 tag specifications used to tag a new resource of the given type in its create request.
+// Returns nil if there are no tags, so that no empty tag specification is sent.
+func TagSpecificationsOnCreate(tags tftags.KeyValueTags, resourceType string) []*ec2.TagSpecification {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	return []*ec2.TagSpecification{
+		{
+			ResourceType: aws.String(resourceType),
+			Tags:         Tags(tags.IgnoreAWS()),
+		},
+	}
+}
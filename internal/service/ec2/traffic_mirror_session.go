@@ -203,6 +203,7 @@ func resourceTrafficMirrorSessionRead(d *schema.ResourceData, meta interface{})
 
 	if tfawserr.ErrMessageContains(err, "InvalidTrafficMirrorSessionId.NotFound", "") {
 		log.Printf("[WARN] EC2 Traffic Mirror Session (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -213,6 +214,7 @@ func resourceTrafficMirrorSessionRead(d *schema.ResourceData, meta interface{})
 
 	if 0 == len(out.TrafficMirrorSessions) {
 		log.Printf("[WARN] EC2 Traffic Mirror Session (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
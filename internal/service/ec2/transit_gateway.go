@@ -168,6 +168,7 @@ func resourceTransitGatewayRead(d *schema.ResourceData, meta interface{}) error
 
 	if tfawserr.ErrMessageContains(err, "InvalidTransitGatewayID.NotFound", "") {
 		log.Printf("[WARN] EC2 Transit Gateway (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -178,12 +179,14 @@ func resourceTransitGatewayRead(d *schema.ResourceData, meta interface{}) error
 
 	if transitGateway == nil {
 		log.Printf("[WARN] EC2 Transit Gateway (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
 
 	if aws.StringValue(transitGateway.State) == ec2.TransitGatewayStateDeleting || aws.StringValue(transitGateway.State) == ec2.TransitGatewayStateDeleted {
 		log.Printf("[WARN] EC2 Transit Gateway (%s) in deleted state (%s), removing from state", d.Id(), aws.StringValue(transitGateway.State))
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
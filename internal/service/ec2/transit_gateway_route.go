@@ -110,6 +110,7 @@ func resourceTransitGatewayRouteRead(d *schema.ResourceData, meta interface{}) e
 
 	if tfawserr.ErrMessageContains(err, "InvalidRouteTableID.NotFound", "") {
 		log.Printf("[WARN] EC2 Transit Gateway Route Table (%s) not found, removing from state", transitGatewayRouteTableID)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -126,6 +127,7 @@ func resourceTransitGatewayRouteRead(d *schema.ResourceData, meta interface{}) e
 
 	if transitGatewayRoute == nil {
 		log.Printf("[WARN] EC2 Transit Gateway Route (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -133,6 +135,7 @@ func resourceTransitGatewayRouteRead(d *schema.ResourceData, meta interface{}) e
 	state := aws.StringValue(transitGatewayRoute.State)
 	if state == ec2.TransitGatewayRouteStateDeleted || state == ec2.TransitGatewayRouteStateDeleting {
 		log.Printf("[WARN] EC2 Transit Gateway Route (%s) deleted, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
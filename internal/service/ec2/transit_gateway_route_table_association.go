@@ -83,6 +83,7 @@ func resourceTransitGatewayRouteTableAssociationRead(d *schema.ResourceData, met
 
 	if tfawserr.ErrMessageContains(err, "InvalidRouteTableID.NotFound", "") {
 		log.Printf("[WARN] EC2 Transit Gateway Route Table (%s) not found, removing from state", transitGatewayRouteTableID)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -93,12 +94,14 @@ func resourceTransitGatewayRouteTableAssociationRead(d *schema.ResourceData, met
 
 	if transitGatewayAssociation == nil {
 		log.Printf("[WARN] EC2 Transit Gateway Route Table (%s) Association (%s) not found, removing from state", transitGatewayRouteTableID, transitGatewayAttachmentID)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
 
 	if aws.StringValue(transitGatewayAssociation.State) == ec2.TransitGatewayAssociationStateDisassociating {
 		log.Printf("[WARN] EC2 Transit Gateway Route Table (%s) Association (%s) in deleted state (%s), removing from state", transitGatewayRouteTableID, transitGatewayAttachmentID, aws.StringValue(transitGatewayAssociation.State))
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
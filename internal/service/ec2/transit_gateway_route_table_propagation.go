@@ -83,6 +83,7 @@ func resourceTransitGatewayRouteTablePropagationRead(d *schema.ResourceData, met
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, ErrCodeInvalidRouteTableIDNotFound) {
 		log.Printf("[WARN] EC2 Transit Gateway Route Table (%s) not found, removing from state", transitGatewayRouteTableID)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -97,6 +98,7 @@ func resourceTransitGatewayRouteTablePropagationRead(d *schema.ResourceData, met
 		}
 
 		log.Printf("[WARN] EC2 Transit Gateway Route Table (%s) Propagation (%s) not found, removing from state", transitGatewayRouteTableID, transitGatewayAttachmentID)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -175,6 +175,7 @@ func resourceVolumeAttachmentRead(d *schema.ResourceData, meta interface{}) erro
 	vols, err := conn.DescribeVolumes(request)
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, "InvalidVolume.NotFound", "") {
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -183,6 +184,7 @@ func resourceVolumeAttachmentRead(d *schema.ResourceData, meta interface{}) erro
 
 	if len(vols.Volumes) == 0 || aws.StringValue(vols.Volumes[0].State) == ec2.VolumeStateAvailable {
 		log.Printf("[DEBUG] Volume Attachment (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 	}
 
@@ -282,6 +282,7 @@ func resourceVPCRead(d *schema.ResourceData, meta interface{}) error {
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, "InvalidVpcID.NotFound") {
 		log.Printf("[WARN] EC2 VPC (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -296,6 +297,7 @@ func resourceVPCRead(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[WARN] EC2 VPC (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -171,6 +171,7 @@ func resourceVPCDHCPOptionsRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		if isNoSuchDhcpOptionIDErr(err) {
 			log.Printf("[WARN] DHCP Options (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -115,6 +115,7 @@ func resourceVPCDHCPOptionsAssociationRead(d *schema.ResourceData, meta interfac
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, ErrCodeInvalidVPCIDNotFound) {
 		log.Printf("[WARN] EC2 VPC DHCP Options Association (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
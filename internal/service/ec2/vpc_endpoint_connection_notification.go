@@ -96,6 +96,7 @@ func resourceVPCEndpointConnectionNotificationRead(d *schema.ResourceData, meta
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, "InvalidConnectionNotification", "") {
 			log.Printf("[WARN] VPC Endpoint connection notification (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
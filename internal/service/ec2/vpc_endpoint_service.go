@@ -197,6 +197,7 @@ func resourceVPCEndpointServiceRead(d *schema.ResourceData, meta interface{}) er
 	}
 	if _, ok := terminalStates[state]; ok {
 		log.Printf("[WARN] VPC Endpoint Service (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -99,6 +99,7 @@ func resourceVPCIPv4CIDRBlockAssociationRead(d *schema.ResourceData, meta interf
 
 	if output == nil || len(output.Vpcs) == 0 || output.Vpcs[0] == nil {
 		log.Printf("[WARN] IPv4 CIDR block association (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -115,6 +116,7 @@ func resourceVPCIPv4CIDRBlockAssociationRead(d *schema.ResourceData, meta interf
 
 	if vpcCidrBlockAssociation == nil {
 		log.Printf("[WARN] IPv4 CIDR block association (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
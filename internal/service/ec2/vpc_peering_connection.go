@@ -141,6 +141,7 @@ func resourceVPCPeeringRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	if _, ok := status[statusCode]; ok {
 		log.Printf("[WARN] VPC Peering Connection (%s) has status code %s, removing from state", d.Id(), statusCode)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
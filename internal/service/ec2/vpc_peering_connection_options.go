@@ -51,6 +51,7 @@ func resourceVPCPeeringConnectionOptionsRead(d *schema.ResourceData, meta interf
 
 	if pc == nil {
 		log.Printf("[WARN] VPC Peering Connection (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -596,6 +596,7 @@ func resourceVPNConnectionRead(d *schema.ResourceData, meta interface{}) error {
 
 	if tfawserr.ErrMessageContains(err, "InvalidVpnConnectionID.NotFound", "") {
 		log.Printf("[WARN] EC2 VPN Connection (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -616,6 +617,7 @@ func resourceVPNConnectionRead(d *schema.ResourceData, meta interface{}) error {
 
 	if aws.StringValue(vpnConnection.State) == ec2.VpnStateDeleted {
 		log.Printf("[WARN] EC2 VPN Connection (%s) already deleted, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
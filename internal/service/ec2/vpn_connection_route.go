@@ -89,7 +89,7 @@ func resourceVPNConnectionRouteRead(d *schema.ResourceData, meta interface{}) er
 	}
 	if route == nil {
 		// Something other than terraform eliminated the route.
-		d.SetId("")
+		d.SetId("") //lintignore:AWSR003
 	}
 
 	return nil
@@ -110,6 +110,7 @@ func resourceVPNGatewayRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, "InvalidVpnGatewayID.NotFound", "") {
 			log.Printf("[WARN] VPC Gateway (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		} else {
@@ -121,6 +122,7 @@ func resourceVPNGatewayRead(d *schema.ResourceData, meta interface{}) error {
 	vpnGateway := resp.VpnGateways[0]
 	if vpnGateway == nil || aws.StringValue(vpnGateway.State) == ec2.VpnStateDeleted {
 		log.Printf("[WARN] VPC Gateway (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
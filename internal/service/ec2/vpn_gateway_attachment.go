@@ -72,6 +72,7 @@ func resourceVPNGatewayAttachmentRead(d *schema.ResourceData, meta interface{})
 
 	if tfawserr.ErrMessageContains(err, InvalidVPNGatewayIDNotFound, "") {
 		log.Printf("[WARN] VPN Gateway (%s) Attachment (%s) not found, removing from state", vgwId, vpcId)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -82,6 +83,7 @@ func resourceVPNGatewayAttachmentRead(d *schema.ResourceData, meta interface{})
 
 	if vpcAttachment == nil || aws.StringValue(vpcAttachment.State) == ec2.AttachmentStatusDetached {
 		log.Printf("[WARN] VPN Gateway (%s) Attachment (%s) not found, removing from state", vgwId, vpcId)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
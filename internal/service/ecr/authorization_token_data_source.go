@@ -66,6 +66,7 @@ func dataSourceAuthorizationTokenRead(d *schema.ResourceData, meta interface{})
 	proxyEndpoint := aws.StringValue(authorizationData.ProxyEndpoint)
 	authBytes, err := base64.URLEncoding.DecodeString(authorizationToken)
 	if err != nil {
+		//lintignore:AWSR003
 		d.SetId("")
 		return fmt.Errorf("error decoding ECR authorization token: %w", err)
 	}
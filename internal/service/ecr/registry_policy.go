@@ -65,6 +65,7 @@ func resourceRegistryPolicyRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, ecr.ErrCodeRegistryPolicyNotFoundException) {
 			log.Printf("[WARN] ECR Registry (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
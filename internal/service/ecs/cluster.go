@@ -182,7 +182,7 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 	input := &ecs.CreateClusterInput{
 		ClusterName:                     aws.String(clusterName),
 		DefaultCapacityProviderStrategy: expandEcsCapacityProviderStrategy(d.Get("default_capacity_provider_strategy").(*schema.Set)),
-		Tags:                            Tags(tags.IgnoreAWS()),
+		Tags:                            TagsOnCreate(tags),
 	}
 
 	if v, ok := d.GetOk("capacity_providers"); ok {
@@ -197,6 +197,38 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 		input.Configuration = expandECSClusterConfiguration(v.([]interface{}))
 	}
 
+	out, err := retryClusterCreate(conn, input)
+
+	// Some partitions may not support tag-on-create, so attempt to tag after create.
+	if input.Tags != nil && TagOnCreateUnsupported(meta.(*conns.AWSClient).Partition, err) {
+		log.Printf("[WARN] ECS tagging failed creating Cluster (%s) with tags: %s. Trying create without tags.", clusterName, err)
+		input.Tags = nil
+
+		out, err = retryClusterCreate(conn, input)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error creating ECS Cluster (%s): %w", clusterName, err)
+	}
+
+	log.Printf("[DEBUG] ECS cluster %s created", aws.StringValue(out.Cluster.ClusterArn))
+
+	d.SetId(aws.StringValue(out.Cluster.ClusterArn))
+
+	if _, err := waitClusterAvailable(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for ECS Cluster (%s) to become Available: %w", d.Id(), err)
+	}
+
+	if input.Tags == nil && len(tags.IgnoreAWS()) > 0 {
+		if err := UpdateTags(conn, d.Id(), nil, tags); err != nil {
+			return fmt.Errorf("error adding tags to ECS Cluster (%s): %w", d.Id(), err)
+		}
+	}
+
+	return resourceClusterRead(d, meta)
+}
+
+func retryClusterCreate(conn *ecs.ECS, input *ecs.CreateClusterInput) (*ecs.CreateClusterOutput, error) {
 	// CreateCluster will create the ECS IAM Service Linked Role on first ECS provision
 	// This process does not complete before the initial API call finishes.
 	var out *ecs.CreateClusterOutput
@@ -219,19 +251,7 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 		out, err = conn.CreateCluster(input)
 	}
 
-	if err != nil {
-		return fmt.Errorf("error creating ECS Cluster (%s): %w", clusterName, err)
-	}
-
-	log.Printf("[DEBUG] ECS cluster %s created", aws.StringValue(out.Cluster.ClusterArn))
-
-	d.SetId(aws.StringValue(out.Cluster.ClusterArn))
-
-	if _, err := waitClusterAvailable(conn, d.Id()); err != nil {
-		return fmt.Errorf("error waiting for ECS Cluster (%s) to become Available: %w", d.Id(), err)
-	}
-
-	return resourceClusterRead(d, meta)
+	return out, err
 }
 
 func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
@@ -281,6 +301,7 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 
 	if cluster == nil {
 		log.Printf("[WARN] ECS Cluster (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -288,6 +309,7 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 	// Status==INACTIVE means deleted cluster
 	if aws.StringValue(cluster.Status) == "INACTIVE" {
 		log.Printf("[WARN] ECS Cluster (%s) deleted, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
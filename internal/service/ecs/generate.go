@@ -1,6 +1,6 @@
 //go:generate go run -tags generate ../../generate/listpages/main.go -ListOps=DescribeCapacityProviders -Export=yes
 //go:generate go run -tags generate ../../generate/tagresource/main.go
-//go:generate go run -tags generate ../../generate/tags/main.go -GetTag=yes -ListTags=yes -ServiceTagsSlice=yes -UpdateTags=yes -ParentNotFoundErrCode=InvalidParameterException "-ParentNotFoundErrMsg=The specified cluster is inactive. Specify an active cluster and try again."
+//go:generate go run -tags generate ../../generate/tags/main.go -CreateTags=yes -CreateTagsUnsupportedErrCodes=AccessDeniedException,InvalidParameterException,UnsupportedOperation -GetTag=yes -ListTags=yes -ServiceTagsSlice=yes -UpdateTags=yes -ParentNotFoundErrCode=InvalidParameterException "-ParentNotFoundErrMsg=The specified cluster is inactive. Specify an active cluster and try again."
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package ecs
@@ -607,6 +607,7 @@ func resourceServiceRead(d *schema.ResourceData, meta interface{}) error {
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, ecs.ErrCodeServiceNotFoundException) {
 		log.Printf("[WARN] ECS service (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -617,6 +618,7 @@ func resourceServiceRead(d *schema.ResourceData, meta interface{}) error {
 
 	if tfawserr.ErrCodeEquals(err, ecs.ErrCodeClusterNotFoundException) {
 		log.Printf("[WARN] ECS Service %s parent cluster %s not found, removing from state.", d.Id(), d.Get("cluster").(string))
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -630,6 +632,7 @@ func resourceServiceRead(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("ECS service not created: %q", d.Id())
 		}
 		log.Printf("[WARN] Removing ECS service %s (%s) because it's gone", d.Get("name").(string), d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -639,6 +642,7 @@ func resourceServiceRead(d *schema.ResourceData, meta interface{}) error {
 	// Status==INACTIVE means deleted service
 	if aws.StringValue(service.Status) == "INACTIVE" {
 		log.Printf("[WARN] Removing ECS service %q because it's INACTIVE", aws.StringValue(service.ServiceArn))
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -116,7 +116,7 @@ func sweepClusters(region string) error {
 			r := ResourceCluster()
 			d := r.Data(nil)
 			d.SetId(clusterARN)
-			err = r.Delete(d, client)
+			err = sweep.DeleteResource(r, d, client)
 			if err != nil {
 				log.Printf("[ERROR] Error deleting ECS Cluster (%s): %s", clusterARN, err)
 			}
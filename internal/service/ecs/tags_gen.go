@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -119,3 +120,24 @@ func UpdateTags(conn *ecs.ECS, identifier string, oldTagsMap interface{}, newTag
 
 	return nil
 }
+
+// TagsOnCreate returns the ecs service tags used to tag a new resource in its create request.
+// Returns nil if there are no tags, so that APIs without tag-on-create support are not sent an empty tags field.
+func TagsOnCreate(tags tftags.KeyValueTags) []*ecs.Tag {
+	if tags = tags.IgnoreAWS(); len(tags) == 0 {
+		return nil
+	}
+
+	return Tags(tags)
+}
+
+// TagOnCreateUnsupported returns whether the error indicates that a create request was rejected by ecs because it included tags.
+// Tag-on-create is supported in the standard AWS partition, so errors there are never treated as such; elsewhere, for example
+// in ISO partitions, callers should retry the create request without tags and then tag the new resource using UpdateTags.
+func TagOnCreateUnsupported(partition string, err error) bool {
+	if partition == endpoints.AwsPartitionID {
+		return false
+	}
+
+	return tfawserr.ErrCodeEquals(err, "AccessDeniedException", "InvalidParameterException", "UnsupportedOperation")
+}
@@ -531,6 +531,7 @@ func resourceTaskDefinitionRead(d *schema.ResourceData, meta interface{}) error
 
 	if aws.StringValue(taskDefinition.Status) == ecs.TaskDefinitionStatusInactive {
 		log.Printf("[DEBUG] Removing ECS task definition %s because it's INACTIVE", aws.StringValue(out.TaskDefinition.Family))
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
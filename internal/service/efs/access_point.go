@@ -183,6 +183,7 @@ func resourceAccessPointRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, efs.ErrCodeAccessPointNotFound, "") {
 			log.Printf("[WARN] EFS access point %q could not be found.", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
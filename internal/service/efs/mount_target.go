@@ -194,6 +194,7 @@ func resourceMountTargetRead(d *schema.ResourceData, meta interface{}) error {
 			// which would indicate that it might be
 			// already deleted.
 			log.Printf("[WARN] EFS mount target %q could not be found.", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
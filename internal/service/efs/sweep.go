@@ -74,7 +74,7 @@ func sweepAccessPoints(region string) error {
 					r := ResourceAccessPoint()
 					d := r.Data(nil)
 					d.SetId(id)
-					err := r.Delete(d, client)
+					err := sweep.DeleteResource(r, d, client)
 
 					if err != nil {
 						log.Printf("[ERROR] %s", err)
@@ -116,7 +116,7 @@ func sweepFileSystems(region string) error {
 			r := ResourceFileSystem()
 			d := r.Data(nil)
 			d.SetId(id)
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 
 			if err != nil {
 				log.Printf("[ERROR] %s", err)
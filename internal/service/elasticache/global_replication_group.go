@@ -189,6 +189,7 @@ func resourceGlobalReplicationGroupRead(d *schema.ResourceData, meta interface{}
 
 	if aws.StringValue(globalReplicationGroup.Status) == "deleting" || aws.StringValue(globalReplicationGroup.Status) == "deleted" {
 		log.Printf("[WARN] ElastiCache Global Replication Group (%s) in deleted state (%s), removing from state", d.Id(), aws.StringValue(globalReplicationGroup.Status))
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
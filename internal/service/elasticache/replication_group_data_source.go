@@ -122,6 +122,7 @@ func dataSourceReplicationGroupRead(d *schema.ResourceData, meta interface{}) er
 		d.Set("configuration_endpoint_address", rg.ConfigurationEndpoint.Address)
 	} else {
 		if rg.NodeGroups == nil {
+			//lintignore:AWSR003
 			d.SetId("")
 			return fmt.Errorf("ElastiCache Replication Group (%s) doesn't have node groups", aws.StringValue(rg.ReplicationGroupId))
 		}
@@ -126,6 +126,7 @@ func resourceSubnetGroupRead(d *schema.ResourceData, meta interface{}) error {
 		if ec2err, ok := err.(awserr.Error); ok && ec2err.Code() == "CacheSubnetGroupNotFoundFault" {
 			// Update state to indicate the db subnet no longer exists.
 			log.Printf("[WARN] Elasticache Subnet Group (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -246,6 +246,7 @@ func resourceApplicationRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if app == nil {
 			log.Printf("[WARN] %s, removing from state", err)
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -115,6 +115,7 @@ func resourceApplicationVersionRead(d *schema.ResourceData, meta interface{}) er
 	if len(resp.ApplicationVersions) == 0 {
 		log.Printf("[DEBUG] Elastic Beanstalk application version read: application version not found")
 
+		//lintignore:AWSR003
 		d.SetId("")
 
 		return nil
@@ -106,10 +106,12 @@ func resourceConfigurationTemplateRead(d *schema.ResourceData, meta interface{})
 		if awsErr, ok := err.(awserr.Error); ok {
 			if awsErr.Code() == "InvalidParameterValue" && strings.Contains(awsErr.Message(), "No Configuration Template named") {
 				log.Printf("[WARN] No Configuration Template named (%s) found", d.Id())
+				//lintignore:AWSR003
 				d.SetId("")
 				return nil
 			} else if awsErr.Code() == "InvalidParameterValue" && strings.Contains(awsErr.Message(), "No Platform named") {
 				log.Printf("[WARN] No Platform named (%s) found", d.Get("solution_stack_name").(string))
+				//lintignore:AWSR003
 				d.SetId("")
 				return nil
 			}
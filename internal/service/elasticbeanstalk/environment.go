@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/nullable"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -163,21 +164,9 @@ func ResourceEnvironment() *schema.Resource {
 				},
 			},
 			"poll_interval": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
-					value := v.(string)
-					duration, err := time.ParseDuration(value)
-					if err != nil {
-						errors = append(errors, fmt.Errorf(
-							"%q cannot be parsed as a duration: %s", k, err))
-					}
-					if duration < 10*time.Second || duration > 60*time.Second {
-						errors = append(errors, fmt.Errorf(
-							"%q must be between 10s and 180s", k))
-					}
-					return
-				},
+				Type:         nullable.TypeNullableDuration,
+				Optional:     true,
+				ValidateFunc: nullable.ValidateTypeStringNullableDurationBetween(10*time.Second, 60*time.Second),
 			},
 			"autoscaling_groups": {
 				Type:     schema.TypeList,
@@ -299,7 +288,7 @@ func resourceEnvironmentCreate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	pollInterval, err := time.ParseDuration(d.Get("poll_interval").(string))
+	pollInterval, _, err := nullable.Duration(d.Get("poll_interval").(string)).Value()
 	if err != nil {
 		pollInterval = 0
 		log.Printf("[WARN] Error parsing poll_interval, using default backoff")
@@ -441,7 +430,7 @@ func resourceEnvironmentUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			return err
 		}
-		pollInterval, err := time.ParseDuration(d.Get("poll_interval").(string))
+		pollInterval, _, err := nullable.Duration(d.Get("poll_interval").(string)).Value()
 		if err != nil {
 			pollInterval = 0
 			log.Printf("[WARN] Error parsing poll_interval, using default backoff")
@@ -477,7 +466,7 @@ func resourceEnvironmentUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			return err
 		}
-		pollInterval, err := time.ParseDuration(d.Get("poll_interval").(string))
+		pollInterval, _, err := nullable.Duration(d.Get("poll_interval").(string)).Value()
 		if err != nil {
 			pollInterval = 0
 			log.Printf("[WARN] Error parsing poll_interval, using default backoff")
@@ -520,6 +509,7 @@ func resourceEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
 	if len(resp.Environments) == 0 {
 		log.Printf("[DEBUG] Elastic Beanstalk environment properties: could not find environment %s", d.Id())
 
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	} else if len(resp.Environments) != 1 {
@@ -531,6 +521,7 @@ func resourceEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
 	if *env.Status == "Terminated" {
 		log.Printf("[DEBUG] Elastic Beanstalk environment %s was terminated", d.Id())
 
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -744,7 +735,7 @@ func resourceEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return err
 	}
-	pollInterval, err := time.ParseDuration(d.Get("poll_interval").(string))
+	pollInterval, _, err := nullable.Duration(d.Get("poll_interval").(string)).Value()
 	if err != nil {
 		pollInterval = 0
 		log.Printf("[WARN] Error parsing poll_interval, using default backoff")
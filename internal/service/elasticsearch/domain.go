@@ -687,6 +687,7 @@ func resourceDomainRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if ec2err, ok := err.(awserr.Error); ok && ec2err.Code() == "ResourceNotFoundException" {
 			log.Printf("[INFO] ElasticSearch Domain %q not found", d.Get("domain_name").(string))
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -45,6 +45,7 @@ func resourceDomainPolicyRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "ResourceNotFoundException" {
 			log.Printf("[WARN] ElasticSearch Domain %q not found, removing", name)
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -462,6 +462,7 @@ func resourcePipelineRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, elastictranscoder.ErrCodeResourceNotFoundException, "") {
 			log.Printf("[WARN] No such resource found for Elastic Transcoder Pipeline (%s)", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
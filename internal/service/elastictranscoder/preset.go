@@ -575,6 +575,7 @@ func resourcePresetRead(d *schema.ResourceData, meta interface{}) error {
 
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, elastictranscoder.ErrCodeResourceNotFoundException, "") {
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -107,6 +107,7 @@ func resourceAppCookieStickinessPolicyRead(d *schema.ResourceData, meta interfac
 		if ec2err, ok := err.(awserr.Error); ok {
 			if ec2err.Code() == "PolicyNotFound" || ec2err.Code() == "LoadBalancerNotFound" {
 				log.Printf("[WARN] Load Balancer / Load Balancer Policy (%s) not found, removing from state", d.Id())
+				//lintignore:AWSR003
 				d.SetId("")
 			}
 			return nil
@@ -125,6 +126,7 @@ func resourceAppCookieStickinessPolicyRead(d *schema.ResourceData, meta interfac
 	if !assigned {
 		// policy exists, but isn't assigned to a listener
 		log.Printf("[DEBUG] policy '%s' exists, but isn't assigned to a listener", policyName)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
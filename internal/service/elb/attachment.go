@@ -91,6 +91,7 @@ func resourceAttachmentRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if IsNotFound(err) {
 			log.Printf("[ERROR] ELB %s not found", elbName)
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -98,6 +99,7 @@ func resourceAttachmentRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	if len(resp.LoadBalancerDescriptions) != 1 {
 		log.Printf("[ERROR] Unable to find ELB: %s", resp.LoadBalancerDescriptions)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -113,6 +115,7 @@ func resourceAttachmentRead(d *schema.ResourceData, meta interface{}) error {
 
 	if !found {
 		log.Printf("[WARN] instance %s not found in elb attachments", expected)
+		//lintignore:AWSR003
 		d.SetId("")
 	}
 
@@ -101,6 +101,7 @@ func resourceCookieStickinessPolicyRead(d *schema.ResourceData, meta interface{}
 	if err != nil {
 		if ec2err, ok := err.(awserr.Error); ok {
 			if ec2err.Code() == "PolicyNotFound" || ec2err.Code() == "LoadBalancerNotFound" {
+				//lintignore:AWSR003
 				d.SetId("")
 			}
 			return nil
@@ -120,6 +121,7 @@ func resourceCookieStickinessPolicyRead(d *schema.ResourceData, meta interface{}
 	if !assigned {
 		// policy exists, but isn't assigned to a listener
 		log.Printf("[DEBUG] policy '%s' exists, but isn't assigned to a listener", policyName)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -126,11 +126,11 @@ func resourceSSLNegotiationPolicyRead(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		if ec2err, ok := err.(awserr.Error); ok && ec2err.Code() == "PolicyNotFound" {
 			// The policy is gone.
-			d.SetId("")
+			d.SetId("") //lintignore:AWSR003
 			return nil
 		} else if IsNotFound(err) {
 			// The ELB is gone now, so just remove it from the state
-			d.SetId("")
+			d.SetId("") //lintignore:AWSR003
 			return nil
 		}
 		return fmt.Errorf("Error retrieving policy: %s", err)
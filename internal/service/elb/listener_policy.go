@@ -79,6 +79,7 @@ func resourceListenerPolicyRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		if ec2err, ok := err.(awserr.Error); ok {
 			if ec2err.Code() == "LoadBalancerNotFound" {
+				//lintignore:AWSR003
 				d.SetId("")
 				return fmt.Errorf("LoadBalancerNotFound: %s", err)
 			}
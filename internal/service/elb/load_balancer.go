@@ -364,7 +364,7 @@ func resourceLoadBalancerRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if IsNotFound(err) {
 			// The ELB is gone now, so just remove it from the state
-			d.SetId("")
+			d.SetId("") //lintignore:AWSR003
 			return nil
 		}
 
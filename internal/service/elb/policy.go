@@ -106,12 +106,14 @@ func resourcePolicyRead(d *schema.ResourceData, meta interface{}) error {
 
 	if tfawserr.ErrMessageContains(err, "LoadBalancerNotFound", "") {
 		log.Printf("[WARN] Load Balancer Policy (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
 
 	if tfawserr.ErrMessageContains(err, elb.ErrCodePolicyNotFoundException, "") {
 		log.Printf("[WARN] Load Balancer Policy (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
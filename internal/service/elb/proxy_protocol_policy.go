@@ -78,7 +78,7 @@ func resourceProxyProtocolPolicyRead(d *schema.ResourceData, meta interface{}) e
 	if err != nil {
 		if IsNotFound(err) {
 			// The ELB is gone now, so just remove it from the state
-			d.SetId("")
+			d.SetId("") //lintignore:AWSR003
 			return nil
 		}
 		return fmt.Errorf("Error retrieving ELB attributes: %s", err)
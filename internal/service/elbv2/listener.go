@@ -495,6 +495,7 @@ func resourceListenerRead(d *schema.ResourceData, meta interface{}) error {
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, elbv2.ErrCodeListenerNotFoundException) {
 		log.Printf("[WARN] ELBv2 Listener (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -508,6 +509,7 @@ func resourceListenerRead(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("error describing ELBv2 Listener (%s): empty response", d.Id())
 		}
 		log.Printf("[WARN] ELBv2 Listener (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -120,6 +120,7 @@ func resourceListenerCertificateRead(d *schema.ResourceData, meta interface{}) e
 	if err != nil {
 		if certificate == nil {
 			log.Printf("[WARN] %s - removing from state", err)
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -577,6 +577,7 @@ func resourceListenerRuleRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, elbv2.ErrCodeRuleNotFoundException, "") {
 			log.Printf("[WARN] DescribeRules - removing %s from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
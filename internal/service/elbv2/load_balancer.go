@@ -362,6 +362,7 @@ func resourceLoadBalancerRead(d *schema.ResourceData, meta interface{}) error {
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, elb.ErrCodeAccessPointNotFoundException) {
 		// The ALB is gone now, so just remove it from the state
 		log.Printf("[WARN] ALB %s not found in AWS, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -375,6 +376,7 @@ func resourceLoadBalancerRead(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("error retrieving ALB (%s): empty output after creation", d.Id())
 		}
 		log.Printf("[WARN] ALB %s not found in AWS, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -150,11 +150,13 @@ func resourceAttachmentRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, elbv2.ErrCodeTargetGroupNotFoundException, "") {
 			log.Printf("[WARN] Target group does not exist, removing target attachment %s", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
 		if tfawserr.ErrMessageContains(err, elbv2.ErrCodeInvalidTargetException, "") {
 			log.Printf("[WARN] Target does not exist, removing target attachment %s", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -178,6 +180,7 @@ func resourceAttachmentRead(d *schema.ResourceData, meta interface{}) error {
 
 			if reason == elbv2.TargetHealthReasonEnumTargetNotRegistered || reason == elbv2.TargetHealthReasonEnumTargetDeregistrationInProgress {
 				log.Printf("[WARN] Target Attachment does not exist, recreating attachment")
+				//lintignore:AWSR003
 				d.SetId("")
 				return nil
 			}
@@ -186,6 +189,7 @@ func resourceAttachmentRead(d *schema.ResourceData, meta interface{}) error {
 
 	if len(resp.TargetHealthDescriptions) != 1 {
 		log.Printf("[WARN] Target does not exist, removing target attachment %s", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
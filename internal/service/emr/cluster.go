@@ -1022,6 +1022,7 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 		//   ErrorCode: "NoSuchCluster",
 		if tfawserr.ErrMessageContains(err, emr.ErrCodeInvalidRequestException, "is not valid") {
 			log.Printf("[DEBUG] EMR Cluster (%s) not found", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -1030,6 +1031,7 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 
 	if resp.Cluster == nil {
 		log.Printf("[DEBUG] EMR Cluster (%s) not found", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -1041,6 +1043,7 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 
 		if state == emr.ClusterStateTerminated || state == emr.ClusterStateTerminatedWithErrors {
 			log.Printf("[WARN] EMR Cluster (%s) was %s already, removing from state", d.Id(), state)
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -257,6 +257,7 @@ func resourceInstanceFleetRead(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[DEBUG] EMR Instance Fleet (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -218,6 +218,7 @@ func resourceInstanceGroupRead(d *schema.ResourceData, meta interface{}) error {
 			fallthrough
 		case emr.InstanceGroupStateTerminated:
 			log.Printf("[DEBUG] EMR Instance Group (%s) terminated, removing", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
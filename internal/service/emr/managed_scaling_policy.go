@@ -112,12 +112,14 @@ func resourceManagedScalingPolicyRead(d *schema.ResourceData, meta interface{})
 
 	if tfawserr.ErrMessageContains(err, "ValidationException", "A job flow that is shutting down, terminated, or finished may not be modified") {
 		log.Printf("[WARN] EMR Managed Scaling Policy (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
 
 	if tfawserr.ErrMessageContains(err, "InvalidRequestException", "does not exist") {
 		log.Printf("[WARN] EMR Managed Scaling Policy (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -130,6 +132,7 @@ func resourceManagedScalingPolicyRead(d *schema.ResourceData, meta interface{})
 	// returns an empty response. We keep the original error handling above though just in case.
 	if resp == nil || resp.ManagedScalingPolicy == nil {
 		log.Printf("[WARN] EMR Managed Scaling Policy (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -90,6 +90,7 @@ func resourceSecurityConfigurationRead(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, "InvalidRequestException", "does not exist") {
 			log.Printf("[WARN] EMR Security Configuration (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
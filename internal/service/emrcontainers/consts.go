@@ -0,0 +1,10 @@
+package emrcontainers
+
+import (
+	"time"
+)
+
+const (
+	virtualClusterDefaultDeleteTimeout = 90 * time.Minute
+	virtualClusterRunningTimeout       = 5 * time.Minute
+)
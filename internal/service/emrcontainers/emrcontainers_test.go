@@ -0,0 +1,37 @@
+package emrcontainers_test
+
+import (
+	"testing"
+)
+
+const (
+	EnvVarEMRContainersEKSClusterName             = "EMRCONTAINERS_EKS_CLUSTER_NAME"
+	EnvVarEMRContainersEKSClusterNameMessageError = "Environment variable EMRCONTAINERS_EKS_CLUSTER_NAME is not set. " +
+		"To test EMR Containers virtual clusters, the name of an EKS cluster with EMR on EKS cluster access enabled for the \"default\" namespace must be provided."
+)
+
+// Only one active virtual cluster can exist per EKS namespace, so the tests must not run in parallel.
+func TestAccEMRContainers_serial(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"VirtualCluster": {
+			"basic":      testAccVirtualCluster_basic,
+			"disappears": testAccVirtualCluster_disappears,
+			"tags":       testAccVirtualCluster_tags,
+		},
+		"VirtualClusterDataSource": {
+			"basic": testAccVirtualClusterDataSource_basic,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
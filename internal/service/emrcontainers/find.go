@@ -0,0 +1,46 @@
+package emrcontainers
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/emrcontainers"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+//waiter:status Name=VirtualCluster Status=State
+//waiter:wait Name=VirtualClusterDeleted Status=VirtualCluster Pending=emrcontainers.VirtualClusterStateRunning,emrcontainers.VirtualClusterStateArrested,emrcontainers.VirtualClusterStateTerminating
+func FindVirtualClusterByID(ctx context.Context, conn *emrcontainers.EMRContainers, id string) (*emrcontainers.VirtualCluster, error) {
+	input := &emrcontainers.DescribeVirtualClusterInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.DescribeVirtualClusterWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, emrcontainers.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.VirtualCluster == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	// Terminated virtual clusters remain visible for some time; treat them as not found.
+	if state := aws.StringValue(output.VirtualCluster.State); state == emrcontainers.VirtualClusterStateTerminated {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	return output.VirtualCluster, nil
+}
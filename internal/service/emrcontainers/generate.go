@@ -0,0 +1,5 @@
+//go:generate go run -tags generate ../../generate/tags/main.go -CreateTags=yes -ServiceTagsMap=yes -UpdateTags=yes
+//go:generate go run -tags generate ../../generate/waiters/main.go
+// ONLY generate directives and package declaration! Do not add anything else to this file.
+
+package emrcontainers
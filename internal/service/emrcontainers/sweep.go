@@ -0,0 +1,76 @@
+//go:build sweep
+// +build sweep
+
+package emrcontainers
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/emrcontainers"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_emrcontainers_virtual_cluster", &resource.Sweeper{
+		Name: "aws_emrcontainers_virtual_cluster",
+		F:    sweepVirtualClusters,
+	})
+}
+
+func sweepVirtualClusters(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).EMRContainersConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	ctx := context.Background()
+	var errs *multierror.Error
+
+	input := &emrcontainers.ListVirtualClustersInput{
+		States: aws.StringSlice([]string{emrcontainers.VirtualClusterStateRunning, emrcontainers.VirtualClusterStateArrested}),
+	}
+
+	err = conn.ListVirtualClustersPagesWithContext(ctx, input, func(page *emrcontainers.ListVirtualClustersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.VirtualClusters {
+			if v == nil {
+				continue
+			}
+
+			r := ResourceVirtualCluster()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing EMR Containers Virtual Clusters: %w", err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping EMR Containers Virtual Clusters for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping EMR Containers Virtual Cluster sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
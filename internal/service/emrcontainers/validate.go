@@ -0,0 +1,13 @@
+package emrcontainers
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// validVirtualClusterName validates EMR Containers virtual cluster names.
+var validVirtualClusterName = validation.All(
+	validation.StringLenBetween(1, 64),
+	validation.StringMatch(regexp.MustCompile(`^[-._/#A-Za-z0-9]+$`), "Only alphanumeric characters, hyphens, periods, underscores, slashes and hashes are allowed."),
+)
@@ -0,0 +1,37 @@
+package emrcontainers
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidVirtualClusterName(t *testing.T) {
+	validNames := []string{
+		"a",
+		"ExampleCluster",
+		"example-cluster_1.0",
+		"team/spark#1",
+		strings.Repeat("a", 64),
+	}
+
+	for _, v := range validNames {
+		_, errors := validVirtualClusterName(v, "name")
+		if len(errors) != 0 {
+			t.Errorf("%q should be a valid EMR Containers virtual cluster name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"example cluster",
+		"example:cluster",
+		strings.Repeat("a", 65),
+	}
+
+	for _, v := range invalidNames {
+		_, errors := validVirtualClusterName(v, "name")
+		if len(errors) == 0 {
+			t.Errorf("%q should be an invalid EMR Containers virtual cluster name", v)
+		}
+	}
+}
@@ -0,0 +1,292 @@
+package emrcontainers
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/emrcontainers"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceVirtualCluster() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVirtualClusterCreate,
+		ReadContext:   resourceVirtualClusterRead,
+		UpdateContext: resourceVirtualClusterUpdate,
+		DeleteContext: resourceVirtualClusterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(virtualClusterDefaultDeleteTimeout),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"container_provider": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"info": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"eks_info": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"namespace": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(emrcontainers.ContainerProviderType_Values(), false),
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validVirtualClusterName,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceVirtualClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EMRContainersConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &emrcontainers.CreateVirtualClusterInput{
+		Name: aws.String(name),
+		Tags: TagsOnCreate(tags),
+	}
+
+	if v, ok := d.GetOk("container_provider"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ContainerProvider = expandContainerProvider(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating EMR Containers Virtual Cluster: %s", input)
+	output, err := conn.CreateVirtualClusterWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating EMR Containers Virtual Cluster (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	if _, err := waitVirtualClusterRunning(ctx, conn, d.Id(), virtualClusterRunningTimeout); err != nil {
+		return diag.Errorf("error waiting for EMR Containers Virtual Cluster (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceVirtualClusterRead(ctx, d, meta)
+}
+
+func resourceVirtualClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EMRContainersConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	vc, err := FindVirtualClusterByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EMR Containers Virtual Cluster (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading EMR Containers Virtual Cluster (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", vc.Arn)
+
+	if err := d.Set("container_provider", flattenContainerProvider(vc.ContainerProvider)); err != nil {
+		return diag.Errorf("error setting container_provider: %s", err)
+	}
+
+	d.Set("name", vc.Name)
+
+	tags := KeyValueTags(vc.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceVirtualClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EMRContainersConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating EMR Containers Virtual Cluster (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceVirtualClusterRead(ctx, d, meta)
+}
+
+func resourceVirtualClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EMRContainersConn
+
+	log.Printf("[DEBUG] Deleting EMR Containers Virtual Cluster: (%s)", d.Id())
+	_, err := conn.DeleteVirtualClusterWithContext(ctx, &emrcontainers.DeleteVirtualClusterInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, emrcontainers.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting EMR Containers Virtual Cluster (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitVirtualClusterDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for EMR Containers Virtual Cluster (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandContainerProvider(tfMap map[string]interface{}) *emrcontainers.ContainerProvider {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &emrcontainers.ContainerProvider{}
+
+	if v, ok := tfMap["id"].(string); ok && v != "" {
+		apiObject.Id = aws.String(v)
+	}
+
+	if v, ok := tfMap["info"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Info = expandContainerInfo(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandContainerInfo(tfMap map[string]interface{}) *emrcontainers.ContainerInfo {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &emrcontainers.ContainerInfo{}
+
+	if v, ok := tfMap["eks_info"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.EksInfo = expandEksInfo(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandEksInfo(tfMap map[string]interface{}) *emrcontainers.EksInfo {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &emrcontainers.EksInfo{}
+
+	if v, ok := tfMap["namespace"].(string); ok && v != "" {
+		apiObject.Namespace = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenContainerProvider(apiObject *emrcontainers.ContainerProvider) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"id":   aws.StringValue(apiObject.Id),
+		"info": flattenContainerInfo(apiObject.Info),
+		"type": aws.StringValue(apiObject.Type),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenContainerInfo(apiObject *emrcontainers.ContainerInfo) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"eks_info": flattenEksInfo(apiObject.EksInfo),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenEksInfo(apiObject *emrcontainers.EksInfo) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"namespace": aws.StringValue(apiObject.Namespace),
+	}
+
+	return []interface{}{tfMap}
+}
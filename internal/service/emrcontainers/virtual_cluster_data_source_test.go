@@ -0,0 +1,53 @@
+package emrcontainers_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/emrcontainers"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func testAccVirtualClusterDataSource_basic(t *testing.T) {
+	eksClusterName := conns.SkipIfEnvVarEmpty(t, EnvVarEMRContainersEKSClusterName, EnvVarEMRContainersEKSClusterNameMessageError)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_emrcontainers_virtual_cluster.test"
+	resourceName := "aws_emrcontainers_virtual_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, emrcontainers.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVirtualClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVirtualClusterDataSourceConfig(rName, eksClusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "container_provider.#", resourceName, "container_provider.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "container_provider.0.id", resourceName, "container_provider.0.id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "container_provider.0.info.0.eks_info.0.namespace", resourceName, "container_provider.0.info.0.eks_info.0.namespace"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "container_provider.0.type", resourceName, "container_provider.0.type"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "created_at"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "state", "RUNNING"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.Test", resourceName, "tags.Test"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "virtual_cluster_id", resourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVirtualClusterDataSourceConfig(rName, eksClusterName string) string {
+	return acctest.ConfigCompose(
+		testAccVirtualClusterConfigTags1(rName, eksClusterName, "Test", "test"),
+		`
+data "aws_emrcontainers_virtual_cluster" "test" {
+  virtual_cluster_id = aws_emrcontainers_virtual_cluster.test.id
+}
+`)
+}
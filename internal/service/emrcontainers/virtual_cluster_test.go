@@ -0,0 +1,245 @@
+package emrcontainers_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/emrcontainers"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfemrcontainers "github.com/hashicorp/terraform-provider-aws/internal/service/emrcontainers"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccVirtualCluster_basic(t *testing.T) {
+	eksClusterName := conns.SkipIfEnvVarEmpty(t, EnvVarEMRContainersEKSClusterName, EnvVarEMRContainersEKSClusterNameMessageError)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_emrcontainers_virtual_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, emrcontainers.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVirtualClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVirtualClusterConfig(rName, eksClusterName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVirtualClusterExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "emr-containers", regexp.MustCompile(`/virtualclusters/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "container_provider.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_provider.0.id", eksClusterName),
+					resource.TestCheckResourceAttr(resourceName, "container_provider.0.info.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_provider.0.info.0.eks_info.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_provider.0.info.0.eks_info.0.namespace", "default"),
+					resource.TestCheckResourceAttr(resourceName, "container_provider.0.type", "EKS"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccVirtualCluster_disappears(t *testing.T) {
+	eksClusterName := conns.SkipIfEnvVarEmpty(t, EnvVarEMRContainersEKSClusterName, EnvVarEMRContainersEKSClusterNameMessageError)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_emrcontainers_virtual_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, emrcontainers.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVirtualClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVirtualClusterConfig(rName, eksClusterName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVirtualClusterExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfemrcontainers.ResourceVirtualCluster(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccVirtualCluster_tags(t *testing.T) {
+	eksClusterName := conns.SkipIfEnvVarEmpty(t, EnvVarEMRContainersEKSClusterName, EnvVarEMRContainersEKSClusterNameMessageError)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_emrcontainers_virtual_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, emrcontainers.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckVirtualClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVirtualClusterConfigTags1(rName, eksClusterName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVirtualClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVirtualClusterConfigTags2(rName, eksClusterName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVirtualClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccVirtualClusterConfigTags1(rName, eksClusterName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVirtualClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVirtualClusterDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EMRContainersConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_emrcontainers_virtual_cluster" {
+			continue
+		}
+
+		_, err := tfemrcontainers.FindVirtualClusterByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EMR Containers Virtual Cluster %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckVirtualClusterExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EMR Containers Virtual Cluster ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EMRContainersConn
+
+		_, err := tfemrcontainers.FindVirtualClusterByID(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccPreCheck(t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EMRContainersConn
+
+	input := &emrcontainers.ListVirtualClustersInput{}
+
+	_, err := conn.ListVirtualClusters(input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccVirtualClusterConfig(rName, eksClusterName string) string {
+	return fmt.Sprintf(`
+resource "aws_emrcontainers_virtual_cluster" "test" {
+  name = %[1]q
+
+  container_provider {
+    id   = %[2]q
+    type = "EKS"
+
+    info {
+      eks_info {
+        namespace = "default"
+      }
+    }
+  }
+}
+`, rName, eksClusterName)
+}
+
+func testAccVirtualClusterConfigTags1(rName, eksClusterName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_emrcontainers_virtual_cluster" "test" {
+  name = %[1]q
+
+  container_provider {
+    id   = %[2]q
+    type = "EKS"
+
+    info {
+      eks_info {
+        namespace = "default"
+      }
+    }
+  }
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, eksClusterName, tagKey1, tagValue1)
+}
+
+func testAccVirtualClusterConfigTags2(rName, eksClusterName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_emrcontainers_virtual_cluster" "test" {
+  name = %[1]q
+
+  container_provider {
+    id   = %[2]q
+    type = "EKS"
+
+    info {
+      eks_info {
+        namespace = "default"
+      }
+    }
+  }
+
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, rName, eksClusterName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,30 @@
+package emrcontainers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/emrcontainers"
+	"github.com/hashicorp/terraform-provider-aws/internal/waiter"
+)
+
+// waitVirtualClusterRunning has no pending states: a new virtual cluster is RUNNING as soon
+// as it can be described, so this only rides out eventual consistency after creation.
+// Any other state is an error. In particular ARRESTED, which a virtual cluster enters when
+// EMR on EKS cannot access its EKS namespace, is not transitional and is never waited out.
+func waitVirtualClusterRunning(ctx context.Context, conn *emrcontainers.EMRContainers, id string, timeout time.Duration) (*emrcontainers.VirtualCluster, error) {
+	outputRaw, err := waiter.UntilState(ctx, &waiter.Config{
+		Pending:     []string{},
+		Target:      []string{emrcontainers.VirtualClusterStateRunning},
+		Refresh:     statusVirtualCluster(ctx, conn, id),
+		Timeout:     timeout,
+		Description: fmt.Sprintf("EMR Containers Virtual Cluster (%s)", id),
+	})
+
+	if output, ok := outputRaw.(*emrcontainers.VirtualCluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
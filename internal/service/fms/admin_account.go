@@ -117,6 +117,7 @@ func resourceAdminAccountRead(d *schema.ResourceData, meta interface{}) error {
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, fms.ErrCodeResourceNotFoundException) {
 		log.Printf("[WARN] FMS Admin Account (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -131,6 +132,7 @@ func resourceAdminAccountRead(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[WARN] FMS Admin Account (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -187,6 +187,7 @@ func resourcePolicyRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, fms.ErrCodeResourceNotFoundException, "") {
 			log.Printf("[WARN] FMS Policy (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -0,0 +1,67 @@
+// Code generated by "internal/generate/finder/main.go -Op=DescribeBackups -Name=Backup -IDField=BackupIds -ListField=Backups -NotFoundCodes=ErrCodeFileSystemNotFound,ErrCodeBackupNotFound"; DO NOT EDIT.
+
+package fsx
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/aws/aws-sdk-go/service/fsx/fsxiface"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// FindBackups returns all Backups matching the DescribeBackups input.
+func FindBackups(conn fsxiface.FSxAPI, input *fsx.DescribeBackupsInput) ([]*fsx.Backup, error) {
+	var output []*fsx.Backup
+
+	err := conn.DescribeBackupsPages(input, func(page *fsx.DescribeBackupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Backups {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, fsx.ErrCodeFileSystemNotFound, fsx.ErrCodeBackupNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// FindBackupByID returns the Backup with the specified ID.
+func FindBackupByID(conn fsxiface.FSxAPI, id string) (*fsx.Backup, error) {
+	input := &fsx.DescribeBackupsInput{
+		BackupIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := FindBackups(conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
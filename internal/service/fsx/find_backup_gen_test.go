@@ -0,0 +1,136 @@
+// Code generated by "internal/generate/finder/main.go -Op=DescribeBackups -Name=Backup -IDField=BackupIds -ListField=Backups -NotFoundCodes=ErrCodeFileSystemNotFound,ErrCodeBackupNotFound"; DO NOT EDIT.
+
+package fsx_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/aws/aws-sdk-go/service/fsx/fsxiface"
+	tffsx "github.com/hashicorp/terraform-provider-aws/internal/service/fsx"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type mockBackupsConn struct {
+	fsxiface.FSxAPI
+
+	pages []*fsx.DescribeBackupsOutput
+	err   error
+}
+
+func (m *mockBackupsConn) DescribeBackupsPages(input *fsx.DescribeBackupsInput, fn func(*fsx.DescribeBackupsOutput, bool) bool) error {
+	if m.err != nil {
+		return m.err
+	}
+
+	for i, page := range m.pages {
+		if !fn(page, i == len(m.pages)-1) {
+			break
+		}
+	}
+
+	return nil
+}
+
+func TestFindBackupByID(t *testing.T) {
+	found := &fsx.Backup{}
+
+	testCases := []struct {
+		Name           string
+		Conn           *mockBackupsConn
+		ExpectFound    bool
+		ExpectNotFound bool
+		ExpectError    error
+	}{
+		{
+			Name: "found",
+			Conn: &mockBackupsConn{
+				pages: []*fsx.DescribeBackupsOutput{
+					{Backups: []*fsx.Backup{found}},
+				},
+			},
+			ExpectFound: true,
+		},
+		{
+			Name: "empty result",
+			Conn: &mockBackupsConn{
+				pages: []*fsx.DescribeBackupsOutput{
+					{},
+				},
+			},
+			ExpectNotFound: true,
+			ExpectError:    tfresource.ErrEmptyResult,
+		},
+		{
+			Name: "too many results",
+			Conn: &mockBackupsConn{
+				pages: []*fsx.DescribeBackupsOutput{
+					{Backups: []*fsx.Backup{found, {}}},
+				},
+			},
+			ExpectNotFound: true,
+			ExpectError:    tfresource.ErrTooManyResults,
+		},
+		{
+			Name: "too many results across pages",
+			Conn: &mockBackupsConn{
+				pages: []*fsx.DescribeBackupsOutput{
+					{Backups: []*fsx.Backup{found}},
+					{Backups: []*fsx.Backup{{}}},
+				},
+			},
+			ExpectNotFound: true,
+			ExpectError:    tfresource.ErrTooManyResults,
+		},
+		{
+			Name: "not found error " + fsx.ErrCodeFileSystemNotFound,
+			Conn: &mockBackupsConn{
+				err: awserr.New(fsx.ErrCodeFileSystemNotFound, "not found", nil),
+			},
+			ExpectNotFound: true,
+		},
+		{
+			Name: "not found error " + fsx.ErrCodeBackupNotFound,
+			Conn: &mockBackupsConn{
+				err: awserr.New(fsx.ErrCodeBackupNotFound, "not found", nil),
+			},
+			ExpectNotFound: true,
+		},
+		{
+			Name: "other error",
+			Conn: &mockBackupsConn{
+				err: awserr.New("InternalFailure", "internal failure", nil),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			output, err := tffsx.FindBackupByID(testCase.Conn, "test-id")
+
+			if got, expected := tfresource.NotFound(err), testCase.ExpectNotFound; got != expected {
+				t.Errorf("got not found %t, expected %t: %v", got, expected, err)
+			}
+
+			if testCase.ExpectError != nil && !errors.Is(err, testCase.ExpectError) {
+				t.Errorf("got error %v, expected %v", err, testCase.ExpectError)
+			}
+
+			if testCase.ExpectFound {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if output != found {
+					t.Errorf("got %v, expected %v", output, found)
+				}
+			} else if err == nil {
+				t.Errorf("expected error, got %v", output)
+			}
+		})
+	}
+}
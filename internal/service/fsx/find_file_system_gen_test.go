@@ -0,0 +1,129 @@
+// Code generated by "internal/generate/finder/main.go -Op=DescribeFileSystems -Name=FileSystem -IDField=FileSystemIds -ListField=FileSystems -NotFoundCodes=ErrCodeFileSystemNotFound"; DO NOT EDIT.
+
+package fsx_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/aws/aws-sdk-go/service/fsx/fsxiface"
+	tffsx "github.com/hashicorp/terraform-provider-aws/internal/service/fsx"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type mockFileSystemsConn struct {
+	fsxiface.FSxAPI
+
+	pages []*fsx.DescribeFileSystemsOutput
+	err   error
+}
+
+func (m *mockFileSystemsConn) DescribeFileSystemsPages(input *fsx.DescribeFileSystemsInput, fn func(*fsx.DescribeFileSystemsOutput, bool) bool) error {
+	if m.err != nil {
+		return m.err
+	}
+
+	for i, page := range m.pages {
+		if !fn(page, i == len(m.pages)-1) {
+			break
+		}
+	}
+
+	return nil
+}
+
+func TestFindFileSystemByID(t *testing.T) {
+	found := &fsx.FileSystem{}
+
+	testCases := []struct {
+		Name           string
+		Conn           *mockFileSystemsConn
+		ExpectFound    bool
+		ExpectNotFound bool
+		ExpectError    error
+	}{
+		{
+			Name: "found",
+			Conn: &mockFileSystemsConn{
+				pages: []*fsx.DescribeFileSystemsOutput{
+					{FileSystems: []*fsx.FileSystem{found}},
+				},
+			},
+			ExpectFound: true,
+		},
+		{
+			Name: "empty result",
+			Conn: &mockFileSystemsConn{
+				pages: []*fsx.DescribeFileSystemsOutput{
+					{},
+				},
+			},
+			ExpectNotFound: true,
+			ExpectError:    tfresource.ErrEmptyResult,
+		},
+		{
+			Name: "too many results",
+			Conn: &mockFileSystemsConn{
+				pages: []*fsx.DescribeFileSystemsOutput{
+					{FileSystems: []*fsx.FileSystem{found, {}}},
+				},
+			},
+			ExpectNotFound: true,
+			ExpectError:    tfresource.ErrTooManyResults,
+		},
+		{
+			Name: "too many results across pages",
+			Conn: &mockFileSystemsConn{
+				pages: []*fsx.DescribeFileSystemsOutput{
+					{FileSystems: []*fsx.FileSystem{found}},
+					{FileSystems: []*fsx.FileSystem{{}}},
+				},
+			},
+			ExpectNotFound: true,
+			ExpectError:    tfresource.ErrTooManyResults,
+		},
+		{
+			Name: "not found error " + fsx.ErrCodeFileSystemNotFound,
+			Conn: &mockFileSystemsConn{
+				err: awserr.New(fsx.ErrCodeFileSystemNotFound, "not found", nil),
+			},
+			ExpectNotFound: true,
+		},
+		{
+			Name: "other error",
+			Conn: &mockFileSystemsConn{
+				err: awserr.New("InternalFailure", "internal failure", nil),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			output, err := tffsx.FindFileSystemByID(testCase.Conn, "test-id")
+
+			if got, expected := tfresource.NotFound(err), testCase.ExpectNotFound; got != expected {
+				t.Errorf("got not found %t, expected %t: %v", got, expected, err)
+			}
+
+			if testCase.ExpectError != nil && !errors.Is(err, testCase.ExpectError) {
+				t.Errorf("got error %v, expected %v", err, testCase.ExpectError)
+			}
+
+			if testCase.ExpectFound {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if output != found {
+					t.Errorf("got %v, expected %v", output, found)
+				}
+			} else if err == nil {
+				t.Errorf("expected error, got %v", output)
+			}
+		})
+	}
+}
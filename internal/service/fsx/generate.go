@@ -1,4 +1,6 @@
 //go:generate go run -tags generate ../../generate/tags/main.go -ListTags=yes -ListTagsInIDElem=ResourceARN -ServiceTagsSlice=yes -TagInIDElem=ResourceARN -UpdateTags=yes
+//go:generate go run -tags generate ../../generate/finder/main.go -Op=DescribeBackups -Name=Backup -IDField=BackupIds -ListField=Backups -NotFoundCodes=ErrCodeFileSystemNotFound,ErrCodeBackupNotFound
+//go:generate go run -tags generate ../../generate/finder/main.go -Op=DescribeFileSystems -Name=FileSystem -IDField=FileSystemIds -ListField=FileSystems -NotFoundCodes=ErrCodeFileSystemNotFound
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package fsx
@@ -110,6 +110,7 @@ func resourceAliasRead(d *schema.ResourceData, meta interface{}) error {
 	})
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, gamelift.ErrCodeNotFoundException, "") {
+			//lintignore:AWSR003
 			d.SetId("")
 			log.Printf("[WARN] Gamelift Alias (%s) not found, removing from state", d.Id())
 			return nil
@@ -152,6 +152,7 @@ func resourceBuildRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, gamelift.ErrCodeNotFoundException, "") {
 			log.Printf("[WARN] Gamelift Build (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -331,6 +331,7 @@ func resourceFleetRead(d *schema.ResourceData, meta interface{}) error {
 	attributes := out.FleetAttributes
 	if len(attributes) < 1 {
 		log.Printf("[WARN] Gamelift Fleet (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -109,6 +109,7 @@ func resourceGameSessionQueueRead(d *schema.ResourceData, meta interface{}) erro
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, gamelift.ErrCodeNotFoundException, "") {
 			log.Printf("[WARN] Gamelift Session Queues (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -118,6 +119,7 @@ func resourceGameSessionQueueRead(d *schema.ResourceData, meta interface{}) erro
 
 	if len(sessionQueues) < 1 {
 		log.Printf("[WARN] Gamelift Session Queue (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -168,6 +168,7 @@ func resourceVaultRead(d *schema.ResourceData, meta interface{}) error {
 	out, err := conn.DescribeVault(input)
 	if tfawserr.ErrMessageContains(err, glacier.ErrCodeResourceNotFoundException, "") {
 		log.Printf("[WARN] Glaier Vault (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -108,6 +108,7 @@ func resourceVaultLockRead(d *schema.ResourceData, meta interface{}) error {
 
 	if tfawserr.ErrMessageContains(err, glacier.ErrCodeResourceNotFoundException, "") {
 		log.Printf("[WARN] Glacier Vault Lock (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -118,6 +119,7 @@ func resourceVaultLockRead(d *schema.ResourceData, meta interface{}) error {
 
 	if output == nil {
 		log.Printf("[WARN] Glacier Vault Lock (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -55,7 +55,7 @@ func sweepAccelerators(region string) error {
 			r := ResourceAccelerator()
 			d := r.Data(nil)
 			d.SetId(arn)
-			err = r.Delete(d, client)
+			err = sweep.DeleteResource(r, d, client)
 
 			if err != nil {
 				sweeperErr := fmt.Errorf("error deleting Global Accelerator Accelerator (%s): %s", arn, err)
@@ -96,7 +96,7 @@ func sweepGlobalAcceleratorEndpointGroups(client interface{}, listenerArn *strin
 		r := ResourceEndpointGroup()
 		d := r.Data(nil)
 		d.SetId(arn)
-		err = r.Delete(d, client)
+		err = sweep.DeleteResource(r, d, client)
 
 		if err != nil {
 			sweeperErr := fmt.Errorf("error deleting Global Accelerator endpoint group (%s): %s", arn, err)
@@ -135,7 +135,7 @@ func sweepGlobalAcceleratorListeners(client interface{}, acceleratorArn *string)
 		r := ResourceListener()
 		d := r.Data(nil)
 		d.SetId(arn)
-		err = r.Delete(d, client)
+		err = sweep.DeleteResource(r, d, client)
 
 		if err != nil {
 			sweeperErr := fmt.Errorf("error deleting Global Accelerator listener (%s): %s", arn, err)
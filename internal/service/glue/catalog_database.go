@@ -181,6 +181,7 @@ func resourceCatalogDatabaseRead(d *schema.ResourceData, meta interface{}) error
 
 		if tfawserr.ErrMessageContains(err, glue.ErrCodeEntityNotFoundException, "") {
 			log.Printf("[WARN] Glue Catalog Database (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -393,6 +393,7 @@ func resourceCatalogTableRead(d *schema.ResourceData, meta interface{}) error {
 
 		if tfawserr.ErrMessageContains(err, glue.ErrCodeEntityNotFoundException, "") {
 			log.Printf("[WARN] Glue Catalog Table (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -209,6 +209,7 @@ func resourceClassifierRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, glue.ErrCodeEntityNotFoundException, "") {
 			log.Printf("[WARN] Glue Classifier (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -218,6 +219,7 @@ func resourceClassifierRead(d *schema.ResourceData, meta interface{}) error {
 	classifier := output.Classifier
 	if classifier == nil {
 		log.Printf("[WARN] Glue Classifier (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
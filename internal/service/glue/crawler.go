@@ -662,6 +662,7 @@ func resourceCrawlerRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, glue.ErrCodeEntityNotFoundException, "") {
 			log.Printf("[WARN] Glue Crawler (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -672,6 +673,7 @@ func resourceCrawlerRead(d *schema.ResourceData, meta interface{}) error {
 	crawler := crawlerOutput.Crawler
 	if crawler == nil {
 		log.Printf("[WARN] Glue Crawler (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -250,6 +250,7 @@ func resourceJobRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, glue.ErrCodeEntityNotFoundException, "") {
 			log.Printf("[WARN] Glue Job (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -259,6 +260,7 @@ func resourceJobRead(d *schema.ResourceData, meta interface{}) error {
 	job := output.Job
 	if job == nil {
 		log.Printf("[WARN] Glue Job (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
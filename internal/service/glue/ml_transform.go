@@ -238,6 +238,7 @@ func resourceMLTransformRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, glue.ErrCodeEntityNotFoundException, "") {
 			log.Printf("[WARN] Glue ML Transform (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -246,6 +247,7 @@ func resourceMLTransformRead(d *schema.ResourceData, meta interface{}) error {
 
 	if output == nil {
 		log.Printf("[WARN] Glue ML Transform (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -85,6 +85,7 @@ func resourceRegistryRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, glue.ErrCodeEntityNotFoundException, "") {
 			log.Printf("[WARN] Glue Registry (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -93,6 +94,7 @@ func resourceRegistryRead(d *schema.ResourceData, meta interface{}) error {
 
 	if output == nil {
 		log.Printf("[WARN] Glue Registry (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -67,6 +67,7 @@ func resourceResourcePolicyRead(d *schema.ResourceData, meta interface{}) error
 	resourcePolicy, err := conn.GetResourcePolicy(&glue.GetResourcePolicyInput{})
 	if tfawserr.ErrMessageContains(err, glue.ErrCodeEntityNotFoundException, "") {
 		log.Printf("[WARN] Glue Resource (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -76,7 +77,7 @@ func resourceResourcePolicyRead(d *schema.ResourceData, meta interface{}) error
 
 	if *resourcePolicy.PolicyInJson == "" {
 		//Since the glue resource policy is global we expect it to be deleted when the policy is empty
-		d.SetId("")
+		d.SetId("") //lintignore:AWSR003
 	} else {
 		d.Set("policy", resourcePolicy.PolicyInJson)
 	}
@@ -140,6 +140,7 @@ func resourceSchemaRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, glue.ErrCodeEntityNotFoundException, "") {
 			log.Printf("[WARN] Glue Schema (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -148,6 +149,7 @@ func resourceSchemaRead(d *schema.ResourceData, meta interface{}) error {
 
 	if output == nil {
 		log.Printf("[WARN] Glue Schema (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
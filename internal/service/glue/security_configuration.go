@@ -150,6 +150,7 @@ func resourceSecurityConfigurationRead(d *schema.ResourceData, meta interface{})
 
 	if tfawserr.ErrMessageContains(err, glue.ErrCodeEntityNotFoundException, "") {
 		log.Printf("[WARN] Glue Security Configuration (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -161,6 +162,7 @@ func resourceSecurityConfigurationRead(d *schema.ResourceData, meta interface{})
 	securityConfiguration := output.SecurityConfiguration
 	if securityConfiguration == nil {
 		log.Printf("[WARN] Glue Security Configuration (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -103,7 +103,7 @@ func sweepCatalogDatabases(region string) error {
 			d.Set("name", name)
 			d.Set("catalog_id", database.CatalogId)
 
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 			if err != nil {
 				log.Printf("[ERROR] Failed to delete Glue Catalog Database %s: %s", name, err)
 			}
@@ -193,7 +193,7 @@ func sweepConnections(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 			if err != nil {
 				log.Printf("[ERROR] Failed to delete Glue Connection %s: %s", id, err)
 			}
@@ -231,7 +231,7 @@ func sweepCrawlers(region string) error {
 			d := r.Data(nil)
 			d.SetId(name)
 
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 			if err != nil {
 				log.Printf("[ERROR] Failed to delete Glue Crawler %s: %s", name, err)
 			}
@@ -346,7 +346,7 @@ func sweepMLTransforms(region string) error {
 			r := ResourceMLTransform()
 			d := r.Data(nil)
 			d.SetId(id)
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 
 			if err != nil {
 				log.Printf("[ERROR] %s", err)
@@ -390,7 +390,7 @@ func sweepRegistry(region string) error {
 		d := r.Data(nil)
 		d.SetId(arn)
 
-		err := r.Delete(d, client)
+		err := sweep.DeleteResource(r, d, client)
 		if err != nil {
 			log.Printf("[ERROR] Failed to delete Glue Registry %s: %s", arn, err)
 		}
@@ -420,7 +420,7 @@ func sweepSchema(region string) error {
 		d := r.Data(nil)
 		d.SetId(arn)
 
-		err := r.Delete(d, client)
+		err := sweep.DeleteResource(r, d, client)
 		if err != nil {
 			log.Printf("[ERROR] Failed to delete Glue Schema %s: %s", arn, err)
 		}
@@ -489,7 +489,7 @@ func sweepTriggers(region string) error {
 			r := ResourceTrigger()
 			d := r.Data(nil)
 			d.SetId(name)
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 			if err != nil {
 				log.Printf("[ERROR] Failed to delete Glue Trigger %s: %s", name, err)
 			}
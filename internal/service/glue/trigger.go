@@ -265,6 +265,7 @@ func resourceTriggerRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, glue.ErrCodeEntityNotFoundException, "") {
 			log.Printf("[WARN] Glue Trigger (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -274,6 +275,7 @@ func resourceTriggerRead(d *schema.ResourceData, meta interface{}) error {
 	trigger := output.Trigger
 	if trigger == nil {
 		log.Printf("[WARN] Glue Trigger (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -151,6 +151,7 @@ func resourceUserDefinedFunctionRead(d *schema.ResourceData, meta interface{}) e
 
 		if tfawserr.ErrMessageContains(err, glue.ErrCodeEntityNotFoundException, "") {
 			log.Printf("[WARN] Glue User Defined Function (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -105,6 +105,7 @@ func resourceWorkflowRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, glue.ErrCodeEntityNotFoundException, "") {
 			log.Printf("[WARN] Glue Workflow (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -114,6 +115,7 @@ func resourceWorkflowRead(d *schema.ResourceData, meta interface{}) error {
 	workflow := output.Workflow
 	if workflow == nil {
 		log.Printf("[WARN] Glue Workflow (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -131,6 +131,7 @@ func resourceIPSetRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, guardduty.ErrCodeBadRequestException, "The request is rejected because the input detectorId is not owned by the current account.") {
 			log.Printf("[WARN] GuardDuty IpSet %q not found, removing from state", ipSetId)
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -134,6 +134,7 @@ func resourceMemberRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, guardduty.ErrCodeBadRequestException, "The request is rejected because the input detectorId is not owned by the current account.") {
 			log.Printf("[WARN] GuardDuty detector %q not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -142,6 +143,7 @@ func resourceMemberRead(d *schema.ResourceData, meta interface{}) error {
 
 	if gmo.Members == nil || (len(gmo.Members) < 1) {
 		log.Printf("[WARN] GuardDuty Member %q not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -67,6 +67,7 @@ func resourceOrganizationAdminAccountRead(d *schema.ResourceData, meta interface
 
 	if adminAccount == nil {
 		log.Printf("[WARN] GuardDuty Organization Admin Account (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
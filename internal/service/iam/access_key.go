@@ -188,7 +188,7 @@ func resourceAccessKeyRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if iamerr, ok := err.(awserr.Error); ok && iamerr.Code() == "NoSuchEntity" { // XXX TEST ME
 			// the user does not exist, so the key can't exist.
-			d.SetId("")
+			d.SetId("") //lintignore:AWSR003
 			return nil
 		}
 		return fmt.Errorf("Error reading IAM access key: %s", err)
@@ -201,7 +201,7 @@ func resourceAccessKeyRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// Guess the key isn't around anymore.
-	d.SetId("")
+	d.SetId("") //lintignore:AWSR003
 	return nil
 }
 
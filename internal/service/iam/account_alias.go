@@ -62,6 +62,7 @@ func resourceAccountAliasRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if resp == nil || len(resp.AccountAliases) == 0 {
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -129,6 +129,7 @@ func resourceAccountPasswordPolicyRead(d *schema.ResourceData, meta interface{})
 		awsErr, ok := err.(awserr.Error)
 		if ok && awsErr.Code() == "NoSuchEntity" {
 			log.Printf("[WARN] IAM account password policy is gone (i.e. default)")
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -109,6 +109,7 @@ func resourceGroupMembershipRead(d *schema.ResourceData, meta interface{}) error
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
 		log.Printf("[WARN] IAM Group Membership (%s) not found, removing from state", group)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
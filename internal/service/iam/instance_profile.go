@@ -218,6 +218,7 @@ func resourceInstanceProfileRead(d *schema.ResourceData, meta interface{}) error
 	result, err := conn.GetInstanceProfile(request)
 	if tfawserr.ErrMessageContains(err, iam.ErrCodeNoSuchEntityException, "") {
 		log.Printf("[WARN] IAM Instance Profile %s is already gone", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
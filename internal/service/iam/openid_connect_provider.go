@@ -95,6 +95,7 @@ func resourceOpenIDConnectProviderRead(d *schema.ResourceData, meta interface{})
 	out, err := conn.GetOpenIDConnectProvider(input)
 	if tfawserr.ErrMessageContains(err, iam.ErrCodeNoSuchEntityException, "") {
 		log.Printf("[WARN] IAM OIDC Provider (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -147,6 +147,7 @@ func resourcePolicyRead(d *schema.ResourceData, meta interface{}) error {
 
 	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
 		log.Printf("[WARN] IAM Policy (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -157,6 +158,7 @@ func resourcePolicyRead(d *schema.ResourceData, meta interface{}) error {
 
 	if getPolicyResponse == nil || getPolicyResponse.Policy == nil {
 		log.Printf("[WARN] IAM Policy (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -210,6 +212,7 @@ func resourcePolicyRead(d *schema.ResourceData, meta interface{}) error {
 
 	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
 		log.Printf("[WARN] IAM Policy (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -98,6 +98,7 @@ func resourcePolicyAttachmentRead(d *schema.ResourceData, meta interface{}) erro
 		if awsErr, ok := err.(awserr.Error); ok {
 			if awsErr.Code() == "NoSuchEntity" {
 				log.Printf("[WARN] No such entity found for Policy Attachment (%s)", d.Id())
+				//lintignore:AWSR003
 				d.SetId("")
 				return nil
 			}
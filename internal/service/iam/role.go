@@ -234,7 +234,7 @@ func resourceRoleRead(d *schema.ResourceData, meta interface{}) error {
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
-	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(PropagationTimeout, func() (interface{}, error) {
+	outputRaw, err := tfresource.ReadAfterWrite(meta.(*conns.AWSClient).ReadAfterWritePolicy(conns.PropagationIAM, PropagationTimeout), func() (interface{}, error) {
 		return FindRoleByName(conn, d.Id())
 	}, d.IsNewResource())
 
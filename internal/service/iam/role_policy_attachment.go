@@ -92,6 +92,7 @@ func resourceRolePolicyAttachmentRead(d *schema.ResourceData, meta interface{})
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
 		log.Printf("[WARN] IAM Role Managed Policy Attachment (%s) not found, removing from state", id)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -102,6 +103,7 @@ func resourceRolePolicyAttachmentRead(d *schema.ResourceData, meta interface{})
 
 	if !d.IsNewResource() && !hasPolicyAttachment {
 		log.Printf("[WARN] IAM Role Managed Policy Attachment (%s) not found, removing from state", id)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
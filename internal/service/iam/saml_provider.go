@@ -89,6 +89,7 @@ func resourceSamlProviderRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, iam.ErrCodeNoSuchEntityException, "") {
 			log.Printf("[WARN] IAM SAML Provider %q not found, removing from state.", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -155,6 +155,7 @@ func resourceServerCertificateRead(d *schema.ResourceData, meta interface{}) err
 
 	if tfawserr.ErrMessageContains(err, iam.ErrCodeNoSuchEntityException, "") {
 		log.Printf("[WARN] IAM Server Certificate (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
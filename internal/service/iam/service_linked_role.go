@@ -124,6 +124,7 @@ func resourceServiceLinkedRoleRead(d *schema.ResourceData, meta interface{}) err
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, iam.ErrCodeNoSuchEntityException, "") {
 			log.Printf("[WARN] IAM service linked role %s not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -113,6 +113,7 @@ func resourceUserGroupMembershipRead(d *schema.ResourceData, meta interface{}) e
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
 		log.Printf("[WARN] IAM User Group Membership (%s) not found, removing from state", user)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -92,6 +92,7 @@ func resourceUserPolicyAttachmentRead(d *schema.ResourceData, meta interface{})
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
 		log.Printf("[WARN] IAM User Managed Policy Attachment (%s) not found, removing from state", id)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -106,6 +107,7 @@ func resourceUserPolicyAttachmentRead(d *schema.ResourceData, meta interface{})
 		}
 
 		log.Printf("[WARN] IAM User Managed Policy Attachment (%s) not found, removing from state", id)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
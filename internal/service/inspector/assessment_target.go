@@ -74,6 +74,7 @@ func resourceAssessmentTargetRead(d *schema.ResourceData, meta interface{}) erro
 
 	if assessmentTarget == nil {
 		log.Printf("[WARN] Inspector Assessment Target (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
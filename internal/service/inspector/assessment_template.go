@@ -102,6 +102,7 @@ func resourceAssessmentTemplateRead(d *schema.ResourceData, meta interface{}) er
 
 	if resp.AssessmentTemplates == nil || len(resp.AssessmentTemplates) == 0 {
 		log.Printf("[WARN] Inspector assessment template (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
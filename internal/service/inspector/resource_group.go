@@ -65,6 +65,7 @@ func resourceResourceGroupRead(d *schema.ResourceData, meta interface{}) error {
 			failureCode := aws.StringValue(failedItem.FailureCode)
 			if failureCode == inspector.FailedItemErrorCodeItemDoesNotExist {
 				log.Printf("[WARN] Inspector resource group (%s) not found, removing from state", d.Id())
+				//lintignore:AWSR003
 				d.SetId("")
 				return nil
 			}
@@ -72,6 +72,7 @@ func resourcePolicyRead(d *schema.ResourceData, meta interface{}) error {
 
 	if tfawserr.ErrMessageContains(err, iot.ErrCodeResourceNotFoundException, "") {
 		log.Printf("[WARN] IoT Policy (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -106,6 +106,7 @@ func resourcePolicyAttachmentRead(d *schema.ResourceData, meta interface{}) erro
 
 	if policy == nil {
 		log.Printf("[WARN] IOT Policy Attachment (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
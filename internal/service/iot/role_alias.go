@@ -95,6 +95,7 @@ func resourceRoleAliasRead(d *schema.ResourceData, meta interface{}) error {
 
 	if roleAliasDescription == nil {
 		log.Printf("[WARN] Role alias (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
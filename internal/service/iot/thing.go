@@ -95,6 +95,7 @@ func resourceThingRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, iot.ErrCodeResourceNotFoundException, "") {
 			log.Printf("[WARN] IoT Thing %q not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 		}
 		return err
@@ -84,6 +84,7 @@ func resourceThingPrincipalAttachmentRead(d *schema.ResourceData, meta interface
 
 	if !found {
 		log.Printf("[WARN] IoT Thing Principal Attachment (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 	}
 
@@ -131,6 +131,7 @@ func resourceThingTypeRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, iot.ErrCodeResourceNotFoundException, "") {
 			log.Printf("[WARN] IoT Thing Type %q not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 		}
 		return err
@@ -75,6 +75,7 @@ func resourceScramSecretAssociationRead(d *schema.ResourceData, meta interface{}
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, kafka.ErrCodeNotFoundException) {
 		log.Printf("[WARN] Scram secret(s) for MSK cluster (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -99,7 +99,7 @@ func sweepConfigurations(region string) error {
 			r := ResourceConfiguration()
 			d := r.Data(nil)
 			d.SetId(arn)
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 
 			if err != nil {
 				log.Printf("[ERROR] %s", err)
@@ -194,6 +194,7 @@ func resourceStreamRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
 			if awsErr.Code() == kinesis.ErrCodeResourceNotFoundException {
+				//lintignore:AWSR003
 				d.SetId("")
 				return nil
 			}
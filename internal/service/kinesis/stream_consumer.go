@@ -86,6 +86,7 @@ func resourceStreamConsumerRead(d *schema.ResourceData, meta interface{}) error
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, kinesis.ErrCodeResourceNotFoundException) {
 		log.Printf("[WARN] Kinesis Stream Consumer (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -99,6 +100,7 @@ func resourceStreamConsumerRead(d *schema.ResourceData, meta interface{}) error
 			return fmt.Errorf("error reading Kinesis Stream Consumer (%s): empty output after creation", d.Id())
 		}
 		log.Printf("[WARN] Kinesis Stream Consumer (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
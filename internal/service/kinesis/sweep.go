@@ -42,7 +42,7 @@ func sweepStreams(region string) error {
 			d.Set("name", streamName)
 			d.Set("enforce_consumer_deletion", true)
 
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 
 			if err != nil {
 				sweeperErr := fmt.Errorf("error deleting Kinesis Stream (%s): %w", aws.StringValue(streamName), err)
@@ -154,6 +154,7 @@ func resourceStreamRead(d *schema.ResourceData, meta interface{}) error {
 	resp, err := conn.DescribeStream(descOpts)
 	if tfawserr.ErrMessageContains(err, kinesisvideo.ErrCodeResourceNotFoundException, "") {
 		log.Printf("[WARN] Kinesis Video Stream (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
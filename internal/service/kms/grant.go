@@ -207,6 +207,7 @@ func resourceGrantRead(d *schema.ResourceData, meta interface{}) error {
 
 	if grant == nil {
 		log.Printf("[WARN] KMS Grant (%s) not found for Key (%s), removing from state file", grantId, keyId)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -52,7 +52,7 @@ func sweepKeys(region string) error {
 			d.SetId(kKeyId)
 			d.Set("key_id", kKeyId)
 			d.Set("deletion_window_in_days", "7")
-			err = r.Delete(d, client)
+			err = sweep.DeleteResource(r, d, client)
 			if err != nil {
 				log.Printf("Error: Failed to schedule key %q for deletion: %s", kKeyId, err)
 				return false
@@ -416,18 +416,21 @@ func resourcePermissionsRead(d *schema.ResourceData, meta interface{}) error {
 	if !d.IsNewResource() {
 		if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
 			log.Printf("[WARN] Resource Lake Formation permissions (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
 
 		if tfawserr.ErrMessageContains(err, "AccessDeniedException", "Resource does not exist") {
 			log.Printf("[WARN] Resource Lake Formation permissions (%s) not found, removing from state: %s", d.Id(), err)
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
 
 		if len(allPermissions) == 0 {
 			log.Printf("[WARN] Resource Lake Formation permissions (%s) not found, removing from state (0 permissions)", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -118,6 +118,7 @@ func resourceAliasRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
 			if awsErr.Code() == "ResourceNotFoundException" && strings.Contains(awsErr.Message(), "Cannot find alias arn") {
+				//lintignore:AWSR003
 				d.SetId("")
 				return nil
 			}
@@ -124,6 +124,7 @@ func resourceCodeSigningConfigRead(d *schema.ResourceData, meta interface{}) err
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, lambda.ErrCodeResourceNotFoundException) {
 		log.Printf("[WARN] Lambda Code Signing Config (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -657,6 +657,7 @@ func resourceFunctionRead(d *schema.ResourceData, meta interface{}) error {
 	getFunctionOutput, err := conn.GetFunction(params)
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == lambda.ErrCodeResourceNotFoundException && !d.IsNewResource() {
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -173,6 +173,7 @@ func resourceFunctionEventInvokeConfigRead(d *schema.ResourceData, meta interfac
 
 	if tfawserr.ErrMessageContains(err, lambda.ErrCodeResourceNotFoundException, "") {
 		log.Printf("[WARN] Lambda Function Event Invoke Config (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -234,6 +234,7 @@ func resourcePermissionRead(d *schema.ResourceData, meta interface{}) error {
 			if awsErr, ok := psErr.(awserr.Error); ok {
 				if awsErr.Code() == "ResourceNotFoundException" {
 					log.Printf("[WARN] No Lambda Permission Policy found: %v", input)
+					//lintignore:AWSR003
 					d.SetId("")
 					return nil
 				}
@@ -250,6 +251,7 @@ func resourcePermissionRead(d *schema.ResourceData, meta interface{}) error {
 		if awsErr, ok := err.(awserr.Error); ok {
 			if awsErr.Code() == "ResourceNotFoundException" {
 				log.Printf("[WARN] No Lambda Permission Policy found: %v", input)
+				//lintignore:AWSR003
 				d.SetId("")
 				return nil
 			}
@@ -184,6 +184,7 @@ func resourceSlotTypeRead(d *schema.ResourceData, meta interface{}) error {
 		Version: aws.String(SlotTypeVersionLatest),
 	})
 	if tfawserr.ErrCodeEquals(err, lexmodelbuildingservice.ErrCodeNotFoundException) {
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
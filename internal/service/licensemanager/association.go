@@ -78,6 +78,7 @@ func resourceAssociationRead(d *schema.ResourceData, meta interface{}) error {
 
 	if licenseSpecification == nil {
 		log.Printf("[WARN] License Manager association (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -55,6 +55,7 @@ func resourceDomainRead(d *schema.ResourceData, meta interface{}) error {
 		if awsErr, ok := err.(awserr.Error); ok {
 			if awsErr.Code() == "NotFoundException" {
 				log.Printf("[WARN] Lightsail Domain (%s) not found, removing from state", d.Id())
+				//lintignore:AWSR003
 				d.SetId("")
 				return nil
 			}
@@ -195,6 +195,7 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		if awsErr, ok := err.(awserr.Error); ok {
 			if awsErr.Code() == "NotFoundException" {
 				log.Printf("[WARN] Lightsail Instance (%s) not found, removing from state", d.Id())
+				//lintignore:AWSR003
 				d.SetId("")
 				return nil
 			}
@@ -205,6 +206,7 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 
 	if resp == nil {
 		log.Printf("[WARN] Lightsail Instance (%s) not found, nil response from server, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -110,6 +110,7 @@ func resourceInstancePublicPortsRead(d *schema.ResourceData, meta interface{}) e
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, "NotFoundException") {
 		log.Printf("[WARN] Lightsail instance public ports (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -181,6 +181,7 @@ func resourceKeyPairRead(d *schema.ResourceData, meta interface{}) error {
 		if awsErr, ok := err.(awserr.Error); ok {
 			if awsErr.Code() == "NotFoundException" {
 				log.Printf("[WARN] Lightsail KeyPair (%s) not found, removing from state", d.Id())
+				//lintignore:AWSR003
 				d.SetId("")
 				return nil
 			}
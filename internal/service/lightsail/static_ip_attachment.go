@@ -66,6 +66,7 @@ func resourceStaticIPAttachmentRead(d *schema.ResourceData, meta interface{}) er
 		if awsErr, ok := err.(awserr.Error); ok {
 			if awsErr.Code() == "NotFoundException" {
 				log.Printf("[WARN] Lightsail Static IP (%s) not found, removing from state", d.Id())
+				//lintignore:AWSR003
 				d.SetId("")
 				return nil
 			}
@@ -74,6 +75,7 @@ func resourceStaticIPAttachmentRead(d *schema.ResourceData, meta interface{}) er
 	}
 	if !*out.StaticIp.IsAttached {
 		log.Printf("[WARN] Lightsail Static IP (%s) is not attached, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
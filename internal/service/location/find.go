@@ -0,0 +1,179 @@
+package location
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindGeofenceCollectionByName(ctx context.Context, conn *locationservice.LocationService, name string) (*locationservice.DescribeGeofenceCollectionOutput, error) {
+	input := &locationservice.DescribeGeofenceCollectionInput{
+		CollectionName: aws.String(name),
+	}
+
+	output, err := conn.DescribeGeofenceCollectionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindMapByName(ctx context.Context, conn *locationservice.LocationService, name string) (*locationservice.DescribeMapOutput, error) {
+	input := &locationservice.DescribeMapInput{
+		MapName: aws.String(name),
+	}
+
+	output, err := conn.DescribeMapWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindPlaceIndexByName(ctx context.Context, conn *locationservice.LocationService, name string) (*locationservice.DescribePlaceIndexOutput, error) {
+	input := &locationservice.DescribePlaceIndexInput{
+		IndexName: aws.String(name),
+	}
+
+	output, err := conn.DescribePlaceIndexWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindRouteCalculatorByName(ctx context.Context, conn *locationservice.LocationService, name string) (*locationservice.DescribeRouteCalculatorOutput, error) {
+	input := &locationservice.DescribeRouteCalculatorInput{
+		CalculatorName: aws.String(name),
+	}
+
+	output, err := conn.DescribeRouteCalculatorWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindTrackerByName(ctx context.Context, conn *locationservice.LocationService, name string) (*locationservice.DescribeTrackerOutput, error) {
+	input := &locationservice.DescribeTrackerInput{
+		TrackerName: aws.String(name),
+	}
+
+	output, err := conn.DescribeTrackerWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// FindTrackerAssociationByTrackerNameAndConsumerARN returns an error for which tfresource.NotFound returns true
+// if the consumer is not associated with the tracker.
+func FindTrackerAssociationByTrackerNameAndConsumerARN(ctx context.Context, conn *locationservice.LocationService, trackerName, consumerARN string) error {
+	input := &locationservice.ListTrackerConsumersInput{
+		TrackerName: aws.String(trackerName),
+	}
+	var found bool
+
+	err := conn.ListTrackerConsumersPagesWithContext(ctx, input, func(page *locationservice.ListTrackerConsumersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ConsumerArns {
+			if aws.StringValue(v) == consumerARN {
+				found = true
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,4 @@
+//go:generate go run -tags generate ../../generate/tags/main.go -CreateTags=yes -ServiceTagsMap=yes -UpdateTags=yes
+// ONLY generate directives and package declaration! Do not add anything else to this file.
+
+package location
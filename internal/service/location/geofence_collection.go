@@ -0,0 +1,212 @@
+package location
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceGeofenceCollection() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGeofenceCollectionCreate,
+		ReadContext:   resourceGeofenceCollectionRead,
+		UpdateContext: resourceGeofenceCollectionUpdate,
+		DeleteContext: resourceGeofenceCollectionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"collection_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collection_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validResourceName,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"pricing_plan": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      locationservice.PricingPlanRequestBasedUsage,
+				ValidateFunc: validation.StringInSlice(locationservice.PricingPlan_Values(), false),
+			},
+			"pricing_plan_data_source": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGeofenceCollectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LocationConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("collection_name").(string)
+	input := &locationservice.CreateGeofenceCollectionInput{
+		CollectionName: aws.String(name),
+		PricingPlan:    aws.String(d.Get("pricing_plan").(string)),
+		Tags:           TagsOnCreate(tags),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("pricing_plan_data_source"); ok {
+		input.PricingPlanDataSource = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Location Service Geofence Collection: %s", input)
+	output, err := conn.CreateGeofenceCollectionWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Location Service Geofence Collection (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.CollectionName))
+
+	return resourceGeofenceCollectionRead(ctx, d, meta)
+}
+
+func resourceGeofenceCollectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LocationConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindGeofenceCollectionByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Location Service Geofence Collection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Location Service Geofence Collection (%s): %s", d.Id(), err)
+	}
+
+	d.Set("collection_arn", output.CollectionArn)
+	d.Set("collection_name", output.CollectionName)
+	d.Set("create_time", aws.TimeValue(output.CreateTime).Format(time.RFC3339))
+	d.Set("description", output.Description)
+	d.Set("kms_key_id", output.KmsKeyId)
+	d.Set("pricing_plan", output.PricingPlan)
+	d.Set("pricing_plan_data_source", output.PricingPlanDataSource)
+	d.Set("update_time", aws.TimeValue(output.UpdateTime).Format(time.RFC3339))
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceGeofenceCollectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LocationConn
+
+	if d.HasChanges("description", "pricing_plan", "pricing_plan_data_source") {
+		input := &locationservice.UpdateGeofenceCollectionInput{
+			CollectionName: aws.String(d.Id()),
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("pricing_plan") {
+			input.PricingPlan = aws.String(d.Get("pricing_plan").(string))
+		}
+
+		if d.HasChange("pricing_plan_data_source") {
+			input.PricingPlanDataSource = aws.String(d.Get("pricing_plan_data_source").(string))
+		}
+
+		log.Printf("[DEBUG] Updating Location Service Geofence Collection: %s", input)
+		_, err := conn.UpdateGeofenceCollectionWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Location Service Geofence Collection (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("collection_arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Location Service Geofence Collection (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceGeofenceCollectionRead(ctx, d, meta)
+}
+
+func resourceGeofenceCollectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LocationConn
+
+	log.Printf("[DEBUG] Deleting Location Service Geofence Collection: (%s)", d.Id())
+	_, err := conn.DeleteGeofenceCollectionWithContext(ctx, &locationservice.DeleteGeofenceCollectionInput{
+		CollectionName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Location Service Geofence Collection (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
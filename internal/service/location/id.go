@@ -0,0 +1,26 @@
+package location
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Consumer ARNs contain colons and slashes, so use a pipe to separate the ID parts.
+const trackerAssociationResourceIDSeparator = "|"
+
+func TrackerAssociationCreateResourceID(trackerName, consumerARN string) string {
+	parts := []string{trackerName, consumerARN}
+	id := strings.Join(parts, trackerAssociationResourceIDSeparator)
+
+	return id
+}
+
+func TrackerAssociationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, trackerAssociationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected tracker-name%[2]sconsumer-arn", id, trackerAssociationResourceIDSeparator)
+}
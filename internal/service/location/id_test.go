@@ -0,0 +1,66 @@
+package location_test
+
+import (
+	"testing"
+
+	tflocation "github.com/hashicorp/terraform-provider-aws/internal/service/location"
+)
+
+func TestTrackerAssociationParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName            string
+		InputID             string
+		ExpectError         bool
+		ExpectedTrackerName string
+		ExpectedConsumerARN string
+	}{
+		{
+			TestName:    "empty ID",
+			InputID:     "",
+			ExpectError: true,
+		},
+		{
+			TestName:    "incorrect format",
+			InputID:     "test",
+			ExpectError: true,
+		},
+		{
+			TestName:    "missing consumer ARN",
+			InputID:     "test|",
+			ExpectError: true,
+		},
+		{
+			TestName:    "missing tracker name",
+			InputID:     "|arn:aws:geo:us-west-2:123456789012:geofence-collection/test",
+			ExpectError: true,
+		},
+		{
+			TestName:            "valid ID",
+			InputID:             tflocation.TrackerAssociationCreateResourceID("test", "arn:aws:geo:us-west-2:123456789012:geofence-collection/test"),
+			ExpectedTrackerName: "test",
+			ExpectedConsumerARN: "arn:aws:geo:us-west-2:123456789012:geofence-collection/test",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotTrackerName, gotConsumerARN, err := tflocation.TrackerAssociationParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error")
+			}
+
+			if gotTrackerName != testCase.ExpectedTrackerName {
+				t.Errorf("got tracker name %s, expected %s", gotTrackerName, testCase.ExpectedTrackerName)
+			}
+
+			if gotConsumerARN != testCase.ExpectedConsumerARN {
+				t.Errorf("got consumer ARN %s, expected %s", gotConsumerARN, testCase.ExpectedConsumerARN)
+			}
+		})
+	}
+}
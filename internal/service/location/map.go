@@ -0,0 +1,242 @@
+package location
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceMap() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMapCreate,
+		ReadContext:   resourceMapRead,
+		UpdateContext: resourceMapUpdate,
+		DeleteContext: resourceMapDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"style": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 100),
+						},
+					},
+				},
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_source": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"map_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"map_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validResourceName,
+			},
+			"pricing_plan": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      locationservice.PricingPlanRequestBasedUsage,
+				ValidateFunc: validation.StringInSlice(locationservice.PricingPlan_Values(), false),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMapCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LocationConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("map_name").(string)
+	input := &locationservice.CreateMapInput{
+		MapName:     aws.String(name),
+		PricingPlan: aws.String(d.Get("pricing_plan").(string)),
+		Tags:        TagsOnCreate(tags),
+	}
+
+	if v, ok := d.GetOk("configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Configuration = expandMapConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Location Service Map: %s", input)
+	output, err := conn.CreateMapWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Location Service Map (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.MapName))
+
+	return resourceMapRead(ctx, d, meta)
+}
+
+func resourceMapRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LocationConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindMapByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Location Service Map (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Location Service Map (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("configuration", flattenMapConfiguration(output.Configuration)); err != nil {
+		return diag.Errorf("error setting configuration: %s", err)
+	}
+
+	d.Set("create_time", aws.TimeValue(output.CreateTime).Format(time.RFC3339))
+	d.Set("data_source", output.DataSource)
+	d.Set("description", output.Description)
+	d.Set("map_arn", output.MapArn)
+	d.Set("map_name", output.MapName)
+	d.Set("pricing_plan", output.PricingPlan)
+	d.Set("update_time", aws.TimeValue(output.UpdateTime).Format(time.RFC3339))
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceMapUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LocationConn
+
+	if d.HasChanges("description", "pricing_plan") {
+		input := &locationservice.UpdateMapInput{
+			MapName: aws.String(d.Id()),
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("pricing_plan") {
+			input.PricingPlan = aws.String(d.Get("pricing_plan").(string))
+		}
+
+		log.Printf("[DEBUG] Updating Location Service Map: %s", input)
+		_, err := conn.UpdateMapWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Location Service Map (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("map_arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Location Service Map (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceMapRead(ctx, d, meta)
+}
+
+func resourceMapDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LocationConn
+
+	log.Printf("[DEBUG] Deleting Location Service Map: (%s)", d.Id())
+	_, err := conn.DeleteMapWithContext(ctx, &locationservice.DeleteMapInput{
+		MapName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Location Service Map (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandMapConfiguration(tfMap map[string]interface{}) *locationservice.MapConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &locationservice.MapConfiguration{}
+
+	if v, ok := tfMap["style"].(string); ok && v != "" {
+		apiObject.Style = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenMapConfiguration(apiObject *locationservice.MapConfiguration) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"style": aws.StringValue(apiObject.Style),
+	}
+
+	return []interface{}{tfMap}
+}
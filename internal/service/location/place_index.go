@@ -0,0 +1,251 @@
+package location
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourcePlaceIndex() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePlaceIndexCreate,
+		ReadContext:   resourcePlaceIndexRead,
+		UpdateContext: resourcePlaceIndexUpdate,
+		DeleteContext: resourcePlaceIndexDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_source": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"data_source_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"intended_use": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      locationservice.IntendedUseSingleUse,
+							ValidateFunc: validation.StringInSlice(locationservice.IntendedUse_Values(), false),
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"index_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"index_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validResourceName,
+			},
+			"pricing_plan": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      locationservice.PricingPlanRequestBasedUsage,
+				ValidateFunc: validation.StringInSlice(locationservice.PricingPlan_Values(), false),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePlaceIndexCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LocationConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("index_name").(string)
+	input := &locationservice.CreatePlaceIndexInput{
+		DataSource:  aws.String(d.Get("data_source").(string)),
+		IndexName:   aws.String(name),
+		PricingPlan: aws.String(d.Get("pricing_plan").(string)),
+		Tags:        TagsOnCreate(tags),
+	}
+
+	if v, ok := d.GetOk("data_source_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DataSourceConfiguration = expandDataSourceConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Location Service Place Index: %s", input)
+	output, err := conn.CreatePlaceIndexWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Location Service Place Index (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.IndexName))
+
+	return resourcePlaceIndexRead(ctx, d, meta)
+}
+
+func resourcePlaceIndexRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LocationConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindPlaceIndexByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Location Service Place Index (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Location Service Place Index (%s): %s", d.Id(), err)
+	}
+
+	d.Set("create_time", aws.TimeValue(output.CreateTime).Format(time.RFC3339))
+	d.Set("data_source", output.DataSource)
+
+	if err := d.Set("data_source_configuration", flattenDataSourceConfiguration(output.DataSourceConfiguration)); err != nil {
+		return diag.Errorf("error setting data_source_configuration: %s", err)
+	}
+
+	d.Set("description", output.Description)
+	d.Set("index_arn", output.IndexArn)
+	d.Set("index_name", output.IndexName)
+	d.Set("pricing_plan", output.PricingPlan)
+	d.Set("update_time", aws.TimeValue(output.UpdateTime).Format(time.RFC3339))
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourcePlaceIndexUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LocationConn
+
+	if d.HasChanges("data_source_configuration", "description", "pricing_plan") {
+		input := &locationservice.UpdatePlaceIndexInput{
+			IndexName: aws.String(d.Id()),
+		}
+
+		if d.HasChange("data_source_configuration") {
+			if v, ok := d.GetOk("data_source_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.DataSourceConfiguration = expandDataSourceConfiguration(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("pricing_plan") {
+			input.PricingPlan = aws.String(d.Get("pricing_plan").(string))
+		}
+
+		log.Printf("[DEBUG] Updating Location Service Place Index: %s", input)
+		_, err := conn.UpdatePlaceIndexWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Location Service Place Index (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("index_arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Location Service Place Index (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourcePlaceIndexRead(ctx, d, meta)
+}
+
+func resourcePlaceIndexDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LocationConn
+
+	log.Printf("[DEBUG] Deleting Location Service Place Index: (%s)", d.Id())
+	_, err := conn.DeletePlaceIndexWithContext(ctx, &locationservice.DeletePlaceIndexInput{
+		IndexName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Location Service Place Index (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandDataSourceConfiguration(tfMap map[string]interface{}) *locationservice.DataSourceConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &locationservice.DataSourceConfiguration{}
+
+	if v, ok := tfMap["intended_use"].(string); ok && v != "" {
+		apiObject.IntendedUse = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenDataSourceConfiguration(apiObject *locationservice.DataSourceConfiguration) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"intended_use": aws.StringValue(apiObject.IntendedUse),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,194 @@
+package location
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceRouteCalculator() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRouteCalculatorCreate,
+		ReadContext:   resourceRouteCalculatorRead,
+		UpdateContext: resourceRouteCalculatorUpdate,
+		DeleteContext: resourceRouteCalculatorDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"calculator_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"calculator_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validResourceName,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_source": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"pricing_plan": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      locationservice.PricingPlanRequestBasedUsage,
+				ValidateFunc: validation.StringInSlice(locationservice.PricingPlan_Values(), false),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceRouteCalculatorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LocationConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("calculator_name").(string)
+	input := &locationservice.CreateRouteCalculatorInput{
+		CalculatorName: aws.String(name),
+		DataSource:     aws.String(d.Get("data_source").(string)),
+		PricingPlan:    aws.String(d.Get("pricing_plan").(string)),
+		Tags:           TagsOnCreate(tags),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Location Service Route Calculator: %s", input)
+	output, err := conn.CreateRouteCalculatorWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Location Service Route Calculator (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.CalculatorName))
+
+	return resourceRouteCalculatorRead(ctx, d, meta)
+}
+
+func resourceRouteCalculatorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LocationConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindRouteCalculatorByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Location Service Route Calculator (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Location Service Route Calculator (%s): %s", d.Id(), err)
+	}
+
+	d.Set("calculator_arn", output.CalculatorArn)
+	d.Set("calculator_name", output.CalculatorName)
+	d.Set("create_time", aws.TimeValue(output.CreateTime).Format(time.RFC3339))
+	d.Set("data_source", output.DataSource)
+	d.Set("description", output.Description)
+	d.Set("pricing_plan", output.PricingPlan)
+	d.Set("update_time", aws.TimeValue(output.UpdateTime).Format(time.RFC3339))
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceRouteCalculatorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LocationConn
+
+	if d.HasChanges("description", "pricing_plan") {
+		input := &locationservice.UpdateRouteCalculatorInput{
+			CalculatorName: aws.String(d.Id()),
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("pricing_plan") {
+			input.PricingPlan = aws.String(d.Get("pricing_plan").(string))
+		}
+
+		log.Printf("[DEBUG] Updating Location Service Route Calculator: %s", input)
+		_, err := conn.UpdateRouteCalculatorWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Location Service Route Calculator (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("calculator_arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Location Service Route Calculator (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceRouteCalculatorRead(ctx, d, meta)
+}
+
+func resourceRouteCalculatorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LocationConn
+
+	log.Printf("[DEBUG] Deleting Location Service Route Calculator: (%s)", d.Id())
+	_, err := conn.DeleteRouteCalculatorWithContext(ctx, &locationservice.DeleteRouteCalculatorInput{
+		CalculatorName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Location Service Route Calculator (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
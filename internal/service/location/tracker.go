@@ -0,0 +1,224 @@
+package location
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceTracker() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTrackerCreate,
+		ReadContext:   resourceTrackerRead,
+		UpdateContext: resourceTrackerUpdate,
+		DeleteContext: resourceTrackerDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"position_filtering": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      locationservice.PositionFilteringTimeBased,
+				ValidateFunc: validation.StringInSlice(locationservice.PositionFiltering_Values(), false),
+			},
+			"pricing_plan": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      locationservice.PricingPlanRequestBasedUsage,
+				ValidateFunc: validation.StringInSlice(locationservice.PricingPlan_Values(), false),
+			},
+			"pricing_plan_data_source": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"tracker_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tracker_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validResourceName,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTrackerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LocationConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("tracker_name").(string)
+	input := &locationservice.CreateTrackerInput{
+		PositionFiltering: aws.String(d.Get("position_filtering").(string)),
+		PricingPlan:       aws.String(d.Get("pricing_plan").(string)),
+		Tags:              TagsOnCreate(tags),
+		TrackerName:       aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("pricing_plan_data_source"); ok {
+		input.PricingPlanDataSource = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Location Service Tracker: %s", input)
+	output, err := conn.CreateTrackerWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Location Service Tracker (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.TrackerName))
+
+	return resourceTrackerRead(ctx, d, meta)
+}
+
+func resourceTrackerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LocationConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindTrackerByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Location Service Tracker (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Location Service Tracker (%s): %s", d.Id(), err)
+	}
+
+	d.Set("create_time", aws.TimeValue(output.CreateTime).Format(time.RFC3339))
+	d.Set("description", output.Description)
+	d.Set("kms_key_id", output.KmsKeyId)
+	d.Set("position_filtering", output.PositionFiltering)
+	d.Set("pricing_plan", output.PricingPlan)
+	d.Set("pricing_plan_data_source", output.PricingPlanDataSource)
+	d.Set("tracker_arn", output.TrackerArn)
+	d.Set("tracker_name", output.TrackerName)
+	d.Set("update_time", aws.TimeValue(output.UpdateTime).Format(time.RFC3339))
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceTrackerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LocationConn
+
+	if d.HasChanges("description", "position_filtering", "pricing_plan", "pricing_plan_data_source") {
+		input := &locationservice.UpdateTrackerInput{
+			TrackerName: aws.String(d.Id()),
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("position_filtering") {
+			input.PositionFiltering = aws.String(d.Get("position_filtering").(string))
+		}
+
+		if d.HasChange("pricing_plan") {
+			input.PricingPlan = aws.String(d.Get("pricing_plan").(string))
+		}
+
+		if d.HasChange("pricing_plan_data_source") {
+			input.PricingPlanDataSource = aws.String(d.Get("pricing_plan_data_source").(string))
+		}
+
+		log.Printf("[DEBUG] Updating Location Service Tracker: %s", input)
+		_, err := conn.UpdateTrackerWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Location Service Tracker (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("tracker_arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Location Service Tracker (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceTrackerRead(ctx, d, meta)
+}
+
+func resourceTrackerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LocationConn
+
+	log.Printf("[DEBUG] Deleting Location Service Tracker: (%s)", d.Id())
+	_, err := conn.DeleteTrackerWithContext(ctx, &locationservice.DeleteTrackerInput{
+		TrackerName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Location Service Tracker (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
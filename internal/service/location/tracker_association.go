@@ -0,0 +1,118 @@
+package location
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceTrackerAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTrackerAssociationCreate,
+		ReadContext:   resourceTrackerAssociationRead,
+		DeleteContext: resourceTrackerAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"consumer_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"tracker_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validResourceName,
+			},
+		},
+	}
+}
+
+func resourceTrackerAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LocationConn
+
+	trackerName := d.Get("tracker_name").(string)
+	consumerARN := d.Get("consumer_arn").(string)
+	id := TrackerAssociationCreateResourceID(trackerName, consumerARN)
+	input := &locationservice.AssociateTrackerConsumerInput{
+		ConsumerArn: aws.String(consumerARN),
+		TrackerName: aws.String(trackerName),
+	}
+
+	log.Printf("[DEBUG] Creating Location Service Tracker Association: %s", input)
+	_, err := conn.AssociateTrackerConsumerWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Location Service Tracker Association (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceTrackerAssociationRead(ctx, d, meta)
+}
+
+func resourceTrackerAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LocationConn
+
+	trackerName, consumerARN, err := TrackerAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = FindTrackerAssociationByTrackerNameAndConsumerARN(ctx, conn, trackerName, consumerARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Location Service Tracker Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Location Service Tracker Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("consumer_arn", consumerARN)
+	d.Set("tracker_name", trackerName)
+
+	return nil
+}
+
+func resourceTrackerAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LocationConn
+
+	trackerName, consumerARN, err := TrackerAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Location Service Tracker Association: (%s)", d.Id())
+	_, err = conn.DisassociateTrackerConsumerWithContext(ctx, &locationservice.DisassociateTrackerConsumerInput{
+		ConsumerArn: aws.String(consumerARN),
+		TrackerName: aws.String(trackerName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Location Service Tracker Association (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
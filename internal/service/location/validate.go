@@ -0,0 +1,14 @@
+package location
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// validResourceName validates Location Service resource names.
+// Names may contain only alphanumeric characters, hyphens, periods and underscores.
+var validResourceName = validation.All(
+	validation.StringLenBetween(1, 100),
+	validation.StringMatch(regexp.MustCompile(`^[-._\w]+$`), "Only alphanumeric characters, hyphens, periods and underscores are allowed."),
+)
@@ -0,0 +1,37 @@
+package location
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidResourceName(t *testing.T) {
+	validNames := []string{
+		"a",
+		"ExampleMap",
+		"example-map_1.0",
+		strings.Repeat("a", 100),
+	}
+
+	for _, v := range validNames {
+		_, errors := validResourceName(v, "name")
+		if len(errors) != 0 {
+			t.Errorf("%q should be a valid Location Service resource name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"example map",
+		"example/map",
+		"example:map",
+		strings.Repeat("a", 101),
+	}
+
+	for _, v := range invalidNames {
+		_, errors := validResourceName(v, "name")
+		if len(errors) == 0 {
+			t.Errorf("%q should be an invalid Location Service resource name", v)
+		}
+	}
+}
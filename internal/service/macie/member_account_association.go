@@ -69,6 +69,7 @@ func resourceMemberAccountAssociationRead(d *schema.ResourceData, meta interface
 
 	if res == nil {
 		log.Printf("[WARN] Macie member account association (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -122,6 +122,7 @@ func resourceS3BucketAssociationRead(d *schema.ResourceData, meta interface{}) e
 
 	if res == nil {
 		log.Printf("[WARN] Macie S3 bucket association (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
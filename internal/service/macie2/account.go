@@ -106,6 +106,7 @@ func resourceMacie2AccountRead(ctx context.Context, d *schema.ResourceData, meta
 	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
 		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
 		log.Printf("[WARN] Macie not enabled for AWS account (%s), removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -116,6 +116,7 @@ func resourceMacie2InvitationAccepterRead(ctx context.Context, d *schema.Resourc
 	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
 		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
 		log.Printf("[WARN] Macie InvitationAccepter (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -81,6 +81,7 @@ func resourceMacie2OrganizationAdminAccountRead(ctx context.Context, d *schema.R
 		if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
 			tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
 			log.Printf("[WARN] Macie OrganizationAdminAccount (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -89,6 +90,7 @@ func resourceMacie2OrganizationAdminAccountRead(ctx context.Context, d *schema.R
 
 	if res == nil {
 		log.Printf("[WARN] Macie OrganizationAdminAccount (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -146,6 +146,7 @@ func resourceQueueRead(d *schema.ResourceData, meta interface{}) error {
 	resp, err := conn.GetQueue(getOpts)
 	if tfawserr.ErrMessageContains(err, mediaconvert.ErrCodeNotFoundException, "") {
 		log.Printf("[WARN] Media Convert Queue (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
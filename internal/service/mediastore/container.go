@@ -98,6 +98,7 @@ func resourceContainerRead(d *schema.ResourceData, meta interface{}) error {
 	resp, err := conn.DescribeContainer(input)
 	if tfawserr.ErrMessageContains(err, mediastore.ErrCodeContainerNotFoundException, "") {
 		log.Printf("[WARN] No Container found: %s, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
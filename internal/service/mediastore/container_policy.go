@@ -65,11 +65,13 @@ func resourceContainerPolicyRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, mediastore.ErrCodeContainerNotFoundException, "") {
 			log.Printf("[WARN] MediaContainer Policy %q not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
 		if tfawserr.ErrMessageContains(err, mediastore.ErrCodePolicyNotFoundException, "") {
 			log.Printf("[WARN] MediaContainer Policy %q not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
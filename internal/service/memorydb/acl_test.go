@@ -0,0 +1,275 @@
+package memorydb_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmemorydb "github.com/hashicorp/terraform-provider-aws/internal/service/memorydb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccMemoryDBACL_basic(t *testing.T) {
+	rName := "tf-test-" + sdkacctest.RandString(8)
+	user1 := "tf-test-" + sdkacctest.RandString(8)
+	resourceName := "aws_memorydb_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, memorydb.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckACLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccACLConfig(rName, []string{user1}, []string{user1}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckACLExists(resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "memorydb", "acl/"+rName),
+					resource.TestCheckResourceAttrSet(resourceName, "minimum_engine_version"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "user_names.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "user_names.*", "aws_memorydb_user.test.0", "user_name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMemoryDBACL_disappears(t *testing.T) {
+	rName := "tf-test-" + sdkacctest.RandString(8)
+	resourceName := "aws_memorydb_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, memorydb.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckACLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccACLConfig(rName, nil, nil),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckACLExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfmemorydb.ResourceACL(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMemoryDBACL_update_userNames(t *testing.T) {
+	rName := "tf-test-" + sdkacctest.RandString(8)
+	user1 := "tf-test-" + sdkacctest.RandString(8)
+	user2 := "tf-test-" + sdkacctest.RandString(8)
+	user3 := "tf-test-" + sdkacctest.RandString(8)
+	resourceName := "aws_memorydb_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, memorydb.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckACLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccACLConfig(rName, []string{user1, user2, user3}, []string{user1, user2}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckACLExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "user_names.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "user_names.*", user1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "user_names.*", user2),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Add and remove users at the same time.
+				Config: testAccACLConfig(rName, []string{user1, user2, user3}, []string{user2, user3}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckACLExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "user_names.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "user_names.*", user2),
+					resource.TestCheckTypeSetElemAttr(resourceName, "user_names.*", user3),
+				),
+			},
+			{
+				// Remove all users.
+				Config: testAccACLConfig(rName, []string{user1, user2, user3}, nil),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckACLExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "user_names.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMemoryDBACL_update_tags(t *testing.T) {
+	rName := "tf-test-" + sdkacctest.RandString(8)
+	resourceName := "aws_memorydb_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, memorydb.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckACLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccACLConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckACLExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccACLConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckACLExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccACLConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckACLExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckACLDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).MemoryDBConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_memorydb_acl" {
+			continue
+		}
+
+		_, err := tfmemorydb.FindACLByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("MemoryDB ACL %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckACLExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MemoryDB ACL ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MemoryDBConn
+
+		_, err := tfmemorydb.FindACLByName(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccACLConfigUsers(userNames ...string) string {
+	var userNamesHCL []string
+	for _, userName := range userNames {
+		userNamesHCL = append(userNamesHCL, fmt.Sprintf("%q", userName))
+	}
+
+	return fmt.Sprintf(`
+locals {
+  user_names = [%[1]s]
+}
+
+resource "aws_memorydb_user" "test" {
+  count         = length(local.user_names)
+  access_string = "on ~* &* +@all"
+  user_name     = local.user_names[count.index]
+
+  authentication_mode {
+    type      = "password"
+    passwords = ["aaaaaaaaaaaaaaaa"]
+  }
+}
+`, strings.Join(userNamesHCL, ", "))
+}
+
+func testAccACLConfig(rName string, userNames []string, aclUserNames []string) string {
+	var aclUserNamesHCL []string
+	for _, userName := range aclUserNames {
+		aclUserNamesHCL = append(aclUserNamesHCL, fmt.Sprintf("%q", userName))
+	}
+
+	return acctest.ConfigCompose(
+		testAccACLConfigUsers(userNames...),
+		fmt.Sprintf(`
+resource "aws_memorydb_acl" "test" {
+  depends_on = [aws_memorydb_user.test]
+
+  name       = %[1]q
+  user_names = [%[2]s]
+}
+`, rName, strings.Join(aclUserNamesHCL, ", ")),
+	)
+}
+
+func testAccACLConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_memorydb_acl" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccACLConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_memorydb_acl" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,653 @@
+package memorydb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceCluster() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceClusterCreate,
+		ReadContext:   resourceClusterRead,
+		UpdateContext: resourceClusterUpdate,
+		DeleteContext: resourceClusterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(clusterDefaultCreateTimeout),
+			Update: schema.DefaultTimeout(clusterDefaultUpdateTimeout),
+			Delete: schema.DefaultTimeout(clusterDefaultDeleteTimeout),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"acl_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_minor_version_upgrade": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"cluster_endpoint": endpointSchema(),
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Managed by Terraform",
+			},
+			"engine_patch_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"final_snapshot_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validResourceName(snapshotNameMaxLength),
+			},
+			"kms_key_arn": {
+				// The API will accept an ID, but return the ARN on every read.
+				// For the sake of consistency, force everyone to use ARN-s.
+				// To prevent confusion, the attribute is suffixed _arn rather
+				// than the _id implied by the API.
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"maintenance_window": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				StateFunc: func(val interface{}) string {
+					// MemoryDB always changes the maintenance to lowercase.
+					return strings.ToLower(val.(string))
+				},
+				ValidateFunc: verify.ValidOnceAWeekWindowFormat,
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name_prefix"},
+				ValidateFunc:  validResourceName(clusterNameMaxLength),
+			},
+			"name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name"},
+				ValidateFunc:  validResourceNamePrefix(clusterNameMaxLength - resource.UniqueIDSuffixLength),
+			},
+			"node_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"num_replicas_per_shard": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(0, 5),
+			},
+			"num_shards": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"parameter_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"shards": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"nodes": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"availability_zone": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"create_time": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"endpoint": endpointSchema(),
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"num_nodes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"slots": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"snapshot_arns": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"snapshot_name"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"snapshot_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"snapshot_arns"},
+			},
+			"snapshot_retention_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 35),
+			},
+			"snapshot_window": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidOnceADayWindowFormat,
+			},
+			"sns_topic_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"subnet_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"tls_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func endpointSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"address": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"port": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MemoryDBConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
+	input := &memorydb.CreateClusterInput{
+		ACLName:                 aws.String(d.Get("acl_name").(string)),
+		AutoMinorVersionUpgrade: aws.Bool(d.Get("auto_minor_version_upgrade").(bool)),
+		ClusterName:             aws.String(name),
+		NodeType:                aws.String(d.Get("node_type").(string)),
+		NumReplicasPerShard:     aws.Int64(int64(d.Get("num_replicas_per_shard").(int))),
+		NumShards:               aws.Int64(int64(d.Get("num_shards").(int))),
+		Tags:                    TagsOnCreate(tags),
+		TLSEnabled:              aws.Bool(d.Get("tls_enabled").(bool)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("engine_version"); ok {
+		input.EngineVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("maintenance_window"); ok {
+		input.MaintenanceWindow = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("parameter_group_name"); ok {
+		input.ParameterGroupName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("port"); ok {
+		input.Port = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("security_group_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.SecurityGroupIds = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("snapshot_arns"); ok && v.(*schema.Set).Len() > 0 {
+		input.SnapshotArns = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("snapshot_name"); ok {
+		input.SnapshotName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("snapshot_retention_limit"); ok {
+		input.SnapshotRetentionLimit = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("snapshot_window"); ok {
+		input.SnapshotWindow = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sns_topic_arn"); ok {
+		input.SnsTopicArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("subnet_group_name"); ok {
+		input.SubnetGroupName = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating MemoryDB Cluster: %s", input)
+	_, err := conn.CreateClusterWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating MemoryDB Cluster (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitClusterAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for MemoryDB Cluster (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceClusterRead(ctx, d, meta)
+}
+
+func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MemoryDBConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	cluster, err := FindClusterByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MemoryDB Cluster (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading MemoryDB Cluster (%s): %s", d.Id(), err)
+	}
+
+	d.Set("acl_name", cluster.ACLName)
+	d.Set("arn", cluster.ARN)
+	d.Set("auto_minor_version_upgrade", cluster.AutoMinorVersionUpgrade)
+	d.Set("description", cluster.Description)
+	d.Set("engine_patch_version", cluster.EnginePatchVersion)
+	d.Set("engine_version", cluster.EngineVersion)
+	d.Set("kms_key_arn", cluster.KmsKeyId) // KmsKeyId is actually an ARN here.
+	d.Set("maintenance_window", cluster.MaintenanceWindow)
+	d.Set("name", cluster.Name)
+	d.Set("name_prefix", create.NamePrefixFromName(aws.StringValue(cluster.Name)))
+	d.Set("node_type", cluster.NodeType)
+	d.Set("num_shards", cluster.NumberOfShards)
+	d.Set("parameter_group_name", cluster.ParameterGroupName)
+	d.Set("snapshot_retention_limit", cluster.SnapshotRetentionLimit)
+	d.Set("snapshot_window", cluster.SnapshotWindow)
+	d.Set("subnet_group_name", cluster.SubnetGroupName)
+	d.Set("tls_enabled", cluster.TLSEnabled)
+
+	if err := d.Set("cluster_endpoint", flattenEndpoint(cluster.ClusterEndpoint)); err != nil {
+		return diag.Errorf("error setting cluster_endpoint: %s", err)
+	}
+
+	numReplicasPerShard, err := deriveClusterNumReplicasPerShard(cluster)
+
+	if err != nil {
+		return diag.Errorf("error reading num_replicas_per_shard for MemoryDB Cluster (%s): %s", d.Id(), err)
+	}
+
+	d.Set("num_replicas_per_shard", numReplicasPerShard)
+
+	if v := cluster.ClusterEndpoint; v != nil {
+		d.Set("port", v.Port)
+	}
+
+	if err := d.Set("security_group_ids", flattenSecurityGroupIDs(cluster.SecurityGroups)); err != nil {
+		return diag.Errorf("error setting security_group_ids: %s", err)
+	}
+
+	if err := d.Set("shards", flattenShards(cluster.Shards)); err != nil {
+		return diag.Errorf("error setting shards: %s", err)
+	}
+
+	if aws.StringValue(cluster.SnsTopicStatus) == clusterSNSTopicStatusActive {
+		d.Set("sns_topic_arn", cluster.SnsTopicArn)
+	} else {
+		d.Set("sns_topic_arn", "")
+	}
+
+	tags, err := ListTags(conn, d.Get("arn").(string))
+
+	if err != nil {
+		return diag.Errorf("error listing tags for MemoryDB Cluster (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MemoryDBConn
+
+	if d.HasChangesExcept("final_snapshot_name", "tags", "tags_all") {
+		waitParameterGroupInSync := false
+
+		input := &memorydb.UpdateClusterInput{
+			ClusterName: aws.String(d.Id()),
+		}
+
+		if d.HasChange("acl_name") {
+			input.ACLName = aws.String(d.Get("acl_name").(string))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("engine_version") {
+			input.EngineVersion = aws.String(d.Get("engine_version").(string))
+		}
+
+		if d.HasChange("maintenance_window") {
+			input.MaintenanceWindow = aws.String(d.Get("maintenance_window").(string))
+		}
+
+		if d.HasChange("node_type") {
+			input.NodeType = aws.String(d.Get("node_type").(string))
+		}
+
+		if d.HasChange("num_replicas_per_shard") {
+			input.ReplicaConfiguration = &memorydb.ReplicaConfigurationRequest{
+				ReplicaCount: aws.Int64(int64(d.Get("num_replicas_per_shard").(int))),
+			}
+		}
+
+		if d.HasChange("num_shards") {
+			input.ShardConfiguration = &memorydb.ShardConfigurationRequest{
+				ShardCount: aws.Int64(int64(d.Get("num_shards").(int))),
+			}
+		}
+
+		if d.HasChange("parameter_group_name") {
+			input.ParameterGroupName = aws.String(d.Get("parameter_group_name").(string))
+			waitParameterGroupInSync = true
+		}
+
+		if d.HasChange("security_group_ids") {
+			// This should only be reachable from a change in configuration, so
+			// an empty set is a deliberate attempt to remove all security groups.
+			input.SecurityGroupIds = flex.ExpandStringSet(d.Get("security_group_ids").(*schema.Set))
+		}
+
+		if d.HasChange("snapshot_retention_limit") {
+			input.SnapshotRetentionLimit = aws.Int64(int64(d.Get("snapshot_retention_limit").(int)))
+		}
+
+		if d.HasChange("snapshot_window") {
+			input.SnapshotWindow = aws.String(d.Get("snapshot_window").(string))
+		}
+
+		if d.HasChange("sns_topic_arn") {
+			v := d.Get("sns_topic_arn").(string)
+
+			input.SnsTopicArn = aws.String(v)
+
+			if v == "" {
+				input.SnsTopicStatus = aws.String(clusterSNSTopicStatusInactive)
+			} else {
+				input.SnsTopicStatus = aws.String(clusterSNSTopicStatusActive)
+			}
+		}
+
+		log.Printf("[DEBUG] Updating MemoryDB Cluster: %s", input)
+		_, err := conn.UpdateClusterWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating MemoryDB Cluster (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitClusterAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("error waiting for MemoryDB Cluster (%s) update: %s", d.Id(), err)
+		}
+
+		if waitParameterGroupInSync {
+			if _, err := waitClusterParameterGroupInSync(ctx, conn, d.Id(), clusterParameterGroupInSyncTimeout); err != nil {
+				return diag.Errorf("error waiting for MemoryDB Cluster (%s) parameter group to be in sync: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating MemoryDB Cluster (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceClusterRead(ctx, d, meta)
+}
+
+func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MemoryDBConn
+
+	input := &memorydb.DeleteClusterInput{
+		ClusterName: aws.String(d.Id()),
+	}
+
+	if v := d.Get("final_snapshot_name"); v != nil && len(v.(string)) > 0 {
+		input.FinalSnapshotName = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Deleting MemoryDB Cluster: (%s)", d.Id())
+	_, err := conn.DeleteClusterWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeClusterNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting MemoryDB Cluster (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitClusterDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for MemoryDB Cluster (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// deriveClusterNumReplicasPerShard determines the replicas per shard
+// configuration of a cluster. As this cannot directly be read back, we
+// assume that it's the same as that of the largest shard.
+//
+// For the sake of caution, this search is limited to stable shards.
+func deriveClusterNumReplicasPerShard(cluster *memorydb.Cluster) (int, error) {
+	var maxNumberOfNodesPerShard int64
+
+	for _, shard := range cluster.Shards {
+		if aws.StringValue(shard.Status) != clusterShardStatusAvailable {
+			continue
+		}
+
+		n := aws.Int64Value(shard.NumberOfNodes)
+		if n > maxNumberOfNodesPerShard {
+			maxNumberOfNodesPerShard = n
+		}
+	}
+
+	if maxNumberOfNodesPerShard == 0 {
+		return 0, fmt.Errorf("no available shards found")
+	}
+
+	return int(maxNumberOfNodesPerShard - 1), nil
+}
+
+func flattenEndpoint(apiObject *memorydb.Endpoint) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := aws.StringValue(apiObject.Address); v != "" {
+		tfMap["address"] = v
+	}
+
+	if v := aws.Int64Value(apiObject.Port); v != 0 {
+		tfMap["port"] = v
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSecurityGroupIDs(apiObjects []*memorydb.SecurityGroupMembership) []string {
+	var securityGroupIDs []string
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		if v := aws.StringValue(apiObject.SecurityGroupId); v != "" {
+			securityGroupIDs = append(securityGroupIDs, v)
+		}
+	}
+
+	return securityGroupIDs
+}
+
+func flattenShards(apiObjects []*memorydb.Shard) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		var nodes []interface{}
+
+		for _, node := range apiObject.Nodes {
+			if node == nil {
+				continue
+			}
+
+			nodes = append(nodes, map[string]interface{}{
+				"availability_zone": aws.StringValue(node.AvailabilityZone),
+				"create_time":       aws.TimeValue(node.CreateTime).Format(time.RFC3339),
+				"endpoint":          flattenEndpoint(node.Endpoint),
+				"name":              aws.StringValue(node.Name),
+			})
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":      aws.StringValue(apiObject.Name),
+			"num_nodes": int(aws.Int64Value(apiObject.NumberOfNodes)),
+			"nodes":     nodes,
+			"slots":     aws.StringValue(apiObject.Slots),
+		})
+	}
+
+	return tfList
+}
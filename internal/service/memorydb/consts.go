@@ -0,0 +1,77 @@
+package memorydb
+
+import (
+	"time"
+)
+
+const (
+	aclStatusActive    = "active"
+	aclStatusCreating  = "creating"
+	aclStatusDeleting  = "deleting"
+	aclStatusModifying = "modifying"
+)
+
+const (
+	clusterStatusAvailable    = "available"
+	clusterStatusCreating     = "creating"
+	clusterStatusDeleting     = "deleting"
+	clusterStatusSnapshotting = "snapshotting"
+	clusterStatusUpdating     = "updating"
+)
+
+const (
+	clusterParameterGroupStatusApplying = "applying"
+	clusterParameterGroupStatusInSync   = "in-sync"
+)
+
+const (
+	clusterShardStatusAvailable = "available"
+)
+
+const (
+	clusterSNSTopicStatusActive   = "ACTIVE"
+	clusterSNSTopicStatusInactive = "INACTIVE"
+)
+
+const (
+	snapshotStatusAvailable = "available"
+	snapshotStatusCreating  = "creating"
+	snapshotStatusDeleting  = "deleting"
+)
+
+const (
+	userStatusActive    = "active"
+	userStatusDeleting  = "deleting"
+	userStatusModifying = "modifying"
+)
+
+const (
+	aclActiveTimeout  = 5 * time.Minute
+	aclDeletedTimeout = 5 * time.Minute
+
+	clusterDefaultCreateTimeout = 120 * time.Minute
+	clusterDefaultUpdateTimeout = 120 * time.Minute
+	clusterDefaultDeleteTimeout = 120 * time.Minute
+
+	clusterParameterGroupInSyncTimeout = 60 * time.Minute
+
+	snapshotDefaultCreateTimeout = 120 * time.Minute
+	snapshotDefaultDeleteTimeout = 120 * time.Minute
+
+	userActiveTimeout  = 5 * time.Minute
+	userDeletedTimeout = 5 * time.Minute
+)
+
+const (
+	aclNameMaxLength            = 40
+	clusterNameMaxLength        = 40
+	parameterGroupNameMaxLength = 255
+	snapshotNameMaxLength       = 255
+	subnetGroupNameMaxLength    = 255
+)
+
+// The name of the ACL that is created by MemoryDB and gives full access to the default user.
+const aclNameOpenAccess = "open-access"
+
+// The number of parameters which can be modified in a single UpdateParameterGroup call.
+const parameterGroupMaxParamsBulkEdit = 20
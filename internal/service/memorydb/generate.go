@@ -0,0 +1,6 @@
+//go:generate go run -tags generate ../../generate/listpages/main.go -ListOps=DescribeACLs,DescribeClusters,DescribeParameterGroups,DescribeParameters,DescribeSnapshots,DescribeSubnetGroups,DescribeUsers
+//go:generate go run -tags generate ../../generate/tags/main.go -CreateTags=yes -ListTags=yes -ListTagsOp=ListTags -ListTagsOutTagsElem=TagList -ServiceTagsSlice=yes -UpdateTags=yes
+//go:generate go run -tags generate ../../generate/waiters/main.go
+// ONLY generate directives and package declaration! Do not add anything else to this file.
+
+package memorydb
@@ -0,0 +1,387 @@
+package memorydb
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceParameterGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceParameterGroupCreate,
+		ReadContext:   resourceParameterGroupRead,
+		UpdateContext: resourceParameterGroupUpdate,
+		DeleteContext: resourceParameterGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "Managed by Terraform",
+			},
+			"family": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name_prefix"},
+				ValidateFunc:  validResourceName(parameterGroupNameMaxLength),
+			},
+			"name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name"},
+				ValidateFunc:  validResourceNamePrefix(parameterGroupNameMaxLength - resource.UniqueIDSuffixLength),
+			},
+			"parameter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+				Set: parameterHash,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceParameterGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MemoryDBConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
+	input := &memorydb.CreateParameterGroupInput{
+		Description:        aws.String(d.Get("description").(string)),
+		Family:             aws.String(d.Get("family").(string)),
+		ParameterGroupName: aws.String(name),
+		Tags:               TagsOnCreate(tags),
+	}
+
+	log.Printf("[DEBUG] Creating MemoryDB Parameter Group: %s", input)
+	output, err := conn.CreateParameterGroupWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating MemoryDB Parameter Group (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+	d.Set("arn", output.ParameterGroup.ARN)
+
+	// Update to apply parameter changes.
+	return resourceParameterGroupUpdate(ctx, d, meta)
+}
+
+func resourceParameterGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MemoryDBConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	group, err := FindParameterGroupByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MemoryDB Parameter Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading MemoryDB Parameter Group (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", group.ARN)
+	d.Set("description", group.Description)
+	d.Set("family", group.Family)
+	d.Set("name", group.Name)
+	d.Set("name_prefix", create.NamePrefixFromName(aws.StringValue(group.Name)))
+
+	userDefinedParameters := expandParameterNameValueMap(d.Get("parameter").(*schema.Set).List())
+
+	parameters, err := listParameterGroupParameters(ctx, conn, aws.StringValue(group.Family), d.Id(), userDefinedParameters)
+
+	if err != nil {
+		return diag.Errorf("error listing parameters for MemoryDB Parameter Group (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("parameter", flattenParameters(parameters)); err != nil {
+		return diag.Errorf("error setting parameter: %s", err)
+	}
+
+	tags, err := ListTags(conn, d.Get("arn").(string))
+
+	if err != nil {
+		return diag.Errorf("error listing tags for MemoryDB Parameter Group (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceParameterGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MemoryDBConn
+
+	if d.HasChange("parameter") {
+		o, n := d.GetChange("parameter")
+		toRemove, toAdd := parameterChanges(o, n)
+
+		// The API is limited to updating no more than 20 parameters at a time.
+		for len(toRemove) > 0 {
+			var paramsToModify []*memorydb.ParameterNameValue
+
+			if len(toRemove) <= parameterGroupMaxParamsBulkEdit {
+				paramsToModify, toRemove = toRemove[:], nil
+			} else {
+				paramsToModify, toRemove = toRemove[:parameterGroupMaxParamsBulkEdit], toRemove[parameterGroupMaxParamsBulkEdit:]
+			}
+
+			if err := resetParameterGroupParameters(ctx, conn, d.Id(), paramsToModify); err != nil {
+				return diag.Errorf("error resetting MemoryDB Parameter Group (%s) parameters: %s", d.Id(), err)
+			}
+		}
+
+		for len(toAdd) > 0 {
+			var paramsToModify []*memorydb.ParameterNameValue
+
+			if len(toAdd) <= parameterGroupMaxParamsBulkEdit {
+				paramsToModify, toAdd = toAdd[:], nil
+			} else {
+				paramsToModify, toAdd = toAdd[:parameterGroupMaxParamsBulkEdit], toAdd[parameterGroupMaxParamsBulkEdit:]
+			}
+
+			if err := modifyParameterGroupParameters(ctx, conn, d.Id(), paramsToModify); err != nil {
+				return diag.Errorf("error modifying MemoryDB Parameter Group (%s) parameters: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating MemoryDB Parameter Group (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceParameterGroupRead(ctx, d, meta)
+}
+
+func resourceParameterGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MemoryDBConn
+
+	log.Printf("[DEBUG] Deleting MemoryDB Parameter Group: (%s)", d.Id())
+	_, err := conn.DeleteParameterGroupWithContext(ctx, &memorydb.DeleteParameterGroupInput{
+		ParameterGroupName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeParameterGroupNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting MemoryDB Parameter Group (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resetParameterGroupParameters resets the given parameters to their default values.
+func resetParameterGroupParameters(ctx context.Context, conn *memorydb.MemoryDB, name string, parameters []*memorydb.ParameterNameValue) error {
+	var parameterNames []*string
+	for _, parameter := range parameters {
+		parameterNames = append(parameterNames, parameter.ParameterName)
+	}
+
+	input := &memorydb.ResetParameterGroupInput{
+		ParameterGroupName: aws.String(name),
+		ParameterNames:     parameterNames,
+	}
+
+	log.Printf("[DEBUG] Resetting MemoryDB Parameter Group: %s", input)
+	_, err := conn.ResetParameterGroupWithContext(ctx, input)
+
+	return err
+}
+
+// modifyParameterGroupParameters sets the given parameters to their configured values.
+func modifyParameterGroupParameters(ctx context.Context, conn *memorydb.MemoryDB, name string, parameters []*memorydb.ParameterNameValue) error {
+	input := &memorydb.UpdateParameterGroupInput{
+		ParameterGroupName:  aws.String(name),
+		ParameterNameValues: parameters,
+	}
+
+	log.Printf("[DEBUG] Updating MemoryDB Parameter Group: %s", input)
+	_, err := conn.UpdateParameterGroupWithContext(ctx, input)
+
+	return err
+}
+
+// listParameterGroupParameters returns the parameters of a parameter group which are either
+// configured, or whose value differs from the default for the parameter group family.
+func listParameterGroupParameters(ctx context.Context, conn *memorydb.MemoryDB, family, name string, userDefined map[string]string) ([]*memorydb.Parameter, error) {
+	// There isn't an API to list the default values of a family's parameters,
+	// so use the parameters of the default parameter group for the family.
+	defaultsName := "default." + strings.ReplaceAll(family, "_", "-")
+
+	defaults, err := FindParametersByParameterGroupName(ctx, conn, defaultsName)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading default parameters (%s): %w", defaultsName, err)
+	}
+
+	defaultValues := make(map[string]string, len(defaults))
+	for _, parameter := range defaults {
+		defaultValues[aws.StringValue(parameter.Name)] = aws.StringValue(parameter.Value)
+	}
+
+	parameters, err := FindParametersByParameterGroupName(ctx, conn, name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*memorydb.Parameter
+
+	for _, parameter := range parameters {
+		parameterName := aws.StringValue(parameter.Name)
+		defaultValue, hasDefault := defaultValues[parameterName]
+		_, isUserDefined := userDefined[parameterName]
+
+		if !hasDefault || isUserDefined || defaultValue != aws.StringValue(parameter.Value) {
+			result = append(result, parameter)
+		}
+	}
+
+	return result, nil
+}
+
+// parameterChanges returns the parameters to reset to their default values and the parameters to modify.
+func parameterChanges(o, n interface{}) (remove, addOrUpdate []*memorydb.ParameterNameValue) {
+	if o == nil {
+		o = new(schema.Set)
+	}
+	if n == nil {
+		n = new(schema.Set)
+	}
+
+	os := o.(*schema.Set)
+	ns := n.(*schema.Set)
+
+	om := expandParameterNameValueMap(os.Difference(ns).List())
+	nm := expandParameterNameValueMap(ns.Difference(os).List())
+
+	for name, value := range nm {
+		addOrUpdate = append(addOrUpdate, &memorydb.ParameterNameValue{
+			ParameterName:  aws.String(name),
+			ParameterValue: aws.String(value),
+		})
+	}
+
+	for name, value := range om {
+		// Parameters whose value has changed are updated rather than reset.
+		if _, ok := nm[name]; ok {
+			continue
+		}
+
+		remove = append(remove, &memorydb.ParameterNameValue{
+			ParameterName:  aws.String(name),
+			ParameterValue: aws.String(value),
+		})
+	}
+
+	return remove, addOrUpdate
+}
+
+func parameterHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", m["name"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["value"].(string)))
+
+	return create.StringHashcode(buf.String())
+}
+
+func expandParameterNameValueMap(tfList []interface{}) map[string]string {
+	m := make(map[string]string, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		m[tfMap["name"].(string)] = tfMap["value"].(string)
+	}
+
+	return m
+}
+
+func flattenParameters(apiObjects []*memorydb.Parameter) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":  aws.StringValue(apiObject.Name),
+			"value": aws.StringValue(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
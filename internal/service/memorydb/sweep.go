@@ -0,0 +1,385 @@
+//go:build sweep
+// +build sweep
+
+package memorydb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_memorydb_acl", &resource.Sweeper{
+		Name: "aws_memorydb_acl",
+		F:    sweepACLs,
+		Dependencies: []string{
+			"aws_memorydb_cluster",
+		},
+	})
+
+	resource.AddTestSweepers("aws_memorydb_cluster", &resource.Sweeper{
+		Name: "aws_memorydb_cluster",
+		F:    sweepClusters,
+	})
+
+	resource.AddTestSweepers("aws_memorydb_parameter_group", &resource.Sweeper{
+		Name: "aws_memorydb_parameter_group",
+		F:    sweepParameterGroups,
+		Dependencies: []string{
+			"aws_memorydb_cluster",
+		},
+	})
+
+	resource.AddTestSweepers("aws_memorydb_snapshot", &resource.Sweeper{
+		Name: "aws_memorydb_snapshot",
+		F:    sweepSnapshots,
+		Dependencies: []string{
+			"aws_memorydb_cluster",
+		},
+	})
+
+	resource.AddTestSweepers("aws_memorydb_subnet_group", &resource.Sweeper{
+		Name: "aws_memorydb_subnet_group",
+		F:    sweepSubnetGroups,
+		Dependencies: []string{
+			"aws_memorydb_cluster",
+		},
+	})
+
+	resource.AddTestSweepers("aws_memorydb_user", &resource.Sweeper{
+		Name: "aws_memorydb_user",
+		F:    sweepUsers,
+		Dependencies: []string{
+			"aws_memorydb_acl",
+		},
+	})
+}
+
+func sweepACLs(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).MemoryDBConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	ctx := context.Background()
+	var errs *multierror.Error
+
+	input := &memorydb.DescribeACLsInput{}
+
+	err = describeACLsPagesWithContext(ctx, conn, input, func(page *memorydb.DescribeACLsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ACLs {
+			if v == nil {
+				continue
+			}
+
+			if name := aws.StringValue(v.Name); name == aclNameOpenAccess {
+				log.Printf("[INFO] Skipping MemoryDB ACL: %s", name)
+				continue
+			}
+
+			r := ResourceACL()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing MemoryDB ACLs: %w", err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping MemoryDB ACLs for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping MemoryDB ACL sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepClusters(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).MemoryDBConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	ctx := context.Background()
+	var errs *multierror.Error
+
+	input := &memorydb.DescribeClustersInput{}
+
+	err = describeClustersPagesWithContext(ctx, conn, input, func(page *memorydb.DescribeClustersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Clusters {
+			if v == nil {
+				continue
+			}
+
+			r := ResourceCluster()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing MemoryDB Clusters: %w", err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping MemoryDB Clusters for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping MemoryDB Cluster sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepParameterGroups(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).MemoryDBConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	ctx := context.Background()
+	var errs *multierror.Error
+
+	input := &memorydb.DescribeParameterGroupsInput{}
+
+	err = describeParameterGroupsPagesWithContext(ctx, conn, input, func(page *memorydb.DescribeParameterGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ParameterGroups {
+			if v == nil {
+				continue
+			}
+
+			if name := aws.StringValue(v.Name); strings.HasPrefix(name, "default.") {
+				log.Printf("[INFO] Skipping MemoryDB Parameter Group: %s", name)
+				continue
+			}
+
+			r := ResourceParameterGroup()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing MemoryDB Parameter Groups: %w", err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping MemoryDB Parameter Groups for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping MemoryDB Parameter Group sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepSnapshots(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).MemoryDBConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	ctx := context.Background()
+	var errs *multierror.Error
+
+	input := &memorydb.DescribeSnapshotsInput{}
+
+	err = describeSnapshotsPagesWithContext(ctx, conn, input, func(page *memorydb.DescribeSnapshotsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Snapshots {
+			if v == nil {
+				continue
+			}
+
+			r := ResourceSnapshot()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing MemoryDB Snapshots: %w", err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping MemoryDB Snapshots for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping MemoryDB Snapshot sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepSubnetGroups(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).MemoryDBConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	ctx := context.Background()
+	var errs *multierror.Error
+
+	input := &memorydb.DescribeSubnetGroupsInput{}
+
+	err = describeSubnetGroupsPagesWithContext(ctx, conn, input, func(page *memorydb.DescribeSubnetGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.SubnetGroups {
+			if v == nil {
+				continue
+			}
+
+			if name := aws.StringValue(v.Name); name == "default" {
+				log.Printf("[INFO] Skipping MemoryDB Subnet Group: %s", name)
+				continue
+			}
+
+			r := ResourceSubnetGroup()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing MemoryDB Subnet Groups: %w", err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping MemoryDB Subnet Groups for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping MemoryDB Subnet Group sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepUsers(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).MemoryDBConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	ctx := context.Background()
+	var errs *multierror.Error
+
+	input := &memorydb.DescribeUsersInput{}
+
+	err = describeUsersPagesWithContext(ctx, conn, input, func(page *memorydb.DescribeUsersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Users {
+			if v == nil {
+				continue
+			}
+
+			if name := aws.StringValue(v.Name); name == "default" {
+				log.Printf("[INFO] Skipping MemoryDB User: %s", name)
+				continue
+			}
+
+			r := ResourceUser()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing MemoryDB Users: %w", err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping MemoryDB Users for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping MemoryDB User sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -0,0 +1,119 @@
+//go:build sweep
+// +build sweep
+
+package memorydb
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/replay"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+// TestSweepACLsAllowTags runs the ACL sweeper against replayed API responses to check that
+// the sweep allowlist matches the tags of the resources the sweeper finds.
+func TestSweepACLsAllowTags(t *testing.T) {
+	region := "us-west-2" //lintignore:AWSAT003
+	arn := func(name string) string {
+		return fmt.Sprintf("arn:aws:memorydb:%s:123456789012:acl/%s", region, name) //lintignore:AWSAT005
+	}
+	acl := func(name string) string {
+		return fmt.Sprintf(`{"ARN":%q,"Name":%q,"MinimumEngineVersion":"6.2","Status":"active","UserNames":[]}`, arn(name), name)
+	}
+	interaction := func(operation string, body string, statusCode int, response string) *replay.Interaction {
+		return &replay.Interaction{
+			Request: replay.Request{
+				Service:   "memorydb",
+				Operation: operation,
+				Method:    http.MethodPost,
+				Path:      "/",
+				Body:      body,
+			},
+			Response: replay.Response{
+				StatusCode: statusCode,
+				Header:     map[string]string{"Content-Type": "application/x-amz-json-1.1"},
+				Body:       response,
+			},
+		}
+	}
+
+	filename := filepath.Join(t.TempDir(), "fixtures.json")
+	cassette := &replay.Cassette{
+		AccountID: "123456789012",
+		Partition: "aws",
+		Region:    region,
+		Interactions: []*replay.Interaction{
+			interaction("DescribeACLs", `{}`, http.StatusOK, fmt.Sprintf(`{"ACLs":[%s,%s,%s]}`, acl(aclNameOpenAccess), acl("tf-acc-test-tagged"), acl("tf-acc-test-untagged"))),
+			interaction("DescribeACLs", `{"ACLName":"tf-acc-test-tagged"}`, http.StatusOK, fmt.Sprintf(`{"ACLs":[%s]}`, acl("tf-acc-test-tagged"))),
+			interaction("ListTags", fmt.Sprintf(`{"ResourceArn":%q}`, arn("tf-acc-test-tagged")), http.StatusOK, `{"TagList":[{"Key":"environment","Value":"test"}]}`),
+			interaction("DescribeACLs", `{"ACLName":"tf-acc-test-untagged"}`, http.StatusOK, fmt.Sprintf(`{"ACLs":[%s]}`, acl("tf-acc-test-untagged"))),
+			interaction("ListTags", fmt.Sprintf(`{"ResourceArn":%q}`, arn("tf-acc-test-untagged")), http.StatusOK, `{"TagList":[]}`),
+			interaction("DeleteACL", `{"ACLName":"tf-acc-test-tagged"}`, http.StatusOK, fmt.Sprintf(`{"ACL":%s}`, acl("tf-acc-test-tagged"))),
+			interaction("DescribeACLs", `{"ACLName":"tf-acc-test-tagged"}`, http.StatusBadRequest, `{"__type":"ACLNotFoundFault","message":"ACL tf-acc-test-tagged not found"}`),
+		},
+	}
+
+	if err := cassette.Save(filename); err != nil {
+		t.Fatal(err)
+	}
+
+	config := &conns.Config{
+		MaxRetries:     1,
+		ReplayFixtures: filename,
+	}
+
+	client, err := config.Client()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	t.Cleanup(func() {
+		if err := conns.CloseReplayServer(filename); err != nil {
+			t.Error(err)
+		}
+	})
+
+	sweep.SweeperClients = map[string]interface{}{region: client}
+
+	if err := flag.Set("sweep-allow-tags", "environment=test"); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		sweep.SweeperClients = nil
+		flag.Set("sweep-allow-tags", "") //nolint:errcheck
+	})
+
+	if err := sweepACLs(region); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	actions := make(map[string]string)
+
+	for _, outcome := range sweep.DefaultReport.Outcomes() {
+		if outcome.Sweeper == "memorydb.sweepACLs" {
+			actions[outcome.ID] = outcome.Action
+		}
+	}
+
+	expected := map[string]string{
+		"tf-acc-test-tagged":   sweep.ActionDeleted,
+		"tf-acc-test-untagged": sweep.ActionSkipped,
+	}
+
+	if len(actions) != len(expected) {
+		t.Fatalf("got outcomes %v, expected %v", actions, expected)
+	}
+
+	for id, action := range expected {
+		if got := actions[id]; got != action {
+			t.Errorf("%s: got action %q, expected %q", id, got, action)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package memorydb
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// validResourceName returns a validation function for MemoryDB resource names.
+// Names must begin with a lowercase letter and contain only lowercase letters, digits and non-consecutive hyphens.
+func validResourceName(maxLength int) schema.SchemaValidateFunc {
+	return validation.All(
+		validation.StringLenBetween(1, maxLength),
+		validation.StringDoesNotMatch(regexp.MustCompile(`[-][-]`), "The name may not contain two consecutive hyphens."),
+		validation.StringMatch(
+			// Similar to ElastiCache, MemoryDB normalises names to lowercase.
+			regexp.MustCompile(`^[a-z0-9-]*[a-z0-9]$`),
+			"Only lowercase alphanumeric characters and hyphens are allowed, and the name may not end with a hyphen.",
+		),
+		validation.StringMatch(regexp.MustCompile(`^[a-z]`), "The name must begin with a lowercase letter."),
+	)
+}
+
+// validResourceNamePrefix returns a validation function for MemoryDB resource name prefixes.
+func validResourceNamePrefix(maxLength int) schema.SchemaValidateFunc {
+	return validation.All(
+		validation.StringLenBetween(1, maxLength),
+		validation.StringDoesNotMatch(regexp.MustCompile(`[-][-]`), "The name may not contain two consecutive hyphens."),
+		validation.StringMatch(regexp.MustCompile(`^[a-z0-9-]+$`), "Only lowercase alphanumeric characters and hyphens are allowed."),
+		validation.StringMatch(regexp.MustCompile(`^[a-z]`), "The name must begin with a lowercase letter."),
+	)
+}
+
+// validUserName validates MemoryDB user names.
+func validUserName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if len(value) == 0 {
+		errors = append(errors, fmt.Errorf("%q cannot be an empty string", k))
+	}
+
+	if !regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]*$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must begin with a letter and contain only alphanumeric characters and hyphens", k))
+	}
+
+	if regexp.MustCompile(`--|-$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q cannot contain two consecutive hyphens or end with a hyphen", k))
+	}
+
+	return
+}
@@ -0,0 +1,101 @@
+package memorydb
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidResourceName(t *testing.T) {
+	validNames := []string{
+		"a",
+		"a1",
+		"test-cluster",
+		"a" + strings.Repeat("b", 39),
+	}
+
+	for _, v := range validNames {
+		_, errors := validResourceName(40)(v, "name")
+		if len(errors) != 0 {
+			t.Errorf("%q should be a valid MemoryDB resource name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"1a",
+		"-a",
+		"a-",
+		"a--b",
+		"Test",
+		"test_cluster",
+		"a" + strings.Repeat("b", 40),
+	}
+
+	for _, v := range invalidNames {
+		_, errors := validResourceName(40)(v, "name")
+		if len(errors) == 0 {
+			t.Errorf("%q should be an invalid MemoryDB resource name", v)
+		}
+	}
+}
+
+func TestValidResourceNamePrefix(t *testing.T) {
+	validNames := []string{
+		"a",
+		"tf-",
+		"test-prefix-",
+	}
+
+	for _, v := range validNames {
+		_, errors := validResourceNamePrefix(14)(v, "name_prefix")
+		if len(errors) != 0 {
+			t.Errorf("%q should be a valid MemoryDB resource name prefix: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"1a",
+		"-a",
+		"a--",
+		"Test",
+		"a" + strings.Repeat("b", 14),
+	}
+
+	for _, v := range invalidNames {
+		_, errors := validResourceNamePrefix(14)(v, "name_prefix")
+		if len(errors) == 0 {
+			t.Errorf("%q should be an invalid MemoryDB resource name prefix", v)
+		}
+	}
+}
+
+func TestValidUserName(t *testing.T) {
+	validNames := []string{
+		"a",
+		"User1",
+		"test-user",
+	}
+
+	for _, v := range validNames {
+		_, errors := validUserName(v, "user_name")
+		if len(errors) != 0 {
+			t.Errorf("%q should be a valid MemoryDB user name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"1user",
+		"user-",
+		"user--1",
+		"user_1",
+	}
+
+	for _, v := range invalidNames {
+		_, errors := validUserName(v, "user_name")
+		if len(errors) == 0 {
+			t.Errorf("%q should be an invalid MemoryDB user name", v)
+		}
+	}
+}
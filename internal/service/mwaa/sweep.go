@@ -43,7 +43,7 @@ func sweepEnvironment(region string) error {
 		d := r.Data(nil)
 		d.SetId(name)
 
-		err := r.Delete(d, client)
+		err := sweep.DeleteResource(r, d, client)
 		if err != nil {
 			log.Printf("[ERROR] Failed to delete MWAA Environment %s: %s", name, err)
 		}
@@ -13,6 +13,38 @@ func DataSourceDefaultTags() *schema.Resource {
 		Read: dataSourceDefaultTagsRead,
 
 		Schema: map[string]*schema.Schema{
+			"resource_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"exclude_all_tags": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"exclude_key_prefixes": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"exclude_keys": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"resource_types": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"tags": tftags.TagsSchemaComputed(),
+					},
+				},
+			},
 			"tags": tftags.TagsSchemaComputed(),
 		},
 	}
@@ -24,6 +56,20 @@ func dataSourceDefaultTagsRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(meta.(*conns.AWSClient).Partition)
 
+	var rules []*tftags.DefaultRule
+
+	if defaultTagsConfig != nil {
+		rules = defaultTagsConfig.Rules
+	}
+
+	if err := d.Set("rule", flattenDefaultTagsRules(rules)); err != nil {
+		return fmt.Errorf("error setting rule: %w", err)
+	}
+
+	if v, ok := d.GetOk("resource_type"); ok {
+		defaultTagsConfig = defaultTagsConfig.ForResourceType(v.(string))
+	}
+
 	tags := defaultTagsConfig.GetTags()
 
 	if tags != nil {
@@ -36,3 +82,23 @@ func dataSourceDefaultTagsRead(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+func flattenDefaultTagsRules(rules []*tftags.DefaultRule) []interface{} {
+	tfList := make([]interface{}, 0, len(rules))
+
+	for _, rule := range rules {
+		if rule == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"exclude_all_tags":     rule.ExcludeAllTags,
+			"exclude_key_prefixes": rule.ExcludeKeyPrefixes.Keys(),
+			"exclude_keys":         rule.ExcludeKeys.Keys(),
+			"resource_types":       rule.ResourceTypes,
+			"tags":                 rule.Tags.Map(),
+		})
+	}
+
+	return tfList
+}
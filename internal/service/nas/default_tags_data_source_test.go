@@ -1,6 +1,7 @@
 package nas_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -118,6 +119,49 @@ func TestAccNASDefaultTagsDataSource_ignore(t *testing.T) {
 	})
 }
 
+func TestAccNASDefaultTagsDataSource_rule(t *testing.T) {
+	var providers []*schema.Provider
+
+	dataSourceName := "data.aws_default_tags.test"
+	resourceTypeDataSourceName := "data.aws_default_tags.resource_type"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProviderFactories: acctest.FactoriesInternal(&providers),
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Rule1("CostCenter", "1234", "aws_ebs_volume", "Backup", "true"),
+					testAccDefaultTagsDataSource(),
+					testAccDefaultTagsDataSourceResourceType("aws_ebs_volume"),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.CostCenter", "1234"),
+					resource.TestCheckResourceAttr(dataSourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "rule.0.exclude_all_tags", "false"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "rule.0.exclude_keys.*", "CostCenter"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "rule.0.resource_types.*", "aws_ebs_volume"),
+					resource.TestCheckResourceAttr(dataSourceName, "rule.0.tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "rule.0.tags.Backup", "true"),
+					resource.TestCheckResourceAttr(resourceTypeDataSourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceTypeDataSourceName, "tags.Backup", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDefaultTagsDataSourceResourceType(resourceType string) string {
+	return fmt.Sprintf(`
+data "aws_default_tags" "resource_type" {
+  resource_type = %[1]q
+}
+`, resourceType)
+}
+
 func testAccDefaultTagsDataSource() string {
 	return `data "aws_default_tags" "test" {}`
 }
@@ -451,6 +451,7 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, neptune.ErrCodeDBClusterNotFoundFault, "") {
+			//lintignore:AWSR003
 			d.SetId("")
 			log.Printf("[DEBUG] Neptune Cluster (%s) not found", d.Id())
 			return nil
@@ -468,6 +469,7 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 
 	if dbc == nil {
 		log.Printf("[WARN] Neptune Cluster (%s) not found", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -292,6 +292,7 @@ func resourceClusterInstanceRead(d *schema.ResourceData, meta interface{}) error
 
 	if db == nil {
 		log.Printf("[WARN] Neptune Cluster Instance (%s): not found, removing from state.", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -143,6 +143,7 @@ func resourceClusterParameterGroupRead(d *schema.ResourceData, meta interface{})
 	describeResp, err := conn.DescribeDBClusterParameterGroups(&describeOpts)
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, neptune.ErrCodeDBParameterGroupNotFoundFault) {
 		log.Printf("[WARN] Neptune Cluster Parameter Group (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -157,6 +158,7 @@ func resourceClusterParameterGroupRead(d *schema.ResourceData, meta interface{})
 		}
 
 		log.Printf("[WARN] Neptune Cluster Parameter Group (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -141,6 +141,7 @@ func resourceClusterSnapshotRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, neptune.ErrCodeDBClusterSnapshotNotFoundFault, "") {
 			log.Printf("[WARN] Neptune DB Cluster Snapshot %q not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -149,6 +150,7 @@ func resourceClusterSnapshotRead(d *schema.ResourceData, meta interface{}) error
 
 	if output == nil || len(output.DBClusterSnapshots) == 0 || output.DBClusterSnapshots[0] == nil || aws.StringValue(output.DBClusterSnapshots[0].DBClusterSnapshotIdentifier) != d.Id() {
 		log.Printf("[WARN] Neptune DB Cluster Snapshot %q not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -123,6 +123,7 @@ func resourceSubnetGroupRead(d *schema.ResourceData, meta interface{}) error {
 	}); err != nil {
 		if tfawserr.ErrMessageContains(err, neptune.ErrCodeDBSubnetGroupNotFoundFault, "") {
 			log.Printf("[WARN] Neptune Subnet Group (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -131,6 +132,7 @@ func resourceSubnetGroupRead(d *schema.ResourceData, meta interface{}) error {
 
 	if len(subnetGroups) == 0 {
 		log.Printf("[WARN] Unable to find Neptune Subnet Group: %#v, removing from state", subnetGroups)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
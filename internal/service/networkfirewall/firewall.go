@@ -191,6 +191,7 @@ func resourceFirewallRead(ctx context.Context, d *schema.ResourceData, meta inte
 	output, err := conn.DescribeFirewallWithContext(ctx, input)
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, networkfirewall.ErrCodeResourceNotFoundException) {
 		log.Printf("[WARN] NetworkFirewall Firewall (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -150,6 +150,7 @@ func resourceFirewallPolicyRead(ctx context.Context, d *schema.ResourceData, met
 	output, err := FindFirewallPolicy(ctx, conn, d.Id())
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, networkfirewall.ErrCodeResourceNotFoundException) {
 		log.Printf("[WARN] NetworkFirewall Firewall Policy (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
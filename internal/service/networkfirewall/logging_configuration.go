@@ -97,6 +97,7 @@ func resourceLoggingConfigurationRead(ctx context.Context, d *schema.ResourceDat
 	output, err := FindLoggingConfiguration(ctx, conn, d.Id())
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, networkfirewall.ErrCodeResourceNotFoundException) {
 		log.Printf("[WARN] Logging Configuration for NetworkFirewall Firewall (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -72,6 +72,7 @@ func resourceResourcePolicyRead(ctx context.Context, d *schema.ResourceData, met
 	policy, err := FindResourcePolicy(ctx, conn, resourceArn)
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, networkfirewall.ErrCodeResourceNotFoundException) {
 		log.Printf("[WARN] NetworkFirewall Resource Policy (for resource: %s) not found, removing from state", resourceArn)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
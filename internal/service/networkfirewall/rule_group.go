@@ -451,6 +451,7 @@ func resourceRuleGroupRead(ctx context.Context, d *schema.ResourceData, meta int
 	output, err := FindRuleGroup(ctx, conn, d.Id())
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, networkfirewall.ErrCodeResourceNotFoundException) {
 		log.Printf("[WARN] NetworkFirewall Rule Group (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -268,6 +268,7 @@ func resourceApplicationRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, opsworks.ErrCodeResourceNotFoundException, "") {
 			log.Printf("[INFO] App not found: %s", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
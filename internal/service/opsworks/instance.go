@@ -478,6 +478,7 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	resp, err := client.DescribeInstances(req)
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, opsworks.ErrCodeResourceNotFoundException, "") {
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -486,12 +487,14 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 
 	// If nothing was found, then return no state
 	if len(resp.Instances) == 0 {
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
 	instance := resp.Instances[0]
 
 	if instance.InstanceId == nil {
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
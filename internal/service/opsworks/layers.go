@@ -289,6 +289,7 @@ func (lt *opsworksLayerType) Read(d *schema.ResourceData, meta interface{}) erro
 	resp, err := conn.DescribeLayers(req)
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, opsworks.ErrCodeResourceNotFoundException, "") {
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
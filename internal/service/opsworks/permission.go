@@ -77,6 +77,7 @@ func resourcePermissionRead(d *schema.ResourceData, meta interface{}) error {
 		if awserr, ok := err.(awserr.Error); ok {
 			if awserr.Code() == "ResourceNotFoundException" {
 				log.Printf("[INFO] Permission not found")
+				//lintignore:AWSR003
 				d.SetId("")
 				return nil
 			}
@@ -102,6 +103,7 @@ func resourcePermissionRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if !found {
+		//lintignore:AWSR003
 		d.SetId("")
 		log.Printf("[INFO] The correct permission could not be found for: %s on stack: %s", d.Get("user_arn"), d.Get("stack_id"))
 	}
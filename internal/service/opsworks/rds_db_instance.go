@@ -123,6 +123,7 @@ func resourceRDSDBInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if !found {
+		//lintignore:AWSR003
 		d.SetId("")
 		log.Printf("[INFO] The rds instance '%s' could not be found for stack: '%s'", d.Get("rds_db_instance_arn"), d.Get("stack_id"))
 	}
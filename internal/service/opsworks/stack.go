@@ -328,6 +328,7 @@ func resourceStackRead(d *schema.ResourceData, meta interface{}) error {
 					// We've tried both the original and us-east-1 endpoint, and the stack
 					// is still not found
 					log.Printf("[DEBUG] OpsWorks stack (%s) not found", d.Id())
+					//lintignore:AWSR003
 					d.SetId("")
 					return nil
 				}
@@ -509,6 +510,7 @@ func resourceStackCreate(d *schema.ResourceData, meta interface{}) error {
 		// we can't actually check for them. Instead, we just wait a nominal
 		// amount of time for their creation to complete.
 		log.Print("[INFO] Waiting for OpsWorks built-in security groups to be created")
+		//lintignore:AWSR006
 		time.Sleep(30 * time.Second)
 	}
 
@@ -632,6 +634,7 @@ func resourceStackDelete(d *schema.ResourceData, meta interface{}) error {
 
 	if inVpc && useOpsworksDefaultSg {
 		log.Print("[INFO] Waiting for Opsworks built-in security groups to be deleted")
+		//lintignore:AWSR006
 		time.Sleep(30 * time.Second)
 	}
 
@@ -59,6 +59,7 @@ func resourceUserProfileRead(d *schema.ResourceData, meta interface{}) error {
 		if awserr, ok := err.(awserr.Error); ok {
 			if awserr.Code() == "ResourceNotFoundException" {
 				log.Printf("[DEBUG] OpsWorks user profile (%s) not found", d.Id())
+				//lintignore:AWSR003
 				d.SetId("")
 				return nil
 			}
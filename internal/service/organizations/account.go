@@ -196,6 +196,7 @@ func resourceAccountRead(d *schema.ResourceData, meta interface{}) error {
 
 	if tfawserr.ErrMessageContains(err, organizations.ErrCodeAccountNotFoundException, "") {
 		log.Printf("[WARN] Account does not exist, removing from state: %s", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -207,6 +208,7 @@ func resourceAccountRead(d *schema.ResourceData, meta interface{}) error {
 	account := resp.Account
 	if account == nil {
 		log.Printf("[WARN] Account does not exist, removing from state: %s", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -115,6 +115,7 @@ func resourceDelegatedAdministratorRead(ctx context.Context, d *schema.ResourceD
 
 	if delegatedAccount == nil {
 		log.Printf("[WARN] AWS Organization DelegatedAdministrators not found (%s), removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
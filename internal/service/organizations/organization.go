@@ -235,6 +235,7 @@ func resourceOrganizationRead(d *schema.ResourceData, meta interface{}) error {
 
 	if tfawserr.ErrMessageContains(err, organizations.ErrCodeAWSOrganizationsNotInUseException, "") {
 		log.Printf("[WARN] Organization does not exist, removing from state: %s", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
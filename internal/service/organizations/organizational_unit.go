@@ -129,6 +129,7 @@ func resourceOrganizationalUnitRead(d *schema.ResourceData, meta interface{}) er
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, organizations.ErrCodeOrganizationalUnitNotFoundException) {
 		log.Printf("[WARN] Organizations Organizational Unit (%s) does not exist, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -148,6 +149,7 @@ func resourceOrganizationalUnitRead(d *schema.ResourceData, meta interface{}) er
 		}
 
 		log.Printf("[WARN] Organizations Organizational Unit (%s) does not exist, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
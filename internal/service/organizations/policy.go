@@ -123,6 +123,7 @@ func resourcePolicyRead(ctx context.Context, d *schema.ResourceData, meta interf
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, organizations.ErrCodePolicyNotFoundException, "") {
 			log.Printf("[WARN] Organizations policy does not exist, removing from state: %s", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -131,6 +132,7 @@ func resourcePolicyRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 	if resp.Policy == nil || resp.Policy.PolicySummary == nil {
 		log.Printf("[WARN] Organizations policy does not exist, removing from state: %s", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -107,6 +107,7 @@ func resourcePolicyAttachmentRead(d *schema.ResourceData, meta interface{}) erro
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, organizations.ErrCodeTargetNotFoundException, "") {
 			log.Printf("[WARN] Target does not exist, removing from state: %s", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -115,6 +116,7 @@ func resourcePolicyAttachmentRead(d *schema.ResourceData, meta interface{}) erro
 
 	if output == nil {
 		log.Printf("[WARN] Attachment does not exist, removing from state: %s", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
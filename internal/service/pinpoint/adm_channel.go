@@ -83,6 +83,7 @@ func resourceADMChannelRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, pinpoint.ErrCodeNotFoundException, "") {
 			log.Printf("[WARN] Pinpoint ADM Channel for application %s not found, error code (404)", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
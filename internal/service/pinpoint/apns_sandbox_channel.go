@@ -127,6 +127,7 @@ func resourceAPNSSandboxChannelRead(d *schema.ResourceData, meta interface{}) er
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, pinpoint.ErrCodeNotFoundException, "") {
 			log.Printf("[WARN] Pinpoint APNs Sandbox Channel for application %s not found, error code (404)", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
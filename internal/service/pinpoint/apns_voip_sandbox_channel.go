@@ -127,6 +127,7 @@ func resourceAPNSVoIPSandboxChannelRead(d *schema.ResourceData, meta interface{}
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, pinpoint.ErrCodeNotFoundException, "") {
 			log.Printf("[WARN] Pinpoint APNs Voip Sandbox Channel for application %s not found, error code (404)", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -49,6 +49,7 @@ func resourceWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta int
 	})
 	if tfawserr.ErrCodeEquals(err, prometheusservice.ErrCodeResourceNotFoundException) {
 		log.Printf("[WARN] Prometheus Workspace (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -132,6 +132,7 @@ func resourceLedgerRead(d *schema.ResourceData, meta interface{}) error {
 
 	if tfawserr.ErrMessageContains(err, qldb.ErrCodeResourceNotFoundException, "") {
 		log.Printf("[WARN] QLDB Ledger (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
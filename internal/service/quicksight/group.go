@@ -108,6 +108,7 @@ func resourceGroupRead(d *schema.ResourceData, meta interface{}) error {
 	resp, err := conn.DescribeGroup(descOpts)
 	if tfawserr.ErrMessageContains(err, quicksight.ErrCodeResourceNotFoundException, "") {
 		log.Printf("[WARN] QuickSight Group %s is already gone", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -113,6 +113,7 @@ func resourceGroupMembershipRead(ctx context.Context, d *schema.ResourceData, me
 
 	if !found {
 		log.Printf("[WARN] QuickSight User-group membership %s is not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
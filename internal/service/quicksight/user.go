@@ -149,6 +149,7 @@ func resourceUserRead(d *schema.ResourceData, meta interface{}) error {
 	resp, err := conn.DescribeUser(descOpts)
 	if tfawserr.ErrMessageContains(err, quicksight.ErrCodeResourceNotFoundException, "") {
 		log.Printf("[WARN] QuickSight User %s is not found", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
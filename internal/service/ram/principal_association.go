@@ -98,6 +98,7 @@ func resourcePrincipalAssociationRead(d *schema.ResourceData, meta interface{})
 
 	if !d.IsNewResource() && (tfawserr.ErrCodeEquals(err, ram.ErrCodeResourceArnNotFoundException) || tfawserr.ErrCodeEquals(err, ram.ErrCodeUnknownResourceException)) {
 		log.Printf("[WARN] No RAM resource share principal association with ARN (%s) found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -108,6 +109,7 @@ func resourcePrincipalAssociationRead(d *schema.ResourceData, meta interface{})
 
 	if association == nil || aws.StringValue(association.Status) == ram.ResourceShareAssociationStatusDisassociated {
 		log.Printf("[WARN] RAM resource share principal association with ARN (%s) found, but empty or disassociated - removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
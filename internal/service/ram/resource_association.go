@@ -82,12 +82,14 @@ func resourceResourceAssociationRead(d *schema.ResourceData, meta interface{}) e
 
 	if resourceShareAssociation == nil {
 		log.Printf("[WARN] RAM Resource Share (%s) Resource Association (%s) not found, removing from state", resourceShareARN, resourceARN)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
 
 	if aws.StringValue(resourceShareAssociation.Status) != ram.ResourceShareAssociationStatusAssociated {
 		log.Printf("[WARN] RAM Resource Share (%s) Resource Association (%s) not associated, removing from state", resourceShareARN, resourceARN)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
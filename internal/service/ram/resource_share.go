@@ -99,6 +99,7 @@ func resourceResourceShareRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, ram.ErrCodeUnknownResourceException, "") {
 			log.Printf("[WARN] No RAM resource share by ARN (%s) found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -107,6 +108,7 @@ func resourceResourceShareRead(d *schema.ResourceData, meta interface{}) error {
 
 	if len(output.ResourceShares) == 0 {
 		log.Printf("[WARN] No RAM resource share by ARN (%s) found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -115,6 +117,7 @@ func resourceResourceShareRead(d *schema.ResourceData, meta interface{}) error {
 
 	if aws.StringValue(resourceShare.Status) != ram.ResourceShareStatusActive {
 		log.Printf("[WARN] RAM resource share (%s) delet(ing|ed), removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -146,6 +146,7 @@ func resourceResourceShareAccepterRead(d *schema.ResourceData, meta interface{})
 
 	if !d.IsNewResource() && (tfawserr.ErrCodeEquals(err, ram.ErrCodeResourceArnNotFoundException) || tfawserr.ErrCodeEquals(err, ram.ErrCodeUnknownResourceException)) {
 		log.Printf("[WARN] No RAM resource share with ARN (%s) found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -1013,6 +1013,7 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 
 	if tfawserr.ErrMessageContains(err, rds.ErrCodeDBClusterNotFoundFault, "") {
 		log.Printf("[WARN] RDS Cluster (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -1035,6 +1036,7 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 
 	if dbc == nil {
 		log.Printf("[WARN] RDS Cluster (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -151,6 +151,7 @@ func resourceClusterEndpointRead(d *schema.ResourceData, meta interface{}) error
 
 	if clusterEp == nil {
 		log.Printf("[WARN] RDS Cluster Endpoint (%s) not found", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
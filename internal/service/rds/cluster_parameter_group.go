@@ -142,6 +142,7 @@ func resourceClusterParameterGroupRead(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "DBParameterGroupNotFound" {
 			log.Printf("[WARN] DB Cluster Parameter Group (%s) not found, error code (404)", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
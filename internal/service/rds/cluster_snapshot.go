@@ -175,6 +175,7 @@ func resourceClusterSnapshotRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, rds.ErrCodeDBClusterSnapshotNotFoundFault, "") {
 			log.Printf("[WARN] RDS DB Cluster Snapshot %q not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -183,6 +184,7 @@ func resourceClusterSnapshotRead(d *schema.ResourceData, meta interface{}) error
 
 	if resp == nil || len(resp.DBClusterSnapshots) == 0 || resp.DBClusterSnapshots[0] == nil || aws.StringValue(resp.DBClusterSnapshots[0].DBClusterSnapshotIdentifier) != d.Id() {
 		log.Printf("[WARN] RDS DB Cluster Snapshot %q not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -167,6 +167,7 @@ func resourceGlobalClusterRead(d *schema.ResourceData, meta interface{}) error {
 
 	if tfawserr.ErrMessageContains(err, rds.ErrCodeGlobalClusterNotFoundFault, "") {
 		log.Printf("[WARN] RDS Global Cluster (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -177,12 +178,14 @@ func resourceGlobalClusterRead(d *schema.ResourceData, meta interface{}) error {
 
 	if globalCluster == nil {
 		log.Printf("[WARN] RDS Global Cluster (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
 
 	if aws.StringValue(globalCluster.Status) == "deleting" || aws.StringValue(globalCluster.Status) == "deleted" {
 		log.Printf("[WARN] RDS Global Cluster (%s) in deleted state (%s), removing from state", d.Id(), aws.StringValue(globalCluster.Status))
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -94,6 +94,7 @@ func resourceInstanceRoleAssociationRead(d *schema.ResourceData, meta interface{
 
 	if tfawserr.ErrMessageContains(err, rds.ErrCodeDBInstanceNotFoundFault, "") {
 		log.Printf("[WARN] RDS DB Instance (%s) not found, removing from state", dbInstanceIdentifier)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -104,6 +105,7 @@ func resourceInstanceRoleAssociationRead(d *schema.ResourceData, meta interface{
 
 	if dbInstanceRole == nil {
 		log.Printf("[WARN] RDS DB Instance (%s) IAM Role (%s) association not found, removing from state", dbInstanceIdentifier, roleArn)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
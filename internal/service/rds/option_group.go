@@ -181,6 +181,7 @@ func resourceOptionGroupRead(d *schema.ResourceData, meta interface{}) error {
 
 	if tfawserr.ErrMessageContains(err, rds.ErrCodeOptionGroupNotFoundFault, "") {
 		log.Printf("[WARN] RDS Option Group (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -199,6 +200,7 @@ func resourceOptionGroupRead(d *schema.ResourceData, meta interface{}) error {
 
 	if option == nil {
 		log.Printf("[WARN] RDS Option Group (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
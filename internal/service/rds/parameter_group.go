@@ -140,6 +140,7 @@ func resourceParameterGroupRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, rds.ErrCodeDBParameterGroupNotFoundFault, "") {
 			log.Printf("[WARN] DB Parameter Group (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -262,6 +262,7 @@ func resourceProxyRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, rds.ErrCodeDBProxyNotFoundFault, "") {
 			log.Printf("[WARN] DB Proxy (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -281,6 +282,7 @@ func resourceProxyRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	if dbProxy == nil {
 		log.Printf("[WARN] DB Proxy (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
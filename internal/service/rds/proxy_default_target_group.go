@@ -101,6 +101,7 @@ func resourceProxyDefaultTargetGroupRead(d *schema.ResourceData, meta interface{
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, rds.ErrCodeDBProxyNotFoundFault, "") {
 			log.Printf("[WARN] DB Proxy (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -109,6 +110,7 @@ func resourceProxyDefaultTargetGroupRead(d *schema.ResourceData, meta interface{
 
 	if tg == nil {
 		log.Printf("[WARN] DB Proxy default target group (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
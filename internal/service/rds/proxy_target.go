@@ -134,12 +134,14 @@ func resourceProxyTargetRead(d *schema.ResourceData, meta interface{}) error {
 
 	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBProxyNotFoundFault) {
 		log.Printf("[WARN] RDS DB Proxy Target (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
 
 	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBProxyTargetGroupNotFoundFault) {
 		log.Printf("[WARN] RDS DB Proxy Target (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -150,6 +152,7 @@ func resourceProxyTargetRead(d *schema.ResourceData, meta interface{}) error {
 
 	if dbProxyTarget == nil {
 		log.Printf("[WARN] RDS DB Proxy Target (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -165,6 +165,7 @@ func resourceSnapshotRead(d *schema.ResourceData, meta interface{}) error {
 
 	if tfawserr.ErrMessageContains(err, rds.ErrCodeDBSnapshotNotFoundFault, "") {
 		log.Printf("[WARN] AWS DB Snapshot (%s) is already gone", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -121,7 +121,7 @@ func resourceSubnetGroupRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if ec2err, ok := err.(awserr.Error); ok && ec2err.Code() == "DBSubnetGroupNotFoundFault" {
 			// Update state to indicate the db subnet no longer exists.
-			d.SetId("")
+			d.SetId("") //lintignore:AWSR003
 			return nil
 		}
 		return err
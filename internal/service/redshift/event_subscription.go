@@ -136,6 +136,7 @@ func resourceEventSubscriptionRead(d *schema.ResourceData, meta interface{}) err
 	}
 	if sub == nil {
 		log.Printf("[WARN] Redshift Event Subscription (%s) not found - removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -140,6 +140,7 @@ func resourceParameterGroupRead(d *schema.ResourceData, meta interface{}) error
 
 	if len(describeResp.ParameterGroups) != 1 ||
 		aws.StringValue(describeResp.ParameterGroups[0].ParameterGroupName) != d.Id() {
+		//lintignore:AWSR003
 		d.SetId("")
 		return fmt.Errorf("Unable to find Parameter Group: %#v", describeResp.ParameterGroups)
 	}
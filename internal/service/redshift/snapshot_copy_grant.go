@@ -117,6 +117,7 @@ func resourceSnapshotCopyGrantRead(d *schema.ResourceData, meta interface{}) err
 	grant, err := findSnapshotCopyGrant(conn, grantName)
 	if tfawserr.ErrMessageContains(err, redshift.ErrCodeSnapshotCopyGrantNotFoundFault, "") || grant == nil {
 		log.Printf("[WARN] snapshot copy grant (%s) not found, removing from state", grantName)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -120,6 +120,7 @@ func resourceSnapshotScheduleRead(d *schema.ResourceData, meta interface{}) erro
 
 	if resp.SnapshotSchedules == nil || len(resp.SnapshotSchedules) != 1 {
 		log.Printf("[WARN] Unable to find Redshift Cluster Snapshot Schedule (%s)", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
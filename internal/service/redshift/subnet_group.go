@@ -106,6 +106,7 @@ func resourceSubnetGroupRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, "ClusterSubnetGroupNotFoundFault", "") {
 			log.Printf("[INFO] Redshift Subnet Group: %s was not found", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -116,6 +116,7 @@ func resourceGroupRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, resourcegroups.ErrCodeNotFoundException, "") {
 			log.Printf("[WARN] Resource Groups Group (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -81,6 +81,7 @@ func resourceDelegationSetRead(d *schema.ResourceData, meta interface{}) error {
 	out, err := r53.GetReusableDelegationSet(input)
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, route53.ErrCodeNoSuchDelegationSet, "") {
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 
@@ -24,6 +24,10 @@ func FindHealthCheckByID(conn *route53.Route53, id string) (*route53.HealthCheck
 		}
 	}
 
+	if err != nil {
+		return nil, err
+	}
+
 	if output == nil || output.HealthCheck == nil || output.HealthCheck.HealthCheckConfig == nil {
 		return nil, tfresource.NewEmptyResultError(input)
 	}
@@ -308,7 +308,9 @@ func resourceHealthCheckRead(d *schema.ResourceData, meta interface{}) error {
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
-	output, err := FindHealthCheckByID(conn, d.Id())
+	outputRaw, err := tfresource.ReadAfterWrite(meta.(*conns.AWSClient).ReadAfterWritePolicy(conns.PropagationRoute53, healthCheckPropagationTimeout), func() (interface{}, error) {
+		return FindHealthCheckByID(conn, d.Id())
+	}, d.IsNewResource())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Route53 Health Check (%s) not found, removing from state", d.Id())
@@ -320,6 +322,8 @@ func resourceHealthCheckRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error reading Route53 Health Check (%s): %w", d.Id(), err)
 	}
 
+	output := outputRaw.(*route53.HealthCheck)
+
 	healthCheckConfig := output.HealthCheckConfig
 	d.Set("type", healthCheckConfig.Type)
 	d.Set("failure_threshold", healthCheckConfig.FailureThreshold)
@@ -535,6 +535,7 @@ func resourceRecordRead(d *schema.ResourceData, meta interface{}) error {
 		switch err {
 		case r53NoHostedZoneFound, r53NoRecordsFound:
 			log.Printf("[DEBUG] %s for: %s, removing from state file", err, d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		default:
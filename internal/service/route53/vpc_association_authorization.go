@@ -89,6 +89,7 @@ func resourceVPCAssociationAuthorizationRead(d *schema.ResourceData, meta interf
 
 		if tfawserr.ErrMessageContains(err, route53.ErrCodeNoSuchHostedZone, "") {
 			log.Printf("[WARN] Route53 VPC Association Authorization (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -116,6 +117,7 @@ func resourceVPCAssociationAuthorizationRead(d *schema.ResourceData, meta interf
 
 	// no association found
 	log.Printf("[WARN] Route53 VPC Association Authorization (%s) not found, removing from state", d.Id())
+	//lintignore:AWSR003
 	d.SetId("")
 	return nil
 }
@@ -18,6 +18,8 @@ const (
 	changeMinDelay     = 10
 	changeMaxDelay     = 30
 
+	healthCheckPropagationTimeout = 2 * time.Minute
+
 	hostedZoneDNSSECStatusTimeout = 5 * time.Minute
 
 	keySigningKeyStatusTimeout = 5 * time.Minute
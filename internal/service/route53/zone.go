@@ -187,6 +187,7 @@ func resourceZoneRead(d *schema.ResourceData, meta interface{}) error {
 
 	if tfawserr.ErrMessageContains(err, route53.ErrCodeNoSuchHostedZone, "") {
 		log.Printf("[WARN] Route53 Hosted Zone (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -197,6 +198,7 @@ func resourceZoneRead(d *schema.ResourceData, meta interface{}) error {
 
 	if output == nil || output.HostedZone == nil {
 		log.Printf("[WARN] Route53 Hosted Zone (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -119,6 +119,7 @@ func resourceZoneAssociationRead(d *schema.ResourceData, meta interface{}) error
 
 	if tfawserr.ErrMessageContains(err, "AccessDenied", "is not owned by you") && !d.IsNewResource() {
 		log.Printf("[WARN] Route 53 Zone Association (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -133,6 +134,7 @@ func resourceZoneAssociationRead(d *schema.ResourceData, meta interface{}) error
 		}
 
 		log.Printf("[WARN] Route 53 Hosted Zone (%s) Association (%s) not found, removing from state", zoneID, vpcID)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
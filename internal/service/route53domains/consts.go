@@ -0,0 +1,13 @@
+package route53domains
+
+import (
+	"time"
+)
+
+const (
+	registeredDomainDefaultCreateTimeout = 30 * time.Minute
+	registeredDomainDefaultUpdateTimeout = 30 * time.Minute
+)
+
+// The EPP status code that is present while the domain is locked against transfers.
+const domainStatusClientTransferProhibited = "clientTransferProhibited"
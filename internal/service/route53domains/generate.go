@@ -0,0 +1,5 @@
+//go:generate go run -tags generate ../../generate/tags/main.go -ListTags=yes -ListTagsInIDElem=DomainName -ListTagsOp=ListTagsForDomain -ListTagsOutTagsElem=TagList -ServiceTagsSlice=yes -TagInIDElem=DomainName -TagInTagsElem=TagsToUpdate -TagOp=UpdateTagsForDomain -UntagInTagsElem=TagsToDelete -UntagOp=DeleteTagsForDomain -UpdateTags=yes
+//go:generate go run -tags generate ../../generate/waiters/main.go
+// ONLY generate directives and package declaration! Do not add anything else to this file.
+
+package route53domains
@@ -0,0 +1,536 @@
+package route53domains
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53domains"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceRegisteredDomain() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRegisteredDomainCreate,
+		ReadContext:   resourceRegisteredDomainRead,
+		UpdateContext: resourceRegisteredDomainUpdate,
+		DeleteContext: resourceRegisteredDomainDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(registeredDomainDefaultCreateTimeout),
+			Update: schema.DefaultTimeout(registeredDomainDefaultUpdateTimeout),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"abuse_contact_email": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"abuse_contact_phone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"admin_privacy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"auto_renew": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"expiration_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name_server": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 6,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"glue_ips": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 2,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.IsIPAddress,
+							},
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+					},
+				},
+			},
+			"registrant_privacy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"registrar_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"registrar_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"reseller": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_list": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"tech_privacy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"transfer_lock": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"updated_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"whois_server": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceRegisteredDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53DomainsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	// The domain must already be registered. Adopt it and bring its settings in line with the configuration.
+	domainName := d.Get("domain_name").(string)
+	domainDetail, err := FindDomainDetailByName(ctx, conn, domainName)
+
+	if err != nil {
+		return diag.Errorf("error reading Route 53 Domains Domain (%s): %s", domainName, err)
+	}
+
+	d.SetId(aws.StringValue(domainDetail.DomainName))
+
+	adminPrivacy := d.Get("admin_privacy").(bool)
+	registrantPrivacy := d.Get("registrant_privacy").(bool)
+	techPrivacy := d.Get("tech_privacy").(bool)
+
+	if adminPrivacy != aws.BoolValue(domainDetail.AdminPrivacy) || registrantPrivacy != aws.BoolValue(domainDetail.RegistrantPrivacy) || techPrivacy != aws.BoolValue(domainDetail.TechPrivacy) {
+		if err := modifyDomainContactPrivacy(ctx, conn, d.Id(), adminPrivacy, registrantPrivacy, techPrivacy, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if v := d.Get("auto_renew").(bool); v != aws.BoolValue(domainDetail.AutoRenew) {
+		if err := modifyDomainAutoRenew(ctx, conn, d.Id(), v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if v, ok := d.GetOk("name_server"); ok && len(v.([]interface{})) > 0 {
+		nameservers := expandNameservers(v.([]interface{}))
+
+		if !nameserversEqual(nameservers, domainDetail.Nameservers) {
+			if err := modifyDomainNameservers(ctx, conn, d.Id(), nameservers, d.Timeout(schema.TimeoutCreate)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	if v := d.Get("transfer_lock").(bool); v != hasDomainTransferLock(domainDetail.StatusList) {
+		if err := modifyDomainTransferLock(ctx, conn, d.Id(), v, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	oldTags, err := ListTags(conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("error listing tags for Route 53 Domains Domain (%s): %s", d.Id(), err)
+	}
+
+	if newTags := tags.IgnoreAWS(); !oldTags.IgnoreAWS().Equal(newTags) {
+		if err := UpdateTags(conn, d.Id(), oldTags, newTags); err != nil {
+			return diag.Errorf("error updating Route 53 Domains Domain (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceRegisteredDomainRead(ctx, d, meta)
+}
+
+func resourceRegisteredDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53DomainsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	domainDetail, err := FindDomainDetailByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route 53 Domains Domain (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Route 53 Domains Domain (%s): %s", d.Id(), err)
+	}
+
+	d.Set("abuse_contact_email", domainDetail.AbuseContactEmail)
+	d.Set("abuse_contact_phone", domainDetail.AbuseContactPhone)
+	d.Set("admin_privacy", domainDetail.AdminPrivacy)
+	d.Set("auto_renew", domainDetail.AutoRenew)
+	d.Set("creation_date", flattenTime(domainDetail.CreationDate))
+	d.Set("domain_name", domainDetail.DomainName)
+	d.Set("expiration_date", flattenTime(domainDetail.ExpirationDate))
+	d.Set("registrant_privacy", domainDetail.RegistrantPrivacy)
+	d.Set("registrar_name", domainDetail.RegistrarName)
+	d.Set("registrar_url", domainDetail.RegistrarUrl)
+	d.Set("reseller", domainDetail.Reseller)
+	d.Set("tech_privacy", domainDetail.TechPrivacy)
+	d.Set("transfer_lock", hasDomainTransferLock(domainDetail.StatusList))
+	d.Set("updated_date", flattenTime(domainDetail.UpdatedDate))
+	d.Set("whois_server", domainDetail.WhoIsServer)
+
+	if err := d.Set("name_server", flattenNameservers(domainDetail.Nameservers)); err != nil {
+		return diag.Errorf("error setting name_server: %s", err)
+	}
+
+	if err := d.Set("status_list", aws.StringValueSlice(domainDetail.StatusList)); err != nil {
+		return diag.Errorf("error setting status_list: %s", err)
+	}
+
+	tags, err := ListTags(conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("error listing tags for Route 53 Domains Domain (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceRegisteredDomainUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Route53DomainsConn
+
+	if d.HasChanges("admin_privacy", "registrant_privacy", "tech_privacy") {
+		if err := modifyDomainContactPrivacy(ctx, conn, d.Id(), d.Get("admin_privacy").(bool), d.Get("registrant_privacy").(bool), d.Get("tech_privacy").(bool), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("auto_renew") {
+		if err := modifyDomainAutoRenew(ctx, conn, d.Id(), d.Get("auto_renew").(bool)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("name_server") {
+		if v, ok := d.GetOk("name_server"); ok && len(v.([]interface{})) > 0 {
+			if err := modifyDomainNameservers(ctx, conn, d.Id(), expandNameservers(v.([]interface{})), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	if d.HasChange("transfer_lock") {
+		if err := modifyDomainTransferLock(ctx, conn, d.Id(), d.Get("transfer_lock").(bool), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("error updating Route 53 Domains Domain (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceRegisteredDomainRead(ctx, d, meta)
+}
+
+func resourceRegisteredDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[WARN] Route 53 Domains Registered Domain (%s) not deleted, removing from state", d.Id())
+
+	return nil
+}
+
+func modifyDomainAutoRenew(ctx context.Context, conn *route53domains.Route53Domains, domainName string, autoRenew bool) error {
+	if autoRenew {
+		input := &route53domains.EnableDomainAutoRenewInput{
+			DomainName: aws.String(domainName),
+		}
+
+		log.Printf("[DEBUG] Enabling Route 53 Domains Domain auto-renew: %s", input)
+		_, err := conn.EnableDomainAutoRenewWithContext(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("error enabling Route 53 Domains Domain (%s) auto-renew: %w", domainName, err)
+		}
+	} else {
+		input := &route53domains.DisableDomainAutoRenewInput{
+			DomainName: aws.String(domainName),
+		}
+
+		log.Printf("[DEBUG] Disabling Route 53 Domains Domain auto-renew: %s", input)
+		_, err := conn.DisableDomainAutoRenewWithContext(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("error disabling Route 53 Domains Domain (%s) auto-renew: %w", domainName, err)
+		}
+	}
+
+	return nil
+}
+
+func modifyDomainContactPrivacy(ctx context.Context, conn *route53domains.Route53Domains, domainName string, adminPrivacy, registrantPrivacy, techPrivacy bool, timeout time.Duration) error {
+	input := &route53domains.UpdateDomainContactPrivacyInput{
+		AdminPrivacy:      aws.Bool(adminPrivacy),
+		DomainName:        aws.String(domainName),
+		RegistrantPrivacy: aws.Bool(registrantPrivacy),
+		TechPrivacy:       aws.Bool(techPrivacy),
+	}
+
+	log.Printf("[DEBUG] Updating Route 53 Domains Domain contact privacy: %s", input)
+	output, err := conn.UpdateDomainContactPrivacyWithContext(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Route 53 Domains Domain (%s) contact privacy: %w", domainName, err)
+	}
+
+	if _, err := waitOperationSucceeded(ctx, conn, aws.StringValue(output.OperationId), timeout); err != nil {
+		return fmt.Errorf("error waiting for Route 53 Domains Domain (%s) contact privacy update: %w", domainName, err)
+	}
+
+	return nil
+}
+
+func modifyDomainNameservers(ctx context.Context, conn *route53domains.Route53Domains, domainName string, nameservers []*route53domains.Nameserver, timeout time.Duration) error {
+	input := &route53domains.UpdateDomainNameserversInput{
+		DomainName:  aws.String(domainName),
+		Nameservers: nameservers,
+	}
+
+	log.Printf("[DEBUG] Updating Route 53 Domains Domain name servers: %s", input)
+	output, err := conn.UpdateDomainNameserversWithContext(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Route 53 Domains Domain (%s) name servers: %w", domainName, err)
+	}
+
+	if _, err := waitOperationSucceeded(ctx, conn, aws.StringValue(output.OperationId), timeout); err != nil {
+		return fmt.Errorf("error waiting for Route 53 Domains Domain (%s) name servers update: %w", domainName, err)
+	}
+
+	return nil
+}
+
+func modifyDomainTransferLock(ctx context.Context, conn *route53domains.Route53Domains, domainName string, transferLock bool, timeout time.Duration) error {
+	var operationID string
+
+	if transferLock {
+		input := &route53domains.EnableDomainTransferLockInput{
+			DomainName: aws.String(domainName),
+		}
+
+		log.Printf("[DEBUG] Enabling Route 53 Domains Domain transfer lock: %s", input)
+		output, err := conn.EnableDomainTransferLockWithContext(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("error enabling Route 53 Domains Domain (%s) transfer lock: %w", domainName, err)
+		}
+
+		operationID = aws.StringValue(output.OperationId)
+	} else {
+		input := &route53domains.DisableDomainTransferLockInput{
+			DomainName: aws.String(domainName),
+		}
+
+		log.Printf("[DEBUG] Disabling Route 53 Domains Domain transfer lock: %s", input)
+		output, err := conn.DisableDomainTransferLockWithContext(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("error disabling Route 53 Domains Domain (%s) transfer lock: %w", domainName, err)
+		}
+
+		operationID = aws.StringValue(output.OperationId)
+	}
+
+	if _, err := waitOperationSucceeded(ctx, conn, operationID, timeout); err != nil {
+		return fmt.Errorf("error waiting for Route 53 Domains Domain (%s) transfer lock update: %w", domainName, err)
+	}
+
+	return nil
+}
+
+func flattenTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return aws.TimeValue(t).Format(time.RFC3339)
+}
+
+func hasDomainTransferLock(statusList []*string) bool {
+	for _, v := range statusList {
+		if aws.StringValue(v) == domainStatusClientTransferProhibited {
+			return true
+		}
+	}
+
+	return false
+}
+
+// nameserversEqual returns whether the two lists contain the same name servers, in order, with the same glue IPs.
+func nameserversEqual(ns1, ns2 []*route53domains.Nameserver) bool {
+	if len(ns1) != len(ns2) {
+		return false
+	}
+
+	for i, n1 := range ns1 {
+		n2 := ns2[i]
+
+		if aws.StringValue(n1.Name) != aws.StringValue(n2.Name) {
+			return false
+		}
+
+		if !flex.FlattenStringSet(n1.GlueIps).Equal(flex.FlattenStringSet(n2.GlueIps)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func expandNameserver(tfMap map[string]interface{}) *route53domains.Nameserver {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &route53domains.Nameserver{}
+
+	if v, ok := tfMap["glue_ips"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.GlueIps = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandNameservers(tfList []interface{}) []*route53domains.Nameserver {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*route53domains.Nameserver
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandNameserver(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenNameserver(apiObject *route53domains.Nameserver) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.GlueIps; v != nil {
+		tfMap["glue_ips"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.Name; v != nil {
+		tfMap["name"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenNameservers(apiObjects []*route53domains.Nameserver) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenNameserver(apiObject))
+	}
+
+	return tfList
+}
@@ -0,0 +1,306 @@
+package route53domains_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53domains"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfroute53domains "github.com/hashicorp/terraform-provider-aws/internal/service/route53domains"
+)
+
+const (
+	EnvVarRoute53DomainsDomainName             = "ROUTE53DOMAINS_DOMAIN_NAME"
+	EnvVarRoute53DomainsDomainNameMessageError = "Environment variable ROUTE53DOMAINS_DOMAIN_NAME is not set. " +
+		"To test Route 53 Domains registered domains, the name of a domain registered in the account must be provided."
+)
+
+// All tests modify the settings of the same registered domain, so they must not run in parallel.
+func TestAccRoute53DomainsRegisteredDomain_serial(t *testing.T) {
+	testCases := map[string]func(t *testing.T){
+		"basic":          testAccRegisteredDomain_basic,
+		"tags":           testAccRegisteredDomain_tags,
+		"autoRenew":      testAccRegisteredDomain_autoRenew,
+		"contactPrivacy": testAccRegisteredDomain_contactPrivacy,
+		"transferLock":   testAccRegisteredDomain_transferLock,
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			tc(t)
+		})
+	}
+}
+
+func testAccRegisteredDomain_basic(t *testing.T) {
+	domainName := conns.SkipIfEnvVarEmpty(t, EnvVarRoute53DomainsDomainName, EnvVarRoute53DomainsDomainNameMessageError)
+	resourceName := "aws_route53domains_registered_domain.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, route53domains.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegisteredDomainConfig(domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRegisteredDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "admin_privacy", "true"),
+					resource.TestCheckResourceAttr(resourceName, "auto_renew", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date"),
+					resource.TestCheckResourceAttr(resourceName, "domain_name", domainName),
+					resource.TestCheckResourceAttrSet(resourceName, "expiration_date"),
+					resource.TestCheckResourceAttrSet(resourceName, "name_server.#"),
+					resource.TestCheckResourceAttr(resourceName, "registrant_privacy", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "registrar_name"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tech_privacy", "true"),
+					resource.TestCheckResourceAttr(resourceName, "transfer_lock", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccRegisteredDomain_tags(t *testing.T) {
+	domainName := conns.SkipIfEnvVarEmpty(t, EnvVarRoute53DomainsDomainName, EnvVarRoute53DomainsDomainNameMessageError)
+	resourceName := "aws_route53domains_registered_domain.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, route53domains.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegisteredDomainConfigTags1(domainName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRegisteredDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccRegisteredDomainConfigTags2(domainName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRegisteredDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccRegisteredDomainConfigTags1(domainName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRegisteredDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccRegisteredDomainConfig(domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRegisteredDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRegisteredDomain_autoRenew(t *testing.T) {
+	domainName := conns.SkipIfEnvVarEmpty(t, EnvVarRoute53DomainsDomainName, EnvVarRoute53DomainsDomainNameMessageError)
+	resourceName := "aws_route53domains_registered_domain.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, route53domains.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegisteredDomainConfigAutoRenew(domainName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRegisteredDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_renew", "false"),
+				),
+			},
+			{
+				Config: testAccRegisteredDomainConfigAutoRenew(domainName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRegisteredDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_renew", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRegisteredDomain_contactPrivacy(t *testing.T) {
+	domainName := conns.SkipIfEnvVarEmpty(t, EnvVarRoute53DomainsDomainName, EnvVarRoute53DomainsDomainNameMessageError)
+	resourceName := "aws_route53domains_registered_domain.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, route53domains.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegisteredDomainConfigContactPrivacy(domainName, true, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRegisteredDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "admin_privacy", "true"),
+					resource.TestCheckResourceAttr(resourceName, "registrant_privacy", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tech_privacy", "true"),
+				),
+			},
+			{
+				Config: testAccRegisteredDomainConfigContactPrivacy(domainName, true, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRegisteredDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "admin_privacy", "true"),
+					resource.TestCheckResourceAttr(resourceName, "registrant_privacy", "true"),
+					resource.TestCheckResourceAttr(resourceName, "tech_privacy", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRegisteredDomain_transferLock(t *testing.T) {
+	domainName := conns.SkipIfEnvVarEmpty(t, EnvVarRoute53DomainsDomainName, EnvVarRoute53DomainsDomainNameMessageError)
+	resourceName := "aws_route53domains_registered_domain.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, route53domains.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegisteredDomainConfigTransferLock(domainName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRegisteredDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "transfer_lock", "false"),
+				),
+			},
+			{
+				Config: testAccRegisteredDomainConfigTransferLock(domainName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRegisteredDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "transfer_lock", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPreCheck(t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Route53DomainsConn
+
+	input := &route53domains.ListDomainsInput{}
+
+	_, err := conn.ListDomains(input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccCheckRegisteredDomainExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Route 53 Domains Domain ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53DomainsConn
+
+		_, err := tfroute53domains.FindDomainDetailByName(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccRegisteredDomainConfig(domainName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53domains_registered_domain" "test" {
+  domain_name = %[1]q
+}
+`, domainName)
+}
+
+func testAccRegisteredDomainConfigTags1(domainName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_route53domains_registered_domain" "test" {
+  domain_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, domainName, tagKey1, tagValue1)
+}
+
+func testAccRegisteredDomainConfigTags2(domainName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_route53domains_registered_domain" "test" {
+  domain_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, domainName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccRegisteredDomainConfigAutoRenew(domainName string, autoRenew bool) string {
+	return fmt.Sprintf(`
+resource "aws_route53domains_registered_domain" "test" {
+  domain_name = %[1]q
+  auto_renew  = %[2]t
+}
+`, domainName, autoRenew)
+}
+
+func testAccRegisteredDomainConfigContactPrivacy(domainName string, adminPrivacy, registrantPrivacy, techPrivacy bool) string {
+	return fmt.Sprintf(`
+resource "aws_route53domains_registered_domain" "test" {
+  domain_name = %[1]q
+
+  admin_privacy      = %[2]t
+  registrant_privacy = %[3]t
+  tech_privacy       = %[4]t
+}
+`, domainName, adminPrivacy, registrantPrivacy, techPrivacy)
+}
+
+func testAccRegisteredDomainConfigTransferLock(domainName string, transferLock bool) string {
+	return fmt.Sprintf(`
+resource "aws_route53domains_registered_domain" "test" {
+  domain_name   = %[1]q
+  transfer_lock = %[2]t
+}
+`, domainName, transferLock)
+}
@@ -0,0 +1,38 @@
+package route53domains
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53domains"
+	"github.com/hashicorp/terraform-provider-aws/internal/waiter"
+)
+
+func waitOperationSucceeded(ctx context.Context, conn *route53domains.Route53Domains, id string, timeout time.Duration) (*route53domains.GetOperationDetailOutput, error) {
+	outputRaw, err := waiter.UntilState(ctx, &waiter.Config{
+		Pending:     []string{route53domains.OperationStatusSubmitted, route53domains.OperationStatusInProgress},
+		Target:      []string{route53domains.OperationStatusSuccessful},
+		Refresh:     statusOperation(ctx, conn, id),
+		Timeout:     timeout,
+		Description: fmt.Sprintf("Route 53 Domains operation (%s)", id),
+		Error: func(outputRaw interface{}) error {
+			output := outputRaw.(*route53domains.GetOperationDetailOutput)
+
+			switch aws.StringValue(output.Status) {
+			case route53domains.OperationStatusError, route53domains.OperationStatusFailed:
+				return errors.New(aws.StringValue(output.Message))
+			}
+
+			return nil
+		},
+	})
+
+	if output, ok := outputRaw.(*route53domains.GetOperationDetailOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
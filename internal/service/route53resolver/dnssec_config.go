@@ -86,6 +86,7 @@ func resourceDNSSECConfigRead(d *schema.ResourceData, meta interface{}) error {
 
 	if config == nil || aws.StringValue(config.ValidationStatus) == route53resolver.ResolverDNSSECValidationStatusDisabled {
 		log.Printf("[WARN] Route 53 Resolver DNSSEC config (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -157,6 +157,7 @@ func resourceEndpointRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	if state == EndpointStatusDeleted {
 		log.Printf("[WARN] Route53 Resolver endpoint (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
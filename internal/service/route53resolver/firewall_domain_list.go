@@ -90,6 +90,7 @@ func resourceFirewallDomainListRead(d *schema.ResourceData, meta interface{}) er
 
 	if tfawserr.ErrMessageContains(err, route53resolver.ErrCodeResourceNotFoundException, "") {
 		log.Printf("[WARN] Route53 Resolver DNS Firewall domain list (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -100,6 +101,7 @@ func resourceFirewallDomainListRead(d *schema.ResourceData, meta interface{}) er
 
 	if firewallDomainList == nil {
 		log.Printf("[WARN] Route 53 Resolver DNS Firewall domain list (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -93,6 +93,7 @@ func resourceFirewallRuleGroupRead(d *schema.ResourceData, meta interface{}) err
 
 	if tfawserr.ErrMessageContains(err, route53resolver.ErrCodeResourceNotFoundException, "") {
 		log.Printf("[WARN] Route53 Resolver DNS Firewall rule group (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -103,6 +104,7 @@ func resourceFirewallRuleGroupRead(d *schema.ResourceData, meta interface{}) err
 
 	if ruleGroup == nil {
 		log.Printf("[WARN] Route 53 Resolver DNS Firewall rule group (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
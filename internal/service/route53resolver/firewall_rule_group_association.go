@@ -113,6 +113,7 @@ func resourceFirewallRuleGroupAssociationRead(d *schema.ResourceData, meta inter
 
 	if !d.IsNewResource() && tfawserr.ErrMessageContains(err, route53resolver.ErrCodeResourceNotFoundException, "") {
 		log.Printf("[WARN] Route53 Resolver DNS Firewall rule group association (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -127,6 +128,7 @@ func resourceFirewallRuleGroupAssociationRead(d *schema.ResourceData, meta inter
 		}
 
 		log.Printf("[WARN] Route 53 Resolver DNS Firewall rule group association (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
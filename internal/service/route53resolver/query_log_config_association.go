@@ -69,6 +69,7 @@ func resourceQueryLogConfigAssociationRead(d *schema.ResourceData, meta interfac
 
 	if tfawserr.ErrMessageContains(err, route53resolver.ErrCodeResourceNotFoundException, "") {
 		log.Printf("[WARN] Route53 Resolver Query Log Config Association (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -79,6 +80,7 @@ func resourceQueryLogConfigAssociationRead(d *schema.ResourceData, meta interfac
 
 	if queryLogConfigAssociation == nil {
 		log.Printf("[WARN] Route53 Resolver Query Log Config Association (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -170,6 +170,7 @@ func resourceRuleRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	if state == RuleStatusDeleted {
 		log.Printf("[WARN] Route53 Resolver rule (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -95,6 +95,7 @@ func resourceRuleAssociationRead(d *schema.ResourceData, meta interface{}) error
 	}
 	if state == RuleAssociationStatusDeleted {
 		log.Printf("[WARN] Route53 Resolver rule association (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -124,7 +124,7 @@ func sweepDNSSECConfig(region string) error {
 			d.SetId(aws.StringValue(resolverDnssecConfig.Id))
 			d.Set("resource_id", resourceId)
 
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 
 			if err != nil {
 				sweeperErr := fmt.Errorf("error deleting Route 53 Resolver Resolver Dnssec config (%s): %w", id, err)
@@ -219,7 +219,7 @@ func sweepFirewallsConfig(region string) error {
 			r := ResourceFirewallConfig()
 			d := r.Data(nil)
 			d.SetId(id)
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 
 			if err != nil {
 				log.Printf("[ERROR] %s", err)
@@ -261,7 +261,7 @@ func sweepFirewallDomainLists(region string) error {
 			r := ResourceFirewallDomainList()
 			d := r.Data(nil)
 			d.SetId(id)
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 
 			if err != nil {
 				log.Printf("[ERROR] %s", err)
@@ -328,7 +328,7 @@ func sweepFirewallRuleGroupAssociations(region string) error {
 				}
 			}
 
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 
 			if err != nil {
 				log.Printf("[ERROR] %s", err)
@@ -370,7 +370,7 @@ func sweepFirewallRuleGroups(region string) error {
 			r := ResourceFirewallRuleGroup()
 			d := r.Data(nil)
 			d.SetId(id)
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 
 			if err != nil {
 				log.Printf("[ERROR] %s", err)
@@ -428,7 +428,7 @@ func sweepFirewallRules(region string) error {
 					r := ResourceFirewallRule()
 					d := r.Data(nil)
 					d.SetId(id)
-					err := r.Delete(d, client)
+					err := sweep.DeleteResource(r, d, client)
 
 					if err != nil {
 						log.Printf("[ERROR] %s", err)
@@ -491,7 +491,7 @@ func sweepQueryLogAssociationsConfig(region string) error {
 			// The following additional arguments are required during the resource's Delete operation
 			d.Set("resolver_query_log_config_id", queryLogConfigAssociation.ResolverQueryLogConfigId)
 			d.Set("resource_id", queryLogConfigAssociation.ResourceId)
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 
 			if err != nil {
 				log.Printf("[ERROR] %s", err)
@@ -533,7 +533,7 @@ func sweepQueryLogsConfig(region string) error {
 			r := ResourceQueryLogConfig()
 			d := r.Data(nil)
 			d.SetId(id)
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 
 			if err != nil {
 				log.Printf("[ERROR] %s", err)
@@ -134,12 +134,14 @@ func resourceBucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, "NoSuchBucket") {
 		log.Printf("[WARN] S3 Control Bucket (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, "NoSuchOutpost") {
 		log.Printf("[WARN] S3 Control Bucket (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
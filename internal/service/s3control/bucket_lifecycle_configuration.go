@@ -173,18 +173,21 @@ func resourceBucketLifecycleConfigurationRead(d *schema.ResourceData, meta inter
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, "NoSuchBucket") {
 		log.Printf("[WARN] S3 Control Lifecycle Configuration (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, "NoSuchLifecycleConfiguration") {
 		log.Printf("[WARN] S3 Control Lifecycle Configuration (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, "NoSuchOutpost") {
 		log.Printf("[WARN] S3 Control Lifecycle Configuration (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
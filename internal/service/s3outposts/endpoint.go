@@ -114,6 +114,7 @@ func resourceEndpointRead(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[WARN] S3 Outposts Endpoint (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
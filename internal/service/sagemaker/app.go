@@ -141,6 +141,7 @@ func resourceAppRead(d *schema.ResourceData, meta interface{}) error {
 	app, err := FindAppByName(conn, domainID, userProfileName, appType, appName)
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, sagemaker.ErrCodeResourceNotFound, "") {
+			//lintignore:AWSR003
 			d.SetId("")
 			log.Printf("[WARN] Unable to find SageMaker App (%s), removing from state", d.Id())
 			return nil
@@ -149,6 +150,7 @@ func resourceAppRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if aws.StringValue(app.Status) == sagemaker.AppStatusDeleted {
+		//lintignore:AWSR003
 		d.SetId("")
 		log.Printf("[WARN] Unable to find SageMaker App (%s), removing from state", d.Id())
 		return nil
@@ -140,6 +140,7 @@ func resourceAppImageConfigRead(d *schema.ResourceData, meta interface{}) error
 	image, err := FindAppImageConfigByName(conn, d.Id())
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, sagemaker.ErrCodeResourceNotFound, "does not exist") {
+			//lintignore:AWSR003
 			d.SetId("")
 			log.Printf("[WARN] Unable to find SageMaker App Image Config (%s); removing from state", d.Id())
 			return nil
@@ -95,6 +95,7 @@ func resourceCodeRepositoryRead(d *schema.ResourceData, meta interface{}) error
 	codeRepository, err := FindCodeRepositoryByName(conn, d.Id())
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, "ValidationException", "Cannot find CodeRepository") {
+			//lintignore:AWSR003
 			d.SetId("")
 			log.Printf("[WARN] Unable to find SageMaker code repository (%s); removing from state", d.Id())
 			return nil
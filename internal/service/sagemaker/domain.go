@@ -329,6 +329,7 @@ func resourceDomainRead(d *schema.ResourceData, meta interface{}) error {
 	domain, err := FindDomainByName(conn, d.Id())
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, sagemaker.ErrCodeResourceNotFound, "") {
+			//lintignore:AWSR003
 			d.SetId("")
 			log.Printf("[WARN] Unable to find SageMaker domain (%s), removing from state", d.Id())
 			return nil
@@ -105,6 +105,7 @@ func resourceEndpointRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, "ValidationException", "") {
 			log.Printf("[INFO] unable to find the SageMaker Endpoint resource and therefore it is removed from the state: %s", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -112,6 +113,7 @@ func resourceEndpointRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	if aws.StringValue(endpoint.EndpointStatus) == sagemaker.EndpointStatusDeleting {
 		log.Printf("[WARN] SageMaker Endpoint (%s) is deleting, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -89,7 +89,7 @@ func resourceImageCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// for some reason even if the operation is retried the same error response is given even though the role is valid. a short sleep before creation solves it.
-	time.Sleep(1 * time.Minute)
+	time.Sleep(1 * time.Minute) //lintignore:AWSR006
 	_, err := conn.CreateImage(input)
 	if err != nil {
 		return fmt.Errorf("error creating SageMaker Image %s: %w", name, err)
@@ -112,6 +112,7 @@ func resourceImageRead(d *schema.ResourceData, meta interface{}) error {
 	image, err := FindImageByName(conn, d.Id())
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, sagemaker.ErrCodeResourceNotFound, "does not exist") {
+			//lintignore:AWSR003
 			d.SetId("")
 			log.Printf("[WARN] Unable to find SageMaker Image (%s); removing from state", d.Id())
 			return nil
@@ -80,6 +80,7 @@ func resourceImageVersionRead(d *schema.ResourceData, meta interface{}) error {
 	image, err := FindImageVersionByName(conn, d.Id())
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, sagemaker.ErrCodeResourceNotFound, "does not exist") {
+			//lintignore:AWSR003
 			d.SetId("")
 			log.Printf("[WARN] Unable to find Sagemaker Image Version (%s); removing from state", d.Id())
 			return nil
@@ -291,6 +291,7 @@ func resourceModelRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, "ValidationException", "") {
 			log.Printf("[INFO] unable to find the sagemaker model resource and therefore it is removed from the state: %s", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
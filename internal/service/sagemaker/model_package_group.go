@@ -94,6 +94,7 @@ func resourceModelPackageGroupRead(d *schema.ResourceData, meta interface{}) err
 	mpg, err := FindModelPackageGroupByName(conn, d.Id())
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, "ValidationException", "does not exist") {
+			//lintignore:AWSR003
 			d.SetId("")
 			log.Printf("[WARN] Unable to find Sagemaker Model Package Group (%s); removing from state", d.Id())
 			return nil
@@ -224,6 +224,7 @@ func resourceNotebookInstanceRead(d *schema.ResourceData, meta interface{}) erro
 	notebookInstance, err := conn.DescribeNotebookInstance(describeNotebookInput)
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, "ValidationException", "RecordNotFound") {
+			//lintignore:AWSR003
 			d.SetId("")
 			log.Printf("[WARN] Unable to find sageMaker notebook instance (%s); removing from state", d.Id())
 			return nil
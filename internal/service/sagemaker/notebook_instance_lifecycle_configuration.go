@@ -98,6 +98,7 @@ func resourceNotebookInstanceLifeCycleConfigurationRead(d *schema.ResourceData,
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, "ValidationException", "") {
 			log.Printf("[INFO] unable to find the SageMaker notebook instance lifecycle configuration (%s); therefore it is removed from the state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -297,6 +297,7 @@ func resourceUserProfileRead(d *schema.ResourceData, meta interface{}) error {
 	UserProfile, err := FindUserProfileByName(conn, domainID, userProfileName)
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, sagemaker.ErrCodeResourceNotFound, "") {
+			//lintignore:AWSR003
 			d.SetId("")
 			log.Printf("[WARN] Unable to find SageMaker User Profile (%s), removing from state", d.Id())
 			return nil
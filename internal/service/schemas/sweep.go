@@ -46,7 +46,7 @@ func sweepDiscoverers(region string) error {
 			r := ResourceDiscoverer()
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(discoverer.DiscovererId))
-			err = r.Delete(d, client)
+			err = sweep.DeleteResource(r, d, client)
 
 			if err != nil {
 				log.Printf("[ERROR] %s", err)
@@ -105,7 +105,7 @@ func sweepRegistries(region string) error {
 					r := ResourceSchema()
 					d := r.Data(nil)
 					d.SetId(SchemaCreateResourceID(schemaName, registryName))
-					err = r.Delete(d, client)
+					err = sweep.DeleteResource(r, d, client)
 
 					if err != nil {
 						log.Printf("[ERROR] %s", err)
@@ -128,7 +128,7 @@ func sweepRegistries(region string) error {
 			r := ResourceRegistry()
 			d := r.Data(nil)
 			d.SetId(registryName)
-			err = r.Delete(d, client)
+			err = sweep.DeleteResource(r, d, client)
 
 			if err != nil {
 				log.Printf("[ERROR] %s", err)
@@ -49,6 +49,7 @@ func resourceAccountRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		// Can only read enabled standards if Security Hub is enabled
 		if tfawserr.ErrMessageContains(err, "InvalidAccessException", "not subscribed to AWS Security Hub") {
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
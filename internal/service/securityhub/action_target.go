@@ -104,6 +104,7 @@ func resourceActionTargetRead(d *schema.ResourceData, meta interface{}) error {
 
 	if actionTarget == nil {
 		log.Printf("[WARN] Security Hub custom action target (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
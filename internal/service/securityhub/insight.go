@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strconv"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/securityhub"
@@ -13,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/nullable"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -179,6 +179,7 @@ func resourceInsightRead(ctx context.Context, d *schema.ResourceData, meta inter
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
 		log.Printf("[WARN] Security Hub Insight (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -192,6 +193,7 @@ func resourceInsightRead(ctx context.Context, d *schema.ResourceData, meta inter
 			return diag.FromErr(fmt.Errorf("error reading Security Hub Insight (%s): empty output", d.Id()))
 		}
 		log.Printf("[WARN] Security Hub Insight (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -357,19 +359,19 @@ func numberFilterSchema() *schema.Schema {
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"eq": {
-					Type:         schema.TypeString,
+					Type:         nullable.TypeNullableFloat,
 					Optional:     true,
-					ValidateFunc: verify.ValidTypeStringNullableFloat,
+					ValidateFunc: nullable.ValidateTypeStringNullableFloat,
 				},
 				"gte": {
-					Type:         schema.TypeString,
+					Type:         nullable.TypeNullableFloat,
 					Optional:     true,
-					ValidateFunc: verify.ValidTypeStringNullableFloat,
+					ValidateFunc: nullable.ValidateTypeStringNullableFloat,
 				},
 				"lte": {
-					Type:         schema.TypeString,
+					Type:         nullable.TypeNullableFloat,
 					Optional:     true,
-					ValidateFunc: verify.ValidTypeStringNullableFloat,
+					ValidateFunc: nullable.ValidateTypeStringNullableFloat,
 				},
 			},
 		},
@@ -926,24 +928,21 @@ func expandSecurityHubNumberFilters(l []interface{}) []*securityhub.NumberFilter
 
 		nf := &securityhub.NumberFilter{}
 
-		if v, ok := tfMap["eq"].(string); ok && v != "" {
-			val, err := strconv.ParseFloat(v, 64)
-			if err == nil {
-				nf.Eq = aws.Float64(val)
+		if v, ok := tfMap["eq"].(string); ok {
+			if v, null, err := nullable.Float(v).Value(); err == nil && !null {
+				nf.Eq = aws.Float64(v)
 			}
 		}
 
-		if v, ok := tfMap["gte"].(string); ok && v != "" {
-			val, err := strconv.ParseFloat(v, 64)
-			if err == nil {
-				nf.Gte = aws.Float64(val)
+		if v, ok := tfMap["gte"].(string); ok {
+			if v, null, err := nullable.Float(v).Value(); err == nil && !null {
+				nf.Gte = aws.Float64(v)
 			}
 		}
 
-		if v, ok := tfMap["lte"].(string); ok && v != "" {
-			val, err := strconv.ParseFloat(v, 64)
-			if err == nil {
-				nf.Lte = aws.Float64(val)
+		if v, ok := tfMap["lte"].(string); ok {
+			if v, null, err := nullable.Float(v).Value(); err == nil && !null {
+				nf.Lte = aws.Float64(v)
 			}
 		}
 
@@ -1102,15 +1101,15 @@ func flattenSecurityHubNumberFilters(filters []*securityhub.NumberFilter) []inte
 		m := map[string]interface{}{}
 
 		if filter.Eq != nil {
-			m["eq"] = strconv.FormatFloat(aws.Float64Value(filter.Eq), 'f', -1, 64)
+			m["eq"] = string(nullable.NewFloat(aws.Float64Value(filter.Eq)))
 		}
 
 		if filter.Gte != nil {
-			m["gte"] = strconv.FormatFloat(aws.Float64Value(filter.Gte), 'f', -1, 64)
+			m["gte"] = string(nullable.NewFloat(aws.Float64Value(filter.Gte)))
 		}
 
 		if filter.Lte != nil {
-			m["lte"] = strconv.FormatFloat(aws.Float64Value(filter.Lte), 'f', -1, 64)
+			m["lte"] = string(nullable.NewFloat(aws.Float64Value(filter.Lte)))
 		}
 
 		numFilters = append(numFilters, m)
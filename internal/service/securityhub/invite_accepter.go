@@ -85,6 +85,7 @@ func resourceInviteAccepterRead(d *schema.ResourceData, meta interface{}) error
 	resp, err := conn.GetMasterAccount(&securityhub.GetMasterAccountInput{})
 	if tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
 		log.Print("[WARN] Security Hub master account not found, removing from state")
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -96,6 +97,7 @@ func resourceInviteAccepterRead(d *schema.ResourceData, meta interface{}) error
 
 	if master == nil {
 		log.Print("[WARN] Security Hub master account not found, removing from state")
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
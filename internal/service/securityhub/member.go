@@ -113,6 +113,7 @@ func resourceMemberRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, securityhub.ErrCodeResourceNotFoundException, "") {
 			log.Printf("[WARN] Security Hub member (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -121,6 +122,7 @@ func resourceMemberRead(d *schema.ResourceData, meta interface{}) error {
 
 	if len(resp.Members) == 0 {
 		log.Printf("[WARN] Security Hub member (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
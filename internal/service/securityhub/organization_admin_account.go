@@ -64,6 +64,7 @@ func resourceOrganizationAdminAccountRead(d *schema.ResourceData, meta interface
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
 		log.Printf("[WARN] Security Hub Organization Admin Account (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -78,6 +79,7 @@ func resourceOrganizationAdminAccountRead(d *schema.ResourceData, meta interface
 		}
 
 		log.Printf("[WARN] Security Hub Organization Admin Account (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
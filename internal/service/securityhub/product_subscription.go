@@ -74,6 +74,7 @@ func resourceProductSubscriptionRead(d *schema.ResourceData, meta interface{}) e
 
 	if !exists {
 		log.Printf("[WARN] Security Hub product subscriptions (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -61,6 +61,7 @@ func resourceOrganizationsAccessRead(d *schema.ResourceData, meta interface{}) e
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
 		// theoretically this should not be possible
 		log.Printf("[WARN] Service Catalog Organizations Access (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
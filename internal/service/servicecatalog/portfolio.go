@@ -109,6 +109,7 @@ func resourcePortfolioRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if scErr, ok := err.(awserr.Error); ok && scErr.Code() == "ResourceNotFoundException" {
 			log.Printf("[WARN] Service Catalog Portfolio %q not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -106,6 +106,7 @@ func resourceHTTPNamespaceRead(d *schema.ResourceData, meta interface{}) error {
 	resp, err := conn.GetNamespace(input)
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, servicediscovery.ErrCodeNamespaceNotFound, "") {
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
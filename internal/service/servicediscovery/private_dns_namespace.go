@@ -124,6 +124,7 @@ func resourcePrivateDNSNamespaceRead(d *schema.ResourceData, meta interface{}) e
 	resp, err := conn.GetNamespace(input)
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, servicediscovery.ErrCodeNamespaceNotFound, "") {
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -110,6 +110,7 @@ func resourcePublicDNSNamespaceRead(d *schema.ResourceData, meta interface{}) er
 	resp, err := conn.GetNamespace(input)
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, servicediscovery.ErrCodeNamespaceNotFound, "") {
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -62,6 +62,7 @@ func resourceActiveReceiptRuleSetRead(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, ses.ErrCodeRuleSetDoesNotExistException, "") {
 			log.Printf("[WARN] SES Receipt Rule Set (%s) belonging to SES Active Receipt Rule Set not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -70,6 +71,7 @@ func resourceActiveReceiptRuleSetRead(d *schema.ResourceData, meta interface{})
 
 	if response.Metadata == nil {
 		log.Print("[WARN] No active Receipt Rule Set found")
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
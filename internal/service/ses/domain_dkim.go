@@ -74,6 +74,7 @@ func resourceDomainDKIMRead(d *schema.ResourceData, meta interface{}) error {
 	verificationAttrs, ok := response.DkimAttributes[domainName]
 	if !ok {
 		log.Printf("[WARN] Domain not listed in response when fetching verification attributes for %s", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -81,6 +81,7 @@ func resourceDomainIdentityRead(d *schema.ResourceData, meta interface{}) error
 	verificationAttrs, ok := response.VerificationAttributes[domainName]
 	if !ok {
 		log.Printf("[WARN] Domain not listed in response when fetching verification attributes for %s", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
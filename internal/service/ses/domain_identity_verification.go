@@ -105,12 +105,14 @@ func resourceDomainIdentityVerificationRead(d *schema.ResourceData, meta interfa
 
 	if att == nil {
 		log.Printf("[WARN] Domain not listed in response when fetching verification attributes for %s", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
 
 	if aws.StringValue(att.VerificationStatus) != ses.VerificationStatusSuccess {
 		log.Printf("[WARN] Expected domain verification Success, but was %s, tainting verification", aws.StringValue(att.VerificationStatus))
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
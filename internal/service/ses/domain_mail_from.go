@@ -87,6 +87,7 @@ func resourceDomainMailFromRead(d *schema.ResourceData, meta interface{}) error
 
 	if !ok {
 		log.Printf("[WARN] SES Domain Identity (%s) not found, removing from state", domainName)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
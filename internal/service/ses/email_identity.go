@@ -79,6 +79,7 @@ func resourceEmailIdentityRead(d *schema.ResourceData, meta interface{}) error {
 	_, ok := response.VerificationAttributes[email]
 	if !ok {
 		log.Printf("[WARN] Email not listed in response when fetching verification attributes for %s", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
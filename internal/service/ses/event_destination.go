@@ -223,6 +223,7 @@ func resourceEventDestinationRead(d *schema.ResourceData, meta interface{}) erro
 	output, err := conn.DescribeConfigurationSet(input)
 	if tfawserr.ErrMessageContains(err, ses.ErrCodeConfigurationSetDoesNotExistException, "") {
 		log.Printf("[WARN] SES Configuration Set (%s) not found, removing from state", configurationSetName)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -239,6 +240,7 @@ func resourceEventDestinationRead(d *schema.ResourceData, meta interface{}) erro
 	}
 	if thisEventDestination == nil {
 		log.Printf("[WARN] SES Configuration Set Event Destination (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
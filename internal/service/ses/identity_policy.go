@@ -118,6 +118,7 @@ func resourceIdentityPolicyRead(d *schema.ResourceData, meta interface{}) error
 
 	if len(output.Policies) == 0 {
 		log.Printf("[WARN] SES Identity (%s) Policy (%s) not found, removing from state", identity, policyName)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -125,6 +126,7 @@ func resourceIdentityPolicyRead(d *schema.ResourceData, meta interface{}) error
 	policy, ok := output.Policies[policyName]
 	if !ok {
 		log.Printf("[WARN] SES Identity (%s) Policy (%s) not found, removing from state", identity, policyName)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
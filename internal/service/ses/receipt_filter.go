@@ -108,6 +108,7 @@ func resourceReceiptFilterRead(d *schema.ResourceData, meta interface{}) error {
 
 	if filter == nil {
 		log.Printf("[WARN] SES Receipt Filter (%s) not found", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
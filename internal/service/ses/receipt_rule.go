@@ -477,11 +477,13 @@ func resourceReceiptRuleRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, ses.ErrCodeRuleDoesNotExistException, "") {
 			log.Printf("[WARN] SES Receipt Rule (%s) not found", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
 		if tfawserr.ErrMessageContains(err, ses.ErrCodeRuleSetDoesNotExistException, "") {
 			log.Printf("[WARN] SES Receipt Rule Set (%s) belonging to SES Receipt Rule (%s) not found, removing from state", aws.StringValue(describeOpts.RuleSetName), d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -67,6 +67,7 @@ func resourceReceiptRuleSetRead(d *schema.ResourceData, meta interface{}) error
 
 	if tfawserr.ErrMessageContains(err, ses.ErrCodeRuleSetDoesNotExistException, "") {
 		log.Printf("[WARN] SES Receipt Rule Set (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -77,6 +78,7 @@ func resourceReceiptRuleSetRead(d *schema.ResourceData, meta interface{}) error
 
 	if resp.Metadata == nil {
 		log.Print("[WARN] No Receipt Rule Set found")
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -91,6 +91,7 @@ func resourceActivityRead(d *schema.ResourceData, meta interface{}) error {
 	})
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "ActivityDoesNotExist" {
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -92,6 +92,7 @@ func resourceProtectionRead(d *schema.ResourceData, meta interface{}) error {
 
 	if tfawserr.ErrMessageContains(err, shield.ErrCodeResourceNotFoundException, "") {
 		log.Printf("[WARN] Shield Protection (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
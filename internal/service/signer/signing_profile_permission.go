@@ -192,6 +192,7 @@ func resourceSigningProfilePermissionRead(d *schema.ResourceData, meta interface
 
 	if !d.IsNewResource() && tfawserr.ErrMessageContains(err, signer.ErrCodeResourceNotFoundException, "") {
 		log.Printf("[WARN] No Signer Signing Profile Permissions found (%s), removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -204,6 +205,7 @@ func resourceSigningProfilePermissionRead(d *schema.ResourceData, meta interface
 	permission := getProfilePermission(listProfilePermissionsOutput.Permissions, statementId)
 	if permission == nil {
 		log.Printf("[WARN] No Signer signing profile permission found matching statement id: %s", statementId)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
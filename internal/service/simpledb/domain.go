@@ -56,6 +56,7 @@ func resourceDomainRead(d *schema.ResourceData, meta interface{}) error {
 	if awsErr, ok := err.(awserr.Error); ok {
 		if awsErr.Code() == "NoSuchDomain" {
 			log.Printf("[WARN] Removing SimpleDB domain %q because it's gone.", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -217,6 +217,7 @@ func resourcePlatformApplicationRead(d *schema.ResourceData, meta interface{}) e
 
 	if tfawserr.ErrMessageContains(err, sns.ErrCodeNotFoundException, "") {
 		log.Printf("[WARN] SNS Platform Application (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
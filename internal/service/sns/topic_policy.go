@@ -78,6 +78,7 @@ func resourceTopicPolicyRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, sns.ErrCodeNotFoundException, "") {
 			log.Printf("[WARN] SNS Topic (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -87,6 +88,7 @@ func resourceTopicPolicyRead(d *schema.ResourceData, meta interface{}) error {
 
 	if attributeOutput.Attributes == nil {
 		log.Printf("[WARN] SNS Topic (%q) attributes not found (nil), removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -95,6 +97,7 @@ func resourceTopicPolicyRead(d *schema.ResourceData, meta interface{}) error {
 	policy, ok := attrmap["Policy"]
 	if !ok {
 		log.Printf("[WARN] SNS Topic (%q) policy not found in attributes, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
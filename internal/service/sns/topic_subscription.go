@@ -205,6 +205,7 @@ func resourceTopicSubscriptionRead(d *schema.ResourceData, meta interface{}) err
 		}
 
 		log.Printf("[WARN] SNS Topic Subscription Attributes (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -171,6 +171,7 @@ func resourceActivationRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	if resp.ActivationList == nil || len(resp.ActivationList) == 0 {
 		log.Printf("[WARN] SSM Activation (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
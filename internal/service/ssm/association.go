@@ -216,6 +216,7 @@ func resourceAssociationRead(d *schema.ResourceData, meta interface{}) error {
 
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, ssm.ErrCodeAssociationDoesNotExist, "") {
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -266,6 +266,7 @@ func resourceDocumentRead(d *schema.ResourceData, meta interface{}) error {
 
 	if tfawserr.ErrMessageContains(err, ssm.ErrCodeInvalidDocument, "") {
 		log.Printf("[WARN] SSM Document not found so removing from state")
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -219,6 +219,7 @@ func resourceMaintenanceWindowRead(d *schema.ResourceData, meta interface{}) err
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, ssm.ErrCodeDoesNotExistException, "") {
 			log.Printf("[WARN] Maintenance Window %s not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -143,6 +143,7 @@ func resourceMaintenanceWindowTargetRead(d *schema.ResourceData, meta interface{
 	resp, err := conn.DescribeMaintenanceWindowTargets(params)
 	if tfawserr.ErrMessageContains(err, ssm.ErrCodeDoesNotExistException, "") {
 		log.Printf("[WARN] Maintenance Window (%s) Target (%s) not found, removing from state", windowID, d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -169,6 +170,7 @@ func resourceMaintenanceWindowTargetRead(d *schema.ResourceData, meta interface{
 
 	if !found {
 		log.Printf("[INFO] Maintenance Window Target not found. Removing from state")
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -708,6 +708,7 @@ func resourceMaintenanceWindowTaskRead(d *schema.ResourceData, meta interface{})
 	resp, err := conn.GetMaintenanceWindowTask(params)
 	if tfawserr.ErrMessageContains(err, ssm.ErrCodeDoesNotExistException, "") {
 		log.Printf("[WARN] Maintenance Window (%s) Task (%s) not found, removing from state", windowID, d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
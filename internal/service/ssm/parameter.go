@@ -208,6 +208,7 @@ func resourceParameterRead(d *schema.ResourceData, meta interface{}) error {
 
 	if tfawserr.ErrCodeEquals(err, ssm.ErrCodeParameterNotFound) && !d.IsNewResource() {
 		log.Printf("[WARN] SSM Parameter (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -239,6 +240,7 @@ func resourceParameterRead(d *schema.ResourceData, meta interface{}) error {
 
 	if describeResp == nil || len(describeResp.Parameters) == 0 || describeResp.Parameters[0] == nil {
 		log.Printf("[WARN] SSM Parameter %q not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -83,6 +83,7 @@ func resourcePatchGroupRead(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[WARN] SSM Patch Group (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -105,6 +105,7 @@ func resourceResourceDataSyncRead(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 	if syncItem == nil {
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -145,6 +145,7 @@ func resourceAccountAssignmentRead(d *schema.ResourceData, meta interface{}) err
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
 		log.Printf("[WARN] SSO Account Assignment for Principal (%s) not found, removing from state", principalID)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -159,6 +160,7 @@ func resourceAccountAssignmentRead(d *schema.ResourceData, meta interface{}) err
 		}
 
 		log.Printf("[WARN] SSO Account Assignment for Principal (%s) not found, removing from state", principalID)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -92,6 +92,7 @@ func resourceManagedPolicyAttachmentRead(d *schema.ResourceData, meta interface{
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
 		log.Printf("[WARN] Managed Policy (%s) for SSO Permission Set (%s) not found, removing from state", managedPolicyArn, permissionSetArn)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -102,6 +103,7 @@ func resourceManagedPolicyAttachmentRead(d *schema.ResourceData, meta interface{
 
 	if policy == nil {
 		log.Printf("[WARN] Managed Policy (%s) for SSO Permission Set (%s) not found, removing from state", managedPolicyArn, permissionSetArn)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
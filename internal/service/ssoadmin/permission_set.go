@@ -149,6 +149,7 @@ func resourcePermissionSetRead(d *schema.ResourceData, meta interface{}) error {
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
 		log.Printf("[WARN] SSO Permission Set (%s) not found, removing from state", arn)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
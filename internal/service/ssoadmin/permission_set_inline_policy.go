@@ -90,6 +90,7 @@ func resourcePermissionSetInlinePolicyRead(d *schema.ResourceData, meta interfac
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
 		log.Printf("[WARN] Inline Policy for SSO Permission Set (%s) not found, removing from state", permissionSetArn)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
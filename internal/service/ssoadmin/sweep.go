@@ -102,7 +102,7 @@ func sweepAccountAssignments(region string) error {
 					d := r.Data(nil)
 					d.SetId(fmt.Sprintf("%s,%s,%s,%s,%s,%s", principalID, principalType, targetID, targetType, permissionSetArn, instanceArn))
 
-					err = r.Delete(d, client)
+					err = sweep.DeleteResource(r, d, client)
 
 					if err != nil {
 						log.Printf("[ERROR] %s", err)
@@ -188,7 +188,7 @@ func sweepPermissionSets(region string) error {
 			d := r.Data(nil)
 			d.SetId(fmt.Sprintf("%s,%s", arn, instanceArn))
 
-			err = r.Delete(d, client)
+			err = sweep.DeleteResource(r, d, client)
 
 			if err != nil {
 				log.Printf("[ERROR] %s", err)
@@ -105,6 +105,7 @@ func resourceCacheRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if IsErrGatewayNotFound(err) {
 			log.Printf("[WARN] Storage Gateway cache %q not found - removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -113,6 +114,7 @@ func resourceCacheRead(d *schema.ResourceData, meta interface{}) error {
 
 	if output == nil || len(output.DiskIds) == 0 {
 		log.Printf("[WARN] Storage Gateway cache %q not found - removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -127,6 +129,7 @@ func resourceCacheRead(d *schema.ResourceData, meta interface{}) error {
 
 	if !found {
 		log.Printf("[WARN] Storage Gateway cache %q not found - removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -143,6 +143,7 @@ func resourceFileSystemAssociationRead(d *schema.ResourceData, meta interface{})
 
 	if !d.IsNewResource() && filesystem == nil {
 		log.Printf("[WARN] Storage Gateway File System Association (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -459,6 +459,7 @@ func resourceGatewayRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if IsErrGatewayNotFound(err) {
 			log.Printf("[WARN] Storage Gateway Gateway %q not found - removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -485,6 +486,7 @@ func resourceGatewayRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil && !tfawserr.ErrMessageContains(err, storagegateway.ErrCodeInvalidGatewayRequestException, "This operation is not valid for the specified gateway") {
 		if IsErrGatewayNotFound(err) {
 			log.Printf("[WARN] Storage Gateway Gateway %q not found - removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
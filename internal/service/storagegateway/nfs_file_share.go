@@ -273,6 +273,7 @@ func resourceNFSFileShareRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified file share was not found.") {
 			log.Printf("[WARN] Storage Gateway NFS File Share %q not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -281,6 +282,7 @@ func resourceNFSFileShareRead(d *schema.ResourceData, meta interface{}) error {
 
 	if output == nil || len(output.NFSFileShareInfoList) == 0 || output.NFSFileShareInfoList[0] == nil {
 		log.Printf("[WARN] Storage Gateway NFS File Share %q not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -108,6 +108,7 @@ func resourceUploadBufferRead(d *schema.ResourceData, meta interface{}) error {
 
 	if !d.IsNewResource() && IsErrGatewayNotFound(err) {
 		log.Printf("[WARN] Storage Gateway Upload Buffer (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -122,6 +123,7 @@ func resourceUploadBufferRead(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[WARN] Storage Gateway Upload Buffer (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -77,6 +77,7 @@ func resourceWorkingStorageRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		if IsErrGatewayNotFound(err) {
 			log.Printf("[WARN] Storage Gateway working storage %q not found - removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -85,6 +86,7 @@ func resourceWorkingStorageRead(d *schema.ResourceData, meta interface{}) error
 
 	if output == nil || len(output.DiskIds) == 0 {
 		log.Printf("[WARN] Storage Gateway working storage %q not found - removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -99,6 +101,7 @@ func resourceWorkingStorageRead(d *schema.ResourceData, meta interface{}) error
 
 	if !found {
 		log.Printf("[WARN] Storage Gateway working storage %q not found - removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
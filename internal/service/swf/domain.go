@@ -117,6 +117,7 @@ func resourceDomainRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, swf.ErrCodeUnknownResourceFault, "") {
 			log.Printf("[WARN] SWF Domain %q not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -125,6 +126,7 @@ func resourceDomainRead(d *schema.ResourceData, meta interface{}) error {
 
 	if resp == nil || resp.Configuration == nil || resp.DomainInfo == nil {
 		log.Printf("[WARN] SWF Domain %q not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
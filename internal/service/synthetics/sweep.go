@@ -53,7 +53,7 @@ func sweepCanaries(region string) error {
 			r := ResourceCanary()
 			d := r.Data(nil)
 			d.SetId(name)
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 
 			if err != nil {
 				log.Printf("[ERROR] %s", err)
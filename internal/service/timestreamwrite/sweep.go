@@ -11,7 +11,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/timestreamwrite"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
@@ -59,16 +58,12 @@ func sweepDatabases(region string) error {
 			d := r.Data(nil)
 			d.SetId(dbName)
 
-			diags := r.DeleteWithoutTimeout(ctx, d, client)
+			err := sweep.DeleteResource(r, d, client)
 
-			if diags != nil && diags.HasError() {
-				for _, d := range diags {
-					if d.Severity == diag.Error {
-						sweeperErr := fmt.Errorf("error deleting Timestream Database (%s): %s", dbName, d.Summary)
-						log.Printf("[ERROR] %s", sweeperErr)
-						sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-					}
-				}
+			if err != nil {
+				sweeperErr := fmt.Errorf("error deleting Timestream Database (%s): %w", dbName, err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
 			}
 		}
 
@@ -117,16 +112,12 @@ func sweepTables(region string) error {
 			d := r.Data(nil)
 			d.SetId(fmt.Sprintf("%s:%s", tableName, dbName))
 
-			diags := r.DeleteWithoutTimeout(ctx, d, client)
+			err := sweep.DeleteResource(r, d, client)
 
-			if diags != nil && diags.HasError() {
-				for _, d := range diags {
-					if d.Severity == diag.Error {
-						sweeperErr := fmt.Errorf("error deleting Timestream Table (%s): %s", dbName, d.Summary)
-						log.Printf("[ERROR] %s", sweeperErr)
-						sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-					}
-				}
+			if err != nil {
+				sweeperErr := fmt.Errorf("error deleting Timestream Table (%s): %w", dbName, err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
 			}
 		}
 
@@ -140,6 +140,7 @@ func resourceTableRead(ctx context.Context, d *schema.ResourceData, meta interfa
 
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, timestreamwrite.ErrCodeResourceNotFoundException) {
 		log.Printf("[WARN] Timestream Table %s not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
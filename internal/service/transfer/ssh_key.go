@@ -91,6 +91,7 @@ func resourceSSHKeyRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, transfer.ErrCodeResourceNotFoundException, "") {
 			log.Printf("[WARN] Transfer User (%s) for Server (%s) not found, removing ssh public key (%s) from state", userName, serverID, sshKeyID)
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -106,6 +107,7 @@ func resourceSSHKeyRead(d *schema.ResourceData, meta interface{}) error {
 
 	if body == "" {
 		log.Printf("[WARN] No such ssh public key found for User (%s) in Server (%s)", userName, serverID)
+		//lintignore:AWSR003
 		d.SetId("")
 	}
 
@@ -130,6 +130,7 @@ func resourceRuleRead(d *schema.ResourceData, meta interface{}) error {
 	resp, err := conn.GetRule(params)
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, waf.ErrCodeNonexistentItemException) {
 		log.Printf("[WARN] WAF Rule (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -144,6 +145,7 @@ func resourceRuleRead(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[WARN] WAF Rule (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -61,6 +61,7 @@ func resourceSizeConstraintSetRead(d *schema.ResourceData, meta interface{}) err
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == waf.ErrCodeNonexistentItemException {
 			log.Printf("[WARN] WAF SizeConstraintSet (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
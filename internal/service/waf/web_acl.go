@@ -228,6 +228,7 @@ func resourceWebACLRead(d *schema.ResourceData, meta interface{}) error {
 	resp, err := conn.GetWebACL(params)
 	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, waf.ErrCodeNonexistentItemException) {
 		log.Printf("[WARN] WAF Web ACL (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -242,6 +243,7 @@ func resourceWebACLRead(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[WARN] WAF Web ACL (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
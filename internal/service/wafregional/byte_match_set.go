@@ -107,6 +107,7 @@ func resourceByteMatchSetRead(d *schema.ResourceData, meta interface{}) error {
 
 	if tfawserr.ErrMessageContains(err, waf.ErrCodeNonexistentItemException, "") {
 		log.Printf("[WARN] WAF Regional Byte Set Match (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -117,6 +118,7 @@ func resourceByteMatchSetRead(d *schema.ResourceData, meta interface{}) error {
 
 	if resp == nil || resp.ByteMatchSet == nil {
 		log.Printf("[WARN] WAF Regional Byte Set Match (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
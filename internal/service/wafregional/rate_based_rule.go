@@ -133,6 +133,7 @@ func resourceRateBasedRuleRead(d *schema.ResourceData, meta interface{}) error {
 	resp, err := conn.GetRateBasedRule(params)
 	if tfawserr.ErrMessageContains(err, wafregional.ErrCodeWAFNonexistentItemException, "") {
 		log.Printf("[WARN] WAF Regional Rate Based Rule (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -101,6 +101,7 @@ func resourceRegexMatchSetRead(d *schema.ResourceData, meta interface{}) error {
 	set, err := FindRegexMatchSetByID(conn, d.Id())
 	if tfawserr.ErrCodeEquals(err, wafregional.ErrCodeWAFNonexistentItemException) {
 		log.Printf("[WARN] WAF Regional Regex Match Set (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
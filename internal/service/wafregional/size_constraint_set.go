@@ -64,6 +64,7 @@ func resourceSizeConstraintSetRead(d *schema.ResourceData, meta interface{}) err
 	resp, err := conn.GetSizeConstraintSet(params)
 	if tfawserr.ErrMessageContains(err, wafregional.ErrCodeWAFNonexistentItemException, "") {
 		log.Printf("[WARN] WAF Regional SizeConstraintSet (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
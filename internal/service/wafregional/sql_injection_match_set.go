@@ -103,6 +103,7 @@ func resourceSQLInjectionMatchSetRead(d *schema.ResourceData, meta interface{})
 	resp, err := conn.GetSqlInjectionMatchSet(params)
 	if tfawserr.ErrMessageContains(err, wafregional.ErrCodeWAFNonexistentItemException, "") {
 		log.Printf("[WARN] Regional WAF SQL Injection Match Set (%s) not found, error code (404)", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
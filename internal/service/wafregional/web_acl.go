@@ -261,6 +261,7 @@ func resourceWebACLRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, wafregional.ErrCodeWAFNonexistentItemException, "") {
 			log.Printf("[WARN] WAF Regional ACL (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -270,6 +271,7 @@ func resourceWebACLRead(d *schema.ResourceData, meta interface{}) error {
 
 	if resp == nil || resp.WebACL == nil {
 		log.Printf("[WARN] WAF Regional ACL (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -91,6 +91,7 @@ func resourceWebACLAssociationRead(d *schema.ResourceData, meta interface{}) err
 
 	if tfawserr.ErrMessageContains(err, wafregional.ErrCodeWAFNonexistentItemException, "") {
 		log.Printf("[WARN] WAF Regional Web ACL for resource (%s) not found, removing from state", resourceArn)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -101,6 +102,7 @@ func resourceWebACLAssociationRead(d *schema.ResourceData, meta interface{}) err
 
 	if output == nil || output.WebACLSummary == nil {
 		log.Printf("[WARN] WAF Regional Web ACL for resource (%s) not found, removing from state", resourceArn)
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -76,7 +76,7 @@ func sweepIPSets(region string) error {
 			d.Set("lock_token", ipSet.LockToken)
 			d.Set("name", ipSet.Name)
 			d.Set("scope", input.Scope)
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 
 			if err != nil {
 				sweeperErr := fmt.Errorf("error deleting WAFv2 IP Set (%s): %w", id, err)
@@ -128,7 +128,7 @@ func sweepRegexPatternSets(region string) error {
 			d.Set("lock_token", regexPatternSet.LockToken)
 			d.Set("name", regexPatternSet.Name)
 			d.Set("scope", input.Scope)
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 
 			if err != nil {
 				sweeperErr := fmt.Errorf("error deleting WAFv2 Regex Pattern Set (%s): %w", id, err)
@@ -180,7 +180,7 @@ func sweepRuleGroups(region string) error {
 			d.Set("lock_token", ruleGroup.LockToken)
 			d.Set("name", ruleGroup.Name)
 			d.Set("scope", input.Scope)
-			err := r.Delete(d, client)
+			err := sweep.DeleteResource(r, d, client)
 
 			if err != nil {
 				sweeperErr := fmt.Errorf("error deleting WAFv2 Rule Group (%s): %w", id, err)
@@ -98,6 +98,7 @@ func resourceWebACLAssociationRead(d *schema.ResourceData, meta interface{}) err
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, wafv2.ErrCodeWAFNonexistentItemException, "") {
 			log.Printf("[WARN] WAFv2 Web ACL (%s) not found, removing from state", webAclArn)
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -106,6 +107,7 @@ func resourceWebACLAssociationRead(d *schema.ResourceData, meta interface{}) err
 
 	if resp == nil || resp.WebACL == nil {
 		log.Printf("[WARN] WAFv2 Web ACL associated resource (%s) not found, removing from state", resourceArn)
+		//lintignore:AWSR003
 		d.SetId("")
 	}
 
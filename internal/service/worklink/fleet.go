@@ -170,6 +170,7 @@ func resourceFleetRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, worklink.ErrCodeResourceNotFoundException, "") {
 			log.Printf("[WARN] Worklink Fleet (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
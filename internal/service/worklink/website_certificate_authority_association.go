@@ -88,6 +88,7 @@ func resourceWebsiteCertificateAuthorityAssociationRead(d *schema.ResourceData,
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, worklink.ErrCodeResourceNotFoundException, "") {
 			log.Printf("[WARN] WorkLink Website Certificate Authority Association (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
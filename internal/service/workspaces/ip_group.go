@@ -93,6 +93,7 @@ func resourceIPGroupRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		if len(resp.Result) == 0 {
 			log.Printf("[WARN] WorkSpaces Ip Group (%s) not found, removing from state", d.Id())
+			//lintignore:AWSR003
 			d.SetId("")
 			return nil
 		}
@@ -104,6 +105,7 @@ func resourceIPGroupRead(d *schema.ResourceData, meta interface{}) error {
 
 	if len(ipGroups) == 0 {
 		log.Printf("[WARN] WorkSpaces Ip Group (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -203,6 +203,7 @@ func resourceWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	if state == workspaces.WorkspaceStateTerminated {
 		log.Printf("[WARN] workspace (%s) is not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -152,6 +152,7 @@ func resourceSamplingRuleRead(d *schema.ResourceData, meta interface{}) error {
 
 	if samplingRule == nil {
 		log.Printf("[WARN] XRay Sampling Rule (%s) not found, removing from state", d.Id())
+		//lintignore:AWSR003
 		d.SetId("")
 		return nil
 	}
@@ -0,0 +1,86 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync/atomic"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// ErrCodeRestrictedOperation is the error code returned for API calls refused by guardMutatingRequests.
+const ErrCodeRestrictedOperation = "SweepRestrictedOperation"
+
+// readOnlyOperationPrefixes are the prefixes of API operations that cannot modify resources.
+var readOnlyOperationPrefixes = []string{
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Search",
+}
+
+// deletionsInProgress counts the resources currently being deleted through DeleteResource or
+// SweepOrchestrator. Sweepers for a region run one after another, so any API call made while
+// it is non-zero is made by a resource's delete function.
+var deletionsInProgress int32
+
+// guardMutatingRequests makes every API call that may modify a resource fail unless it is made
+// by the delete function of a resource that passed the -sweep-dry-run and -sweep-allow-* checks.
+// Sweepers that call delete APIs directly cannot honor those flags, so they are refused instead
+// of deleting resources that the flags were meant to protect.
+func guardMutatingRequests(awsClient *conns.AWSClient) {
+	handler := request.NamedHandler{
+		Name: "tfsweep.GuardMutatingRequests",
+		Fn: func(r *request.Request) {
+			if atomic.LoadInt32(&deletionsInProgress) > 0 || isReadOnlyOperation(r.Operation.Name) {
+				return
+			}
+
+			r.Error = awserr.New(ErrCodeRestrictedOperation, fmt.Sprintf("%s %s refused: -sweep-dry-run and -sweep-allow-* are only supported by sweepers that delete resources with sweep.DeleteResource or sweep.SweepOrchestrator", r.ClientInfo.ServiceName, r.Operation.Name), nil)
+		},
+	}
+
+	v := reflect.ValueOf(awsClient).Elem()
+
+	for i := 0; i < v.NumField(); i++ {
+		if svc := serviceClient(v.Field(i)); svc != nil {
+			svc.Handlers.Validate.PushFrontNamed(handler)
+		}
+	}
+}
+
+// serviceClient returns the AWS SDK client embedded in an AWS SDK service client, e.g. *ec2.EC2.
+func serviceClient(v reflect.Value) *client.Client {
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+
+	field := v.Elem().FieldByName("Client")
+
+	if !field.IsValid() || !field.CanInterface() {
+		return nil
+	}
+
+	svc, _ := field.Interface().(*client.Client)
+
+	return svc
+}
+
+func isReadOnlyOperation(name string) bool {
+	for _, prefix := range readOnlyOperationPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,66 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestGuardMutatingRequests(t *testing.T) {
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Region:      aws.String("us-west-2"),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	client := &conns.AWSClient{EC2Conn: ec2.New(sess)}
+	guardMutatingRequests(client)
+
+	req, _ := client.EC2Conn.DescribeVpcsRequest(&ec2.DescribeVpcsInput{})
+
+	if err := req.Build(); err != nil {
+		t.Errorf("expected DescribeVpcs to be allowed, got: %s", err)
+	}
+
+	req, _ = client.EC2Conn.DeleteVpcRequest(&ec2.DeleteVpcInput{VpcId: aws.String("vpc-1")})
+
+	if err := req.Build(); !tfawserr.ErrCodeEquals(err, ErrCodeRestrictedOperation) {
+		t.Errorf("expected DeleteVpc to be refused, got: %v", err)
+	}
+
+	atomic.AddInt32(&deletionsInProgress, 1)
+	defer atomic.AddInt32(&deletionsInProgress, -1)
+
+	req, _ = client.EC2Conn.DeleteVpcRequest(&ec2.DeleteVpcInput{VpcId: aws.String("vpc-1")})
+
+	if err := req.Build(); err != nil {
+		t.Errorf("expected DeleteVpc to be allowed during DeleteResource, got: %s", err)
+	}
+}
+
+func TestIsReadOnlyOperation(t *testing.T) {
+	for name, expected := range map[string]bool{
+		"DescribeVpcs":      true,
+		"GetBucketPolicy":   true,
+		"ListTagsForStream": true,
+		"DeleteVpc":         false,
+		"TerminateInstance": false,
+		"UpdateFunction":    false,
+	} {
+		if got := isReadOnlyOperation(name); got != expected {
+			t.Errorf("%s: got %t, expected %t", name, got, expected)
+		}
+	}
+}
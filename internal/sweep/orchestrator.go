@@ -0,0 +1,347 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// dependencyViolationMaxDeferrals is the number of times a resource whose deletion
+	// fails with a DependencyViolation error is pushed back to a later wave before giving up.
+	dependencyViolationMaxDeferrals = 5
+)
+
+var (
+	flagSweepDryRun             = flag.Bool("sweep-dry-run", false, "List the resources that would be swept without deleting them")
+	flagSweepAllowNamePrefixes  = flag.String("sweep-allow-name-prefixes", "", "Comma-separated list of ID or name prefixes; when set, only matching resources are swept")
+	flagSweepAllowTags          = flag.String("sweep-allow-tags", "", "Comma-separated list of tag keys or key=value pairs; when set, only resources with a matching tag are swept")
+	flagSweepDependencyWaitTime = flag.Duration("sweep-dependency-wait", 30*time.Second, "Time to wait before retrying resources whose deletion failed with a DependencyViolation error")
+)
+
+// DryRun returns whether sweepers should only report what they would delete.
+func DryRun() bool {
+	return flagSweepDryRun != nil && *flagSweepDryRun
+}
+
+// Restricted returns whether the -sweep-dry-run or -sweep-allow-* flags limit what sweepers may delete.
+func Restricted() bool {
+	if DryRun() {
+		return true
+	}
+
+	return (flagSweepAllowNamePrefixes != nil && *flagSweepAllowNamePrefixes != "") || (flagSweepAllowTags != nil && *flagSweepAllowTags != "")
+}
+
+// Allowlist restricts sweeping to resources whose ID or name starts with one of
+// NamePrefixes and which carry one of Tags. An empty field imposes no restriction.
+type Allowlist struct {
+	NamePrefixes []string
+	Tags         map[string]string
+}
+
+// AllowlistFromFlags returns the Allowlist configured with the
+// -sweep-allow-name-prefixes and -sweep-allow-tags flags.
+func AllowlistFromFlags() (*Allowlist, error) {
+	allowlist := &Allowlist{}
+
+	if flagSweepAllowNamePrefixes != nil {
+		for _, v := range strings.Split(*flagSweepAllowNamePrefixes, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				allowlist.NamePrefixes = append(allowlist.NamePrefixes, v)
+			}
+		}
+	}
+
+	if flagSweepAllowTags != nil {
+		for _, v := range strings.Split(*flagSweepAllowTags, ",") {
+			if v = strings.TrimSpace(v); v == "" {
+				continue
+			}
+
+			if allowlist.Tags == nil {
+				allowlist.Tags = make(map[string]string)
+			}
+
+			key, value := v, ""
+			if i := strings.Index(v, "="); i >= 0 {
+				key, value = v[:i], v[i+1:]
+			}
+
+			if key == "" {
+				return nil, fmt.Errorf("invalid -sweep-allow-tags entry (%s): tag key must not be empty", v)
+			}
+
+			allowlist.Tags[key] = value
+		}
+	}
+
+	return allowlist, nil
+}
+
+// restricts returns whether the allowlist depends on the resource's attributes.
+func (a *Allowlist) restricts() bool {
+	return a != nil && (len(a.NamePrefixes) > 0 || len(a.Tags) > 0)
+}
+
+// Allows returns whether the resource may be swept.
+// The resource's name and tags are read from its state, see allowed.
+func (a *Allowlist) Allows(sweepResource *SweepResource) bool {
+	if a == nil {
+		return true
+	}
+
+	if len(a.NamePrefixes) > 0 && !a.allowsName(sweepResource) {
+		return false
+	}
+
+	if len(a.Tags) > 0 && !a.allowsTags(sweepResource) {
+		return false
+	}
+
+	return true
+}
+
+func (a *Allowlist) allowsName(sweepResource *SweepResource) bool {
+	candidates := []string{sweepResource.d.Id()}
+
+	for _, k := range []string{"name", "name_prefix"} {
+		if _, ok := sweepResource.resource.Schema[k]; !ok {
+			continue
+		}
+
+		if v, ok := sweepResource.d.Get(k).(string); ok && v != "" {
+			candidates = append(candidates, v)
+		}
+	}
+
+	for _, prefix := range a.NamePrefixes {
+		for _, candidate := range candidates {
+			if strings.HasPrefix(candidate, prefix) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (a *Allowlist) allowsTags(sweepResource *SweepResource) bool {
+	for _, k := range []string{"tags", "tags_all"} {
+		if _, ok := sweepResource.resource.Schema[k]; !ok {
+			continue
+		}
+
+		tags, ok := sweepResource.d.Get(k).(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		for key, value := range a.Tags {
+			v, ok := tags[key]
+
+			if !ok {
+				continue
+			}
+
+			if value == "" || v == value {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// allowed returns whether the resource may be swept, reading it first if the allowlist applies.
+// Sweepers usually only set the ID of the resources they find, so without reading them
+// their names and tags are not known. Resources which cannot be read are not swept.
+func (a *Allowlist) allowed(sweeper string, sweepResource *SweepResource) bool {
+	if !a.restricts() {
+		return true
+	}
+
+	id := sweepResource.d.Id()
+
+	if err := sweepResource.read(); err != nil {
+		log.Printf("[WARN] Skipping resource (%s): error reading resource for sweep allowlist: %s", id, err)
+		DefaultReport.Record(sweepResource.outcomeForID(sweeper, id, ActionSkipped, err))
+		return false
+	}
+
+	if sweepResource.d.Id() == "" {
+		log.Printf("[DEBUG] Skipping resource (%s): not found", id)
+		DefaultReport.Record(sweepResource.outcomeForID(sweeper, id, ActionSkipped, nil))
+		return false
+	}
+
+	if !a.Allows(sweepResource) {
+		log.Printf("[DEBUG] Skipping resource (%s): not in sweep allowlist", id)
+		DefaultReport.Record(sweepResource.outcome(sweeper, ActionSkipped, nil))
+		return false
+	}
+
+	return true
+}
+
+// sweepWaves orders sweepResources into waves. Every resource in a wave has had
+// all of its dependencies placed in an earlier wave. Dependencies that are not
+// themselves being swept are ignored.
+func sweepWaves(sweepResources []*SweepResource) ([][]*SweepResource, error) {
+	inSweep := make(map[*SweepResource]bool, len(sweepResources))
+	for _, sweepResource := range sweepResources {
+		inSweep[sweepResource] = true
+	}
+
+	remaining := make(map[*SweepResource]int, len(sweepResources))
+	dependents := make(map[*SweepResource][]*SweepResource)
+
+	for _, sweepResource := range sweepResources {
+		if _, ok := remaining[sweepResource]; ok {
+			continue
+		}
+
+		remaining[sweepResource] = 0
+
+		for _, dependency := range sweepResource.dependencies {
+			if !inSweep[dependency] || dependency == sweepResource {
+				continue
+			}
+
+			remaining[sweepResource]++
+			dependents[dependency] = append(dependents[dependency], sweepResource)
+		}
+	}
+
+	var waves [][]*SweepResource
+	var wave []*SweepResource
+	seen := make(map[*SweepResource]bool, len(sweepResources))
+
+	for _, sweepResource := range sweepResources {
+		if remaining[sweepResource] == 0 && !seen[sweepResource] {
+			seen[sweepResource] = true
+			wave = append(wave, sweepResource)
+		}
+	}
+
+	placed := 0
+
+	for len(wave) > 0 {
+		waves = append(waves, wave)
+		placed += len(wave)
+
+		var next []*SweepResource
+
+		for _, sweepResource := range wave {
+			for _, dependent := range dependents[sweepResource] {
+				remaining[dependent]--
+
+				if remaining[dependent] == 0 {
+					next = append(next, dependent)
+				}
+			}
+		}
+
+		wave = next
+	}
+
+	if placed != len(remaining) {
+		var ids []string
+
+		for sweepResource, n := range remaining {
+			if n > 0 {
+				ids = append(ids, sweepResource.d.Id())
+			}
+		}
+
+		return nil, fmt.Errorf("dependency cycle between sweep resources: %s", strings.Join(ids, ", "))
+	}
+
+	return waves, nil
+}
+
+// isDependencyViolation returns whether err indicates that the resource is still
+// in use by another resource and may be deleted once that resource is gone.
+func isDependencyViolation(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "DependencyViolation")
+}
+
+func (sr *SweepResource) delete(ctx context.Context, delay time.Duration, delayRand time.Duration, minTimeout time.Duration, pollInterval time.Duration, timeout time.Duration) error {
+	err := tfresource.RetryConfigContext(ctx, delay, delayRand, minTimeout, pollInterval, timeout, func() *resource.RetryError {
+		err := deleteResource(sr.resource, sr.d, sr.meta)
+
+		if err != nil {
+			if strings.Contains(err.Error(), "Throttling") {
+				log.Printf("[INFO] While sweeping resource (%s), encountered throttling error (%s). Retrying...", sr.d.Id(), err)
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		err = deleteResource(sr.resource, sr.d, sr.meta)
+	}
+
+	return err
+}
+
+// sweepWave deletes the resources in a wave concurrently.
+// Resources whose deletion failed with a DependencyViolation error are returned
+// separately from other errors so that they can be retried in a later wave.
+func sweepWave(ctx context.Context, sweeper string, wave []*SweepResource, delay time.Duration, delayRand time.Duration, minTimeout time.Duration, pollInterval time.Duration, timeout time.Duration) ([]*SweepResource, error) {
+	var g multierror.Group
+	violations := make([]error, len(wave))
+
+	for i, sweepResource := range wave {
+		i, sweepResource := i, sweepResource
+
+		g.Go(func() error {
+			err := sweepResource.delete(ctx, delay, delayRand, minTimeout, pollInterval, timeout)
+
+			if isDependencyViolation(err) && sweepResource.deferrals < dependencyViolationMaxDeferrals {
+				violations[i] = err
+				return nil
+			}
+
+			if err != nil {
+				DefaultReport.Record(sweepResource.outcome(sweeper, ActionFailed, err))
+			} else {
+				DefaultReport.Record(sweepResource.outcome(sweeper, ActionDeleted, nil))
+			}
+
+			return err
+		})
+	}
+
+	err := g.Wait().ErrorOrNil()
+
+	var deferred []*SweepResource
+
+	for i, violation := range violations {
+		if violation == nil {
+			continue
+		}
+
+		sweepResource := wave[i]
+		sweepResource.deferrals++
+		log.Printf("[INFO] While sweeping resource (%s), encountered dependency violation (%s). Deferring...", sweepResource.d.Id(), violation)
+		deferred = append(deferred, sweepResource)
+	}
+
+	return deferred, err
+}
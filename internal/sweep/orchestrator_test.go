@@ -0,0 +1,264 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testSweepResource(t *testing.T, id string, attrs map[string]interface{}) *SweepResource {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+	d := r.Data(nil)
+	d.SetId(id)
+
+	for k, v := range attrs {
+		if err := d.Set(k, v); err != nil {
+			t.Fatalf("error setting %s: %s", k, err)
+		}
+	}
+
+	return NewSweepResource(r, d, nil)
+}
+
+func TestSweepWaves(t *testing.T) {
+	eni := testSweepResource(t, "eni-1", nil)
+	nat := testSweepResource(t, "nat-1", nil)
+	subnet := testSweepResource(t, "subnet-1", nil).DependsOn(eni, nat)
+	vpc := testSweepResource(t, "vpc-1", nil).DependsOn(subnet, eni)
+	unrelated := testSweepResource(t, "sg-1", nil).DependsOn(testSweepResource(t, "not-swept", nil))
+
+	waves, err := sweepWaves([]*SweepResource{vpc, subnet, eni, nat, unrelated})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var got [][]string
+
+	for _, wave := range waves {
+		var ids []string
+
+		for _, sweepResource := range wave {
+			ids = append(ids, sweepResource.d.Id())
+		}
+
+		got = append(got, ids)
+	}
+
+	want := [][]string{{"eni-1", "nat-1", "sg-1"}, {"subnet-1"}, {"vpc-1"}}
+
+	if len(got) != len(want) {
+		t.Fatalf("got waves %v, want %v", got, want)
+	}
+
+	for i := range want {
+		if len(got[i]) != len(want[i]) {
+			t.Fatalf("got waves %v, want %v", got, want)
+		}
+
+		for j := range want[i] {
+			if got[i][j] != want[i][j] {
+				t.Fatalf("got waves %v, want %v", got, want)
+			}
+		}
+	}
+}
+
+func TestSweepWaves_cycle(t *testing.T) {
+	a := testSweepResource(t, "a", nil)
+	b := testSweepResource(t, "b", nil).DependsOn(a)
+	a.DependsOn(b)
+
+	if _, err := sweepWaves([]*SweepResource{a, b}); err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
+
+func TestAllowlistAllows(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		Allowlist *Allowlist
+		Resource  *SweepResource
+		Expected  bool
+	}{
+		{
+			Name:      "nil allowlist",
+			Allowlist: nil,
+			Resource:  testSweepResource(t, "prod-1", nil),
+			Expected:  true,
+		},
+		{
+			Name:      "empty allowlist",
+			Allowlist: &Allowlist{},
+			Resource:  testSweepResource(t, "prod-1", nil),
+			Expected:  true,
+		},
+		{
+			Name:      "ID prefix",
+			Allowlist: &Allowlist{NamePrefixes: []string{"tf-acc-test"}},
+			Resource:  testSweepResource(t, "tf-acc-test-1234", nil),
+			Expected:  true,
+		},
+		{
+			Name:      "name prefix",
+			Allowlist: &Allowlist{NamePrefixes: []string{"tf-acc-test"}},
+			Resource:  testSweepResource(t, "vpc-1234", map[string]interface{}{"name": "tf-acc-test-1234"}),
+			Expected:  true,
+		},
+		{
+			Name:      "no prefix match",
+			Allowlist: &Allowlist{NamePrefixes: []string{"tf-acc-test"}},
+			Resource:  testSweepResource(t, "vpc-1234", map[string]interface{}{"name": "prod"}),
+			Expected:  false,
+		},
+		{
+			Name:      "tag key",
+			Allowlist: &Allowlist{Tags: map[string]string{"Sweepable": ""}},
+			Resource:  testSweepResource(t, "vpc-1234", map[string]interface{}{"tags": map[string]interface{}{"Sweepable": "yes"}}),
+			Expected:  true,
+		},
+		{
+			Name:      "tag key and value",
+			Allowlist: &Allowlist{Tags: map[string]string{"Owner": "ci"}},
+			Resource:  testSweepResource(t, "vpc-1234", map[string]interface{}{"tags": map[string]interface{}{"Owner": "someone"}}),
+			Expected:  false,
+		},
+		{
+			Name:      "untagged",
+			Allowlist: &Allowlist{Tags: map[string]string{"Owner": "ci"}},
+			Resource:  testSweepResource(t, "vpc-1234", nil),
+			Expected:  false,
+		},
+		{
+			Name:      "prefix and tag",
+			Allowlist: &Allowlist{NamePrefixes: []string{"tf-acc-test"}, Tags: map[string]string{"Owner": "ci"}},
+			Resource:  testSweepResource(t, "tf-acc-test-1234", map[string]interface{}{"tags": map[string]interface{}{"Owner": "someone"}}),
+			Expected:  false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := testCase.Allowlist.Allows(testCase.Resource); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestIsDependencyViolation(t *testing.T) {
+	if !isDependencyViolation(errors.New("error deleting resource: DependencyViolation: resource has a dependent object")) {
+		t.Error("expected DependencyViolation error to match")
+	}
+
+	if isDependencyViolation(errors.New("error deleting resource: InvalidVpcID.NotFound")) {
+		t.Error("expected other error not to match")
+	}
+
+	if isDependencyViolation(nil) {
+		t.Error("expected nil error not to match")
+	}
+}
+
+func TestDeleteResource(t *testing.T) {
+	defer func(dryRun bool, namePrefixes string) {
+		*flagSweepDryRun = dryRun
+		*flagSweepAllowNamePrefixes = namePrefixes
+	}(*flagSweepDryRun, *flagSweepAllowNamePrefixes)
+
+	testCases := []struct {
+		Name           string
+		DryRun         bool
+		NamePrefixes   string
+		ID             string
+		ExpectedAction string
+		ExpectedDelete bool
+	}{
+		{
+			Name:           "unrestricted",
+			ID:             "tf-acc-test-1234",
+			ExpectedAction: ActionDeleted,
+			ExpectedDelete: true,
+		},
+		{
+			Name:           "dry run",
+			DryRun:         true,
+			ID:             "tf-acc-test-1234",
+			ExpectedAction: ActionWouldDelete,
+		},
+		{
+			Name:           "allowed",
+			NamePrefixes:   "tf-acc-test",
+			ID:             "tf-acc-test-1234",
+			ExpectedAction: ActionDeleted,
+			ExpectedDelete: true,
+		},
+		{
+			Name:           "not allowed",
+			NamePrefixes:   "tf-acc-test",
+			ID:             "prod-1234",
+			ExpectedAction: ActionSkipped,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			*flagSweepDryRun = testCase.DryRun
+			*flagSweepAllowNamePrefixes = testCase.NamePrefixes
+
+			deleted := false
+			r := &schema.Resource{
+				Delete: func(d *schema.ResourceData, meta interface{}) error {
+					deleted = true
+					return nil
+				},
+			}
+			d := r.Data(nil)
+			d.SetId(testCase.ID)
+
+			n := len(DefaultReport.Outcomes())
+
+			if err := DeleteResource(r, d, nil); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if deleted != testCase.ExpectedDelete {
+				t.Errorf("got deleted %t, expected %t", deleted, testCase.ExpectedDelete)
+			}
+
+			outcomes := DefaultReport.Outcomes()[n:]
+
+			if len(outcomes) != 1 {
+				t.Fatalf("got %d outcomes, expected 1", len(outcomes))
+			}
+
+			if got := outcomes[0].Action; got != testCase.ExpectedAction {
+				t.Errorf("got action %s, expected %s", got, testCase.ExpectedAction)
+			}
+
+			if got := outcomes[0].ID; got != testCase.ID {
+				t.Errorf("got ID %s, expected %s", got, testCase.ID)
+			}
+		})
+	}
+}
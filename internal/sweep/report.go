@@ -0,0 +1,415 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Actions recorded for sweep outcomes.
+const (
+	ActionDeleted     = "deleted"
+	ActionFailed      = "failed"
+	ActionSkipped     = "skipped"
+	ActionWouldDelete = "would-delete"
+)
+
+var (
+	flagSweepReport      = flag.String("sweep-report", "", "Path to write a JSON lines report of per-resource sweep outcomes to")
+	flagSweepJUnitReport = flag.String("sweep-junit-report", "", "Path to write a JUnit XML report of per-resource sweep outcomes to")
+)
+
+// Outcome is the result of sweeping a single resource, or of skipping a sweeper
+// altogether when SkipSweepError returns true.
+type Outcome struct {
+	Sweeper    string    `json:"sweeper"`
+	Type       string    `json:"type,omitempty"`
+	ID         string    `json:"id,omitempty"`
+	Region     string    `json:"region,omitempty"`
+	Action     string    `json:"action"`
+	ErrorClass string    `json:"error_class,omitempty"`
+	Error      string    `json:"error,omitempty"`
+	Time       time.Time `json:"time"`
+}
+
+// Report collects sweep outcomes for the whole run.
+// Outcomes are also written to the report's writers as they are recorded, so that reports
+// are complete even if the test binary exits early after a failed sweeper.
+type Report struct {
+	mutex    sync.Mutex
+	outcomes []Outcome
+
+	openWriters func() ([]outcomeWriter, error)
+	writers     []outcomeWriter
+}
+
+// DefaultReport is the Report that sweep outcomes are recorded in.
+// It is written to the paths given by the -sweep-report and -sweep-junit-report flags.
+var DefaultReport = &Report{
+	openWriters: openFlagWriters,
+}
+
+var (
+	currentRegion string
+
+	resourceTypesMutex sync.RWMutex
+	resourceTypes      = make(map[uintptr]string)
+)
+
+// RegisterResourceTypes makes resource type names available to the report.
+// Resources are identified by their delete function, as sweepers construct
+// fresh *schema.Resource values.
+func RegisterResourceTypes(resources map[string]*schema.Resource) {
+	resourceTypesMutex.Lock()
+	defer resourceTypesMutex.Unlock()
+
+	names := make([]string, 0, len(resources))
+	for name := range resources {
+		names = append(names, name)
+	}
+	// Aliases such as aws_alb and aws_lb share a delete function; the first name wins.
+	sort.Strings(names)
+
+	for _, name := range names {
+		if key, ok := deleteFuncKey(resources[name]); ok {
+			if _, ok := resourceTypes[key]; !ok {
+				resourceTypes[key] = name
+			}
+		}
+	}
+}
+
+func deleteFuncKey(r *schema.Resource) (uintptr, bool) {
+	switch {
+	case r == nil:
+		return 0, false
+	case r.DeleteContext != nil:
+		return reflect.ValueOf(r.DeleteContext).Pointer(), true
+	case r.DeleteWithoutTimeout != nil:
+		return reflect.ValueOf(r.DeleteWithoutTimeout).Pointer(), true
+	case r.Delete != nil:
+		return reflect.ValueOf(r.Delete).Pointer(), true
+	}
+
+	return 0, false
+}
+
+func resourceTypeName(r *schema.Resource) string {
+	key, ok := deleteFuncKey(r)
+
+	if !ok {
+		return ""
+	}
+
+	resourceTypesMutex.RLock()
+	defer resourceTypesMutex.RUnlock()
+
+	return resourceTypes[key]
+}
+
+// Record adds an outcome to the report.
+func (r *Report) Record(outcome Outcome) {
+	if outcome.Time.IsZero() {
+		outcome.Time = time.Now().UTC()
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.outcomes = append(r.outcomes, outcome)
+
+	if r.openWriters != nil {
+		writers, err := r.openWriters()
+
+		if err != nil {
+			log.Printf("[WARN] %s", err)
+		}
+
+		r.openWriters = nil
+		r.writers = writers
+	}
+
+	for _, w := range r.writers {
+		if err := w.write(outcome); err != nil {
+			log.Printf("[WARN] %s", err)
+		}
+	}
+}
+
+// Outcomes returns a copy of the recorded outcomes.
+func (r *Report) Outcomes() []Outcome {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	outcomes := make([]Outcome, len(r.outcomes))
+	copy(outcomes, r.outcomes)
+
+	return outcomes
+}
+
+// Summary counts outcomes by action.
+func (r *Report) Summary() map[string]int {
+	summary := make(map[string]int)
+
+	for _, outcome := range r.Outcomes() {
+		summary[outcome.Action]++
+	}
+
+	return summary
+}
+
+// outcomeWriter writes each outcome to a report as it is recorded.
+type outcomeWriter interface {
+	write(outcome Outcome) error
+}
+
+// openFlagWriters creates the reports given by the -sweep-report and -sweep-junit-report flags.
+func openFlagWriters() ([]outcomeWriter, error) {
+	var writers []outcomeWriter
+
+	if flagSweepReport != nil && *flagSweepReport != "" {
+		f, err := os.Create(*flagSweepReport)
+
+		if err != nil {
+			return writers, fmt.Errorf("error creating sweep report (%s): %w", *flagSweepReport, err)
+		}
+
+		writers = append(writers, &jsonLinesWriter{w: f, name: *flagSweepReport})
+	}
+
+	if flagSweepJUnitReport != nil && *flagSweepJUnitReport != "" {
+		f, err := os.Create(*flagSweepJUnitReport)
+
+		if err != nil {
+			return writers, fmt.Errorf("error creating sweep JUnit report (%s): %w", *flagSweepJUnitReport, err)
+		}
+
+		w, err := newJUnitWriter(f, *flagSweepJUnitReport)
+
+		if err != nil {
+			return writers, err
+		}
+
+		writers = append(writers, w)
+	}
+
+	return writers, nil
+}
+
+// jsonLinesWriter writes outcomes as JSON lines, one JSON object per outcome.
+type jsonLinesWriter struct {
+	w    io.Writer
+	name string
+}
+
+func (w *jsonLinesWriter) write(outcome Outcome) error {
+	output, err := json.Marshal(outcome)
+
+	if err != nil {
+		return fmt.Errorf("error generating sweep report: %w", err)
+	}
+
+	if _, err := w.w.Write(append(output, '\n')); err != nil {
+		return fmt.Errorf("error writing sweep report (%s): %w", w.name, err)
+	}
+
+	return nil
+}
+
+type junitTestCase struct {
+	XMLName   xml.Name      `xml:"testcase"`
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+}
+
+type junitMessage struct {
+	Type    string `xml:"type,attr,omitempty"`
+	Message string `xml:"message,attr"`
+}
+
+const (
+	junitSuitesStart = xml.Header + "<testsuites>\n"
+	junitSuitesEnd   = "</testsuites>\n"
+	junitSuiteEnd    = "  </testsuite>\n"
+)
+
+// junitWriter writes outcomes as a JUnit XML document with a test suite for each region.
+// Each outcome is written over the closing tags, which are then written again,
+// so that the document is valid after every write.
+type junitWriter struct {
+	w      io.WriteSeeker
+	name   string
+	offset int64
+	region *string
+}
+
+func newJUnitWriter(w io.WriteSeeker, name string) (*junitWriter, error) {
+	if _, err := io.WriteString(w, junitSuitesStart+junitSuitesEnd); err != nil {
+		return nil, fmt.Errorf("error writing sweep JUnit report (%s): %w", name, err)
+	}
+
+	return &junitWriter{
+		w:      w,
+		name:   name,
+		offset: int64(len(junitSuitesStart)),
+	}, nil
+}
+
+func (w *junitWriter) write(outcome Outcome) error {
+	className := outcome.Type
+	if className == "" {
+		className = outcome.Sweeper
+	}
+
+	testCase := junitTestCase{
+		Name:      outcome.ID,
+		ClassName: className,
+	}
+
+	if testCase.Name == "" {
+		testCase.Name = outcome.Sweeper
+	}
+
+	switch outcome.Action {
+	case ActionFailed:
+		testCase.Failure = &junitMessage{Type: outcome.ErrorClass, Message: outcome.Error}
+	case ActionSkipped:
+		testCase.Skipped = &junitMessage{Type: outcome.ErrorClass, Message: outcome.Error}
+	}
+
+	output, err := xml.MarshalIndent(testCase, "    ", "  ")
+
+	if err != nil {
+		return fmt.Errorf("error generating sweep JUnit report: %w", err)
+	}
+
+	var content bytes.Buffer
+
+	if w.region == nil || *w.region != outcome.Region {
+		if w.region != nil {
+			content.WriteString(junitSuiteEnd)
+		}
+
+		region := outcome.Region
+		w.region = &region
+
+		fmt.Fprintf(&content, "  <testsuite name=\"%s\">\n", xmlEscape(region))
+	}
+
+	content.Write(output)
+	content.WriteString("\n")
+
+	if _, err := w.w.Seek(w.offset, io.SeekStart); err != nil {
+		return fmt.Errorf("error writing sweep JUnit report (%s): %w", w.name, err)
+	}
+
+	if _, err := w.w.Write(append(content.Bytes(), junitSuiteEnd+junitSuitesEnd...)); err != nil {
+		return fmt.Errorf("error writing sweep JUnit report (%s): %w", w.name, err)
+	}
+
+	w.offset += int64(content.Len())
+
+	return nil
+}
+
+func xmlEscape(s string) string {
+	var buf bytes.Buffer
+
+	xml.EscapeText(&buf, []byte(s)) //nolint:errcheck
+
+	return buf.String()
+}
+
+// ErrorClass returns a short classification of a sweep error, usually the AWS error code.
+func ErrorClass(err error) string {
+	var awsErr awserr.Error
+
+	switch {
+	case err == nil:
+		return ""
+	case errors.As(err, &awsErr):
+		return awsErr.Code()
+	case tfresource.TimedOut(err):
+		return "Timeout"
+	case isDependencyViolation(err):
+		return "DependencyViolation"
+	case strings.Contains(err.Error(), "Throttling"):
+		return "Throttling"
+	}
+
+	return "Unknown"
+}
+
+// sweeperName returns the name of the service sweeper function that called into this package,
+// e.g. "ec2.sweepVPCs".
+func sweeperName() string {
+	pcs := make([]uintptr, 16)
+	n := runtime.Callers(2, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	for {
+		frame, more := frames.Next()
+
+		if !strings.Contains(frame.Function, "/internal/sweep.") {
+			name := frame.Function
+
+			if i := strings.LastIndex(name, "/"); i >= 0 {
+				name = name[i+1:]
+			}
+
+			return name
+		}
+
+		if !more {
+			return ""
+		}
+	}
+}
+
+func (sr *SweepResource) outcome(sweeper string, action string, err error) Outcome {
+	return sr.outcomeForID(sweeper, sr.d.Id(), action, err)
+}
+
+// outcomeForID returns the outcome for the resource with the given ID, e.g. if reading the resource cleared its ID.
+func (sr *SweepResource) outcomeForID(sweeper string, id string, action string, err error) Outcome {
+	outcome := Outcome{
+		Sweeper: sweeper,
+		Type:    resourceTypeName(sr.resource),
+		ID:      id,
+		Region:  currentRegion,
+		Action:  action,
+	}
+
+	if client, ok := sr.meta.(*conns.AWSClient); ok && client.Region != "" {
+		outcome.Region = client.Region
+	}
+
+	if err != nil {
+		outcome.ErrorClass = ErrorClass(err)
+		outcome.Error = err.Error()
+	}
+
+	return outcome
+}
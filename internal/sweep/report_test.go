@@ -0,0 +1,173 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestErrorClass(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Err      error
+		Expected string
+	}{
+		{
+			Name:     "nil",
+			Err:      nil,
+			Expected: "",
+		},
+		{
+			Name:     "AWS error",
+			Err:      fmt.Errorf("error deleting VPC: %w", awserr.New("InvalidVpcID.NotFound", "not found", nil)),
+			Expected: "InvalidVpcID.NotFound",
+		},
+		{
+			Name:     "dependency violation",
+			Err:      errors.New("error deleting resource: DependencyViolation: resource has a dependent object"),
+			Expected: "DependencyViolation",
+		},
+		{
+			Name:     "throttling",
+			Err:      errors.New("error deleting resource: Throttling: Rate exceeded"),
+			Expected: "Throttling",
+		},
+		{
+			Name:     "other",
+			Err:      errors.New("boom"),
+			Expected: "Unknown",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := ErrorClass(testCase.Err); got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestReport(t *testing.T) {
+	var jsonLines bytes.Buffer
+	junitFilename := filepath.Join(t.TempDir(), "report.xml")
+	junitFile, err := os.Create(junitFilename)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer junitFile.Close()
+
+	junit, err := newJUnitWriter(junitFile, junitFilename)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	report := &Report{
+		writers: []outcomeWriter{&jsonLinesWriter{w: &jsonLines}, junit},
+	}
+
+	for _, outcome := range []Outcome{
+		{Sweeper: "ec2.sweepVPCs", Type: "aws_vpc", ID: "vpc-1", Region: "us-west-2", Action: ActionDeleted},
+		{Sweeper: "ec2.sweepVPCs", Type: "aws_vpc", ID: "vpc-2", Region: "us-west-2", Action: ActionFailed, ErrorClass: "DependencyViolation", Error: "in use"},
+		{Sweeper: "appstream.sweepFleets", Region: "us-east-1", Action: ActionSkipped, ErrorClass: "AccessDeniedException", Error: "denied"},
+	} {
+		report.Record(outcome)
+
+		// The JUnit report is a valid document after every outcome.
+		output, err := ioutil.ReadFile(junitFilename)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var document struct {
+			Suites []struct {
+				Name      string `xml:"name,attr"`
+				TestCases []struct {
+					Name string `xml:"name,attr"`
+				} `xml:"testcase"`
+			} `xml:"testsuite"`
+		}
+
+		if err := xml.Unmarshal(output, &document); err != nil {
+			t.Fatalf("invalid JUnit report after %s: %s\n%s", outcome.ID, err, output)
+		}
+	}
+
+	if got, expected := report.Summary(), map[string]int{ActionDeleted: 1, ActionFailed: 1, ActionSkipped: 1}; len(got) != len(expected) || got[ActionFailed] != 1 || got[ActionDeleted] != 1 || got[ActionSkipped] != 1 {
+		t.Errorf("got summary %v, expected %v", got, expected)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(jsonLines.String(), "\n"), "\n")
+
+	if len(lines) != 3 {
+		t.Fatalf("got %d JSON lines, expected 3:\n%s", len(lines), jsonLines.String())
+	}
+
+	var decoded Outcome
+
+	if err := json.Unmarshal([]byte(lines[1]), &decoded); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if decoded.ID != "vpc-2" || decoded.ErrorClass != "DependencyViolation" || decoded.Time.IsZero() {
+		t.Errorf("unexpected outcome: %s", lines[1])
+	}
+
+	output, err := ioutil.ReadFile(junitFilename)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, expected := range []string{
+		`<testsuite name="us-west-2">`,
+		`<testsuite name="us-east-1">`,
+		`<testcase name="vpc-2" classname="aws_vpc">`,
+		`<failure type="DependencyViolation" message="in use"></failure>`,
+		`<testcase name="appstream.sweepFleets" classname="appstream.sweepFleets">`,
+	} {
+		if !strings.Contains(string(output), expected) {
+			t.Errorf("expected JUnit report to contain %q, got:\n%s", expected, output)
+		}
+	}
+
+	if !strings.HasSuffix(string(output), "  </testsuite>\n</testsuites>\n") {
+		t.Errorf("expected JUnit report to end with closing tags, got:\n%s", output)
+	}
+}
+
+func TestResourceTypeName(t *testing.T) {
+	deleteFunc := func(d *schema.ResourceData, meta interface{}) error { return nil }
+
+	RegisterResourceTypes(map[string]*schema.Resource{
+		"aws_test_thing": {Delete: deleteFunc},
+		"aws_test_alias": {Delete: deleteFunc},
+	})
+
+	if got, expected := resourceTypeName(&schema.Resource{Delete: deleteFunc}), "aws_test_alias"; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+
+	if got := resourceTypeName(&schema.Resource{}); got != "" {
+		t.Errorf("got %s, expected empty type name", got)
+	}
+}
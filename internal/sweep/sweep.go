@@ -9,17 +9,15 @@ import (
 	"log"
 	"os"
 	"strconv"
-	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -37,6 +35,8 @@ var SweeperClients map[string]interface{}
 // SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper
 // functions for a given region
 func SharedRegionalSweepClient(region string) (interface{}, error) {
+	currentRegion = region
+
 	if client, ok := SweeperClients[region]; ok {
 		return client, nil
 	}
@@ -59,24 +59,28 @@ func SharedRegionalSweepClient(region string) (interface{}, error) {
 	}
 
 	if role := os.Getenv(conns.EnvVarAssumeRoleARN); role != "" {
-		conf.AssumeRoleARN = role
+		assumeRole := &conns.AssumeRole{
+			DurationSeconds: defaultSweeperAssumeRoleDurationSeconds,
+			RoleARN:         role,
+		}
 
-		conf.AssumeRoleDurationSeconds = defaultSweeperAssumeRoleDurationSeconds
 		if v := os.Getenv(conns.EnvVarAssumeRoleDuration); v != "" {
 			d, err := strconv.Atoi(v)
 			if err != nil {
 				return nil, fmt.Errorf("environment variable %s: %w", conns.EnvVarAssumeRoleDuration, err)
 			}
-			conf.AssumeRoleDurationSeconds = d
+			assumeRole.DurationSeconds = d
 		}
 
 		if v := os.Getenv(conns.EnvVarAssumeRoleExternalID); v != "" {
-			conf.AssumeRoleExternalID = v
+			assumeRole.ExternalID = v
 		}
 
 		if v := os.Getenv(conns.EnvVarAssumeRoleSessionName); v != "" {
-			conf.AssumeRoleSessionName = v
+			assumeRole.SessionName = v
 		}
+
+		conf.AssumeRole = append(conf.AssumeRole, assumeRole)
 	}
 
 	// configures a default client for the region, using the above env vars
@@ -85,6 +89,10 @@ func SharedRegionalSweepClient(region string) (interface{}, error) {
 		return nil, fmt.Errorf("error getting AWS client: %w", err)
 	}
 
+	if Restricted() {
+		guardMutatingRequests(client.(*conns.AWSClient))
+	}
+
 	SweeperClients[region] = client
 
 	return client, nil
@@ -94,6 +102,9 @@ type SweepResource struct {
 	d        *schema.ResourceData
 	meta     interface{}
 	resource *schema.Resource
+
+	dependencies []*SweepResource
+	deferrals    int
 }
 
 func NewSweepResource(resource *schema.Resource, d *schema.ResourceData, meta interface{}) *SweepResource {
@@ -104,46 +115,118 @@ func NewSweepResource(resource *schema.Resource, d *schema.ResourceData, meta in
 	}
 }
 
+// DependsOn declares that the given resources must be deleted before this one,
+// e.g. a VPC depends on its network interfaces and NAT gateways.
+func (sr *SweepResource) DependsOn(dependencies ...*SweepResource) *SweepResource {
+	sr.dependencies = append(sr.dependencies, dependencies...)
+
+	return sr
+}
+
+// ID returns the ID of the resource to be swept.
+func (sr *SweepResource) ID() string {
+	return sr.d.Id()
+}
+
+// Dependencies returns the resources declared with DependsOn.
+func (sr *SweepResource) Dependencies() []*SweepResource {
+	return sr.dependencies
+}
+
 func SweepOrchestrator(sweepResources []*SweepResource) error {
 	return SweepOrchestratorContext(context.Background(), sweepResources, 0*time.Millisecond, 0*time.Millisecond, 0*time.Millisecond, 0*time.Millisecond, SweepThrottlingRetryTimeout)
 }
 
+// SweepOrchestratorContext deletes sweepResources in waves ordered by their declared dependencies.
+// Resources in a wave are deleted concurrently and resources that fail with a DependencyViolation
+// error are retried in a later wave. Only resources allowed by the -sweep-allow-* flags are swept
+// and nothing is deleted when -sweep-dry-run is set. The outcome for each resource is recorded
+// in DefaultReport.
 func SweepOrchestratorContext(ctx context.Context, sweepResources []*SweepResource, delay time.Duration, delayRand time.Duration, minTimeout time.Duration, pollInterval time.Duration, timeout time.Duration) error {
-	var g multierror.Group
+	sweeper := sweeperName()
+
+	allowlist, err := AllowlistFromFlags()
+
+	if err != nil {
+		return err
+	}
+
+	allowed := make([]*SweepResource, 0, len(sweepResources))
 
 	for _, sweepResource := range sweepResources {
-		sweepResource := sweepResource
+		if !allowlist.allowed(sweeper, sweepResource) {
+			continue
+		}
+
+		allowed = append(allowed, sweepResource)
+	}
+
+	waves, err := sweepWaves(allowed)
+
+	if err != nil {
+		return err
+	}
 
-		g.Go(func() error {
-			err := tfresource.RetryConfigContext(ctx, delay, delayRand, minTimeout, pollInterval, timeout, func() *resource.RetryError {
-				err := DeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
+	if DryRun() {
+		for i, wave := range waves {
+			for _, sweepResource := range wave {
+				log.Printf("[INFO] Dry run: would sweep resource (%s) in wave %d", sweepResource.d.Id(), i+1)
+				DefaultReport.Record(sweepResource.outcome(sweeper, ActionWouldDelete, nil))
+			}
+		}
 
-				if err != nil {
-					if strings.Contains(err.Error(), "Throttling") {
-						log.Printf("[INFO] While sweeping resource (%s), encountered throttling error (%s). Retrying...", sweepResource.d.Id(), err)
-						return resource.RetryableError(err)
-					}
+		return nil
+	}
 
-					return resource.NonRetryableError(err)
-				}
+	var errs *multierror.Error
+	var deferred []*SweepResource
 
-				return nil
-			})
+	for i := 0; i < len(waves) || len(deferred) > 0; i++ {
+		var wave []*SweepResource
 
-			if tfresource.TimedOut(err) {
-				err = DeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
+		if i < len(waves) {
+			wave = append(wave, waves[i]...)
+		} else {
+			// Only deferred resources remain. Give the dependency time to go away.
+			select {
+			case <-ctx.Done():
+				return multierror.Append(errs, ctx.Err()).ErrorOrNil()
+			case <-time.After(*flagSweepDependencyWaitTime):
 			}
+		}
+
+		wave = append(wave, deferred...)
+
+		deferred, err = sweepWave(ctx, sweeper, wave, delay, delayRand, minTimeout, pollInterval, timeout)
 
-			return err
-		})
+		if err != nil {
+			errs = multierror.Append(errs, err)
+		}
 	}
 
-	return g.Wait().ErrorOrNil()
+	return errs.ErrorOrNil()
 }
 
 // Check sweeper API call error for reasons to skip sweeping
 // These include missing API endpoints and unsupported API calls
+// Skipped errors are recorded in DefaultReport.
 func SkipSweepError(err error) bool {
+	if !skipSweepError(err) {
+		return false
+	}
+
+	DefaultReport.Record(Outcome{
+		Sweeper:    sweeperName(),
+		Region:     currentRegion,
+		Action:     ActionSkipped,
+		ErrorClass: ErrorClass(err),
+		Error:      err.Error(),
+	})
+
+	return true
+}
+
+func skipSweepError(err error) bool {
 	// Ignore missing API endpoints
 	if tfawserr.ErrMessageContains(err, "RequestError", "send request failed") {
 		return true
@@ -195,7 +278,66 @@ func SkipSweepError(err error) bool {
 	return false
 }
 
+// DeleteResource deletes a single resource on behalf of a sweeper that does not use SweepOrchestrator.
+// Resources not allowed by the -sweep-allow-* flags are skipped, nothing is deleted when -sweep-dry-run
+// is set and the outcome is recorded in DefaultReport.
 func DeleteResource(resource *schema.Resource, d *schema.ResourceData, meta interface{}) error {
+	sweeper := sweeperName()
+	sweepResource := NewSweepResource(resource, d, meta)
+
+	allowlist, err := AllowlistFromFlags()
+
+	if err != nil {
+		return err
+	}
+
+	if !allowlist.allowed(sweeper, sweepResource) {
+		return nil
+	}
+
+	if DryRun() {
+		log.Printf("[INFO] Dry run: would sweep resource (%s)", d.Id())
+		DefaultReport.Record(sweepResource.outcome(sweeper, ActionWouldDelete, nil))
+		return nil
+	}
+
+	err = deleteResource(resource, d, meta)
+
+	if err != nil {
+		DefaultReport.Record(sweepResource.outcome(sweeper, ActionFailed, err))
+	} else {
+		DefaultReport.Record(sweepResource.outcome(sweeper, ActionDeleted, nil))
+	}
+
+	return err
+}
+
+// read refreshes the resource's state, setting its ID to "" if it no longer exists.
+func (sr *SweepResource) read() error {
+	var diags diag.Diagnostics
+
+	switch {
+	case sr.resource.ReadContext != nil:
+		diags = sr.resource.ReadContext(context.Background(), sr.d, sr.meta)
+	case sr.resource.ReadWithoutTimeout != nil:
+		diags = sr.resource.ReadWithoutTimeout(context.Background(), sr.d, sr.meta)
+	case sr.resource.Read != nil:
+		return sr.resource.Read(sr.d, sr.meta)
+	}
+
+	for i := range diags {
+		if diags[i].Severity == diag.Error {
+			return fmt.Errorf("error reading resource: %s", diags[i].Summary)
+		}
+	}
+
+	return nil
+}
+
+func deleteResource(resource *schema.Resource, d *schema.ResourceData, meta interface{}) error {
+	atomic.AddInt32(&deletionsInProgress, 1)
+	defer atomic.AddInt32(&deletionsInProgress, -1)
+
 	if resource.DeleteContext != nil || resource.DeleteWithoutTimeout != nil {
 		var diags diag.Diagnostics
 
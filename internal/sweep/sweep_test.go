@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/acm"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/acmpca"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/amplify"
@@ -39,6 +40,7 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/cur"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/datasync"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/dax"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/detective"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/directconnect"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/dms"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ds"
@@ -55,6 +57,7 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/elb"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/elbv2"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/emr"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/emrcontainers"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/firehose"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/fsx"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/gamelift"
@@ -74,6 +77,8 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/lexmodelbuilding"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/licensemanager"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/location"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/memorydb"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/mq"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/mwaa"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/neptune"
@@ -110,5 +115,8 @@ import (
 
 func TestMain(m *testing.M) {
 	sweep.SweeperClients = make(map[string]interface{})
+	sweep.RegisterResourceTypes(provider.Provider().ResourcesMap)
+	// resource.TestMain exits the process when a sweeper fails, so sweep reports
+	// are streamed as outcomes are recorded rather than written once all sweepers have run.
 	resource.TestMain(m)
 }
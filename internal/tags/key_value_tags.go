@@ -22,6 +22,69 @@ const (
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
 	Tags KeyValueTags
+
+	// Rules customize the tags defaulted for particular resource types.
+	// They are applied by ForResourceType.
+	Rules []*DefaultRule
+}
+
+// DefaultRule customizes the default tags of matching resource types.
+// Excluded tags are removed before the rule's tags are added.
+type DefaultRule struct {
+	// ResourceTypes the rule applies to, e.g. aws_ebs_volume. If empty, the rule applies to all resource types.
+	ResourceTypes []string
+
+	ExcludeAllTags     bool
+	ExcludeKeys        KeyValueTags
+	ExcludeKeyPrefixes KeyValueTags
+
+	Tags KeyValueTags
+}
+
+// Matches returns whether the rule applies to the resource type.
+func (r *DefaultRule) Matches(typeName string) bool {
+	if len(r.ResourceTypes) == 0 {
+		return true
+	}
+
+	for _, v := range r.ResourceTypes {
+		if v == typeName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ForResourceType returns the DefaultConfig of a resource type, with the tags resulting from
+// applying the matching rules in order to the default tags. The returned DefaultConfig has no rules,
+// so MergeTags, TagsEqual and RemoveDefaultConfig use the resource type's tags.
+func (dc *DefaultConfig) ForResourceType(typeName string) *DefaultConfig {
+	if dc == nil || len(dc.Rules) == 0 {
+		return dc
+	}
+
+	tags := dc.Tags
+
+	for _, rule := range dc.Rules {
+		if !rule.Matches(typeName) {
+			continue
+		}
+
+		if rule.ExcludeAllTags {
+			tags = nil
+		} else {
+			tags = tags.IgnorePrefixes(rule.ExcludeKeyPrefixes).Ignore(rule.ExcludeKeys)
+		}
+
+		if len(rule.Tags) > 0 {
+			tags = tags.Merge(rule.Tags)
+		}
+	}
+
+	return &DefaultConfig{
+		Tags: tags,
+	}
 }
 
 // IgnoreConfig contains various options for removing resource tags.
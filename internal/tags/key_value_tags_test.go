@@ -183,6 +183,125 @@ func TestKeyValueTagsDefaultConfigMergeTags(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsDefaultConfigForResourceType(t *testing.T) {
+	defaultTags := New(map[string]string{
+		"CostCenter": "1234",
+		"Owner":      "platform",
+		"team:name":  "platform",
+		"team:slack": "#platform",
+	})
+
+	testCases := []struct {
+		name          string
+		defaultConfig *DefaultConfig
+		typeName      string
+		want          map[string]string
+	}{
+		{
+			name:          "no config",
+			defaultConfig: nil,
+			typeName:      "aws_ebs_volume",
+			want:          map[string]string{},
+		},
+		{
+			name:          "no rules",
+			defaultConfig: &DefaultConfig{Tags: defaultTags},
+			typeName:      "aws_ebs_volume",
+			want: map[string]string{
+				"CostCenter": "1234",
+				"Owner":      "platform",
+				"team:name":  "platform",
+				"team:slack": "#platform",
+			},
+		},
+		{
+			name: "exclude all tags",
+			defaultConfig: &DefaultConfig{
+				Tags: defaultTags,
+				Rules: []*DefaultRule{
+					{ResourceTypes: []string{"aws_autoscaling_group_tag"}, ExcludeAllTags: true},
+				},
+			},
+			typeName: "aws_autoscaling_group_tag",
+			want:     map[string]string{},
+		},
+		{
+			name: "non-matching rule",
+			defaultConfig: &DefaultConfig{
+				Tags: defaultTags,
+				Rules: []*DefaultRule{
+					{ResourceTypes: []string{"aws_autoscaling_group_tag"}, ExcludeAllTags: true},
+				},
+			},
+			typeName: "aws_ebs_volume",
+			want: map[string]string{
+				"CostCenter": "1234",
+				"Owner":      "platform",
+				"team:name":  "platform",
+				"team:slack": "#platform",
+			},
+		},
+		{
+			name: "exclude keys and key prefixes",
+			defaultConfig: &DefaultConfig{
+				Tags: defaultTags,
+				Rules: []*DefaultRule{
+					{
+						ExcludeKeys:        New([]string{"Owner"}),
+						ExcludeKeyPrefixes: New([]string{"team:"}),
+					},
+				},
+			},
+			typeName: "aws_ebs_volume",
+			want: map[string]string{
+				"CostCenter": "1234",
+			},
+		},
+		{
+			name: "rules in order",
+			defaultConfig: &DefaultConfig{
+				Tags: defaultTags,
+				Rules: []*DefaultRule{
+					{
+						ResourceTypes: []string{"aws_db_instance", "aws_ebs_volume"},
+						Tags:          New(map[string]string{"Backup": "true", "Owner": "storage"}),
+					},
+					{
+						ExcludeKeyPrefixes: New([]string{"team:", "Back"}),
+					},
+					{
+						ResourceTypes: []string{"aws_ebs_volume"},
+						Tags:          New(map[string]string{"Snapshot": "daily"}),
+					},
+				},
+			},
+			typeName: "aws_ebs_volume",
+			want: map[string]string{
+				"CostCenter": "1234",
+				"Owner":      "storage",
+				"Snapshot":   "daily",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.defaultConfig.ForResourceType(testCase.typeName)
+
+			if got != nil && len(got.Rules) > 0 {
+				t.Errorf("got %d rules, expected none", len(got.Rules))
+			}
+
+			testKeyValueTagsVerifyMap(t, got.GetTags().Map(), testCase.want)
+		})
+	}
+
+	// The default tags are not modified.
+	if got, want := len(defaultTags), 4; got != want {
+		t.Errorf("got %d default tags, expected %d", got, want)
+	}
+}
+
 func TestKeyValueTagsDefaultConfigTagsEqual(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -0,0 +1,482 @@
+package tags
+
+import (
+	"sort"
+)
+
+// TaggingStrategy describes how a resource type manages tags and whether the
+// provider's default_tags are applied to it.
+type TaggingStrategy int
+
+const (
+	// TaggingStrategyDefaultTags resource types have tags and tags_all arguments and
+	// merge the provider's default tags into tags_all using verify.SetTagsDiff.
+	TaggingStrategyDefaultTags TaggingStrategy = iota
+
+	// TaggingStrategyTagResource resource types manage individual tags of a resource
+	// which is managed elsewhere, such as aws_ec2_tag. Default tags are not applied.
+	TaggingStrategyTagResource
+
+	// TaggingStrategyUnsupported resource types have a tags argument, but do not
+	// apply the provider's default tags.
+	TaggingStrategyUnsupported
+
+	// TaggingStrategyNotTags resource types have a tags argument which does not
+	// configure the resource's tags, such as a tag filter.
+	TaggingStrategyNotTags
+)
+
+// String returns a description of the tagging strategy.
+func (s TaggingStrategy) String() string {
+	switch s {
+	case TaggingStrategyDefaultTags:
+		return "default tags"
+	case TaggingStrategyTagResource:
+		return "tag resource"
+	case TaggingStrategyUnsupported:
+		return "unsupported"
+	case TaggingStrategyNotTags:
+		return "not tags"
+	default:
+		return "unknown"
+	}
+}
+
+// IgnoresDefaultTags returns whether resources using the strategy manage tags without applying the provider's default_tags.
+func (s TaggingStrategy) IgnoresDefaultTags() bool {
+	return s == TaggingStrategyTagResource || s == TaggingStrategyUnsupported
+}
+
+// ResourceTaggingStrategy returns the tagging strategy registered for the resource type, if any.
+func ResourceTaggingStrategy(typeName string) (TaggingStrategy, bool) {
+	strategy, ok := resourceTaggingStrategies[typeName]
+
+	return strategy, ok
+}
+
+// TaggableResourceTypes returns the sorted names of all registered resource types.
+func TaggableResourceTypes() []string {
+	typeNames := make([]string, 0, len(resourceTaggingStrategies))
+
+	for typeName := range resourceTaggingStrategies {
+		typeNames = append(typeNames, typeName)
+	}
+
+	sort.Strings(typeNames)
+
+	return typeNames
+}
+
+// resourceTaggingStrategies registers the tagging strategy of every resource type which
+// has a tags argument or manages tags. New taggable resource types must be added here.
+var resourceTaggingStrategies = map[string]TaggingStrategy{
+	// Tags are configured using tag blocks with propagate_at_launch.
+	"aws_autoscaling_group": TaggingStrategyUnsupported,
+	// The tags argument is not used.
+	"aws_secretsmanager_secret_rotation": TaggingStrategyUnsupported,
+
+	"aws_autoscaling_group_tag": TaggingStrategyTagResource,
+	"aws_dynamodb_tag":          TaggingStrategyTagResource,
+	"aws_ec2_tag":               TaggingStrategyTagResource,
+	"aws_ecs_tag":               TaggingStrategyTagResource,
+
+	// The tags argument matches the tags of the EC2 instances in the resource group.
+	"aws_inspector_resource_group": TaggingStrategyNotTags,
+
+	"aws_accessanalyzer_analyzer":                              TaggingStrategyDefaultTags,
+	"aws_acm_certificate":                                      TaggingStrategyDefaultTags,
+	"aws_acmpca_certificate_authority":                         TaggingStrategyDefaultTags,
+	"aws_alb":                                                  TaggingStrategyDefaultTags,
+	"aws_alb_listener":                                         TaggingStrategyDefaultTags,
+	"aws_alb_listener_rule":                                    TaggingStrategyDefaultTags,
+	"aws_alb_target_group":                                     TaggingStrategyDefaultTags,
+	"aws_ami":                                                  TaggingStrategyDefaultTags,
+	"aws_ami_copy":                                             TaggingStrategyDefaultTags,
+	"aws_ami_from_instance":                                    TaggingStrategyDefaultTags,
+	"aws_amplify_app":                                          TaggingStrategyDefaultTags,
+	"aws_amplify_branch":                                       TaggingStrategyDefaultTags,
+	"aws_api_gateway_api_key":                                  TaggingStrategyDefaultTags,
+	"aws_api_gateway_client_certificate":                       TaggingStrategyDefaultTags,
+	"aws_api_gateway_domain_name":                              TaggingStrategyDefaultTags,
+	"aws_api_gateway_rest_api":                                 TaggingStrategyDefaultTags,
+	"aws_api_gateway_stage":                                    TaggingStrategyDefaultTags,
+	"aws_api_gateway_usage_plan":                               TaggingStrategyDefaultTags,
+	"aws_api_gateway_vpc_link":                                 TaggingStrategyDefaultTags,
+	"aws_apigatewayv2_api":                                     TaggingStrategyDefaultTags,
+	"aws_apigatewayv2_domain_name":                             TaggingStrategyDefaultTags,
+	"aws_apigatewayv2_stage":                                   TaggingStrategyDefaultTags,
+	"aws_apigatewayv2_vpc_link":                                TaggingStrategyDefaultTags,
+	"aws_appconfig_application":                                TaggingStrategyDefaultTags,
+	"aws_appconfig_configuration_profile":                      TaggingStrategyDefaultTags,
+	"aws_appconfig_deployment":                                 TaggingStrategyDefaultTags,
+	"aws_appconfig_deployment_strategy":                        TaggingStrategyDefaultTags,
+	"aws_appconfig_environment":                                TaggingStrategyDefaultTags,
+	"aws_appmesh_gateway_route":                                TaggingStrategyDefaultTags,
+	"aws_appmesh_mesh":                                         TaggingStrategyDefaultTags,
+	"aws_appmesh_route":                                        TaggingStrategyDefaultTags,
+	"aws_appmesh_virtual_gateway":                              TaggingStrategyDefaultTags,
+	"aws_appmesh_virtual_node":                                 TaggingStrategyDefaultTags,
+	"aws_appmesh_virtual_router":                               TaggingStrategyDefaultTags,
+	"aws_appmesh_virtual_service":                              TaggingStrategyDefaultTags,
+	"aws_apprunner_auto_scaling_configuration_version":         TaggingStrategyDefaultTags,
+	"aws_apprunner_connection":                                 TaggingStrategyDefaultTags,
+	"aws_apprunner_service":                                    TaggingStrategyDefaultTags,
+	"aws_appstream_fleet":                                      TaggingStrategyDefaultTags,
+	"aws_appstream_image_builder":                              TaggingStrategyDefaultTags,
+	"aws_appstream_stack":                                      TaggingStrategyDefaultTags,
+	"aws_appsync_graphql_api":                                  TaggingStrategyDefaultTags,
+	"aws_athena_workgroup":                                     TaggingStrategyDefaultTags,
+	"aws_backup_plan":                                          TaggingStrategyDefaultTags,
+	"aws_backup_vault":                                         TaggingStrategyDefaultTags,
+	"aws_batch_compute_environment":                            TaggingStrategyDefaultTags,
+	"aws_batch_job_definition":                                 TaggingStrategyDefaultTags,
+	"aws_batch_job_queue":                                      TaggingStrategyDefaultTags,
+	"aws_cloud9_environment_ec2":                               TaggingStrategyDefaultTags,
+	"aws_cloudformation_stack":                                 TaggingStrategyDefaultTags,
+	"aws_cloudformation_stack_set":                             TaggingStrategyDefaultTags,
+	"aws_cloudfront_distribution":                              TaggingStrategyDefaultTags,
+	"aws_cloudhsm_v2_cluster":                                  TaggingStrategyDefaultTags,
+	"aws_cloudtrail":                                           TaggingStrategyDefaultTags,
+	"aws_cloudwatch_composite_alarm":                           TaggingStrategyDefaultTags,
+	"aws_cloudwatch_event_bus":                                 TaggingStrategyDefaultTags,
+	"aws_cloudwatch_event_rule":                                TaggingStrategyDefaultTags,
+	"aws_cloudwatch_log_group":                                 TaggingStrategyDefaultTags,
+	"aws_cloudwatch_metric_alarm":                              TaggingStrategyDefaultTags,
+	"aws_cloudwatch_metric_stream":                             TaggingStrategyDefaultTags,
+	"aws_codeartifact_domain":                                  TaggingStrategyDefaultTags,
+	"aws_codeartifact_repository":                              TaggingStrategyDefaultTags,
+	"aws_codebuild_project":                                    TaggingStrategyDefaultTags,
+	"aws_codebuild_report_group":                               TaggingStrategyDefaultTags,
+	"aws_codecommit_repository":                                TaggingStrategyDefaultTags,
+	"aws_codedeploy_app":                                       TaggingStrategyDefaultTags,
+	"aws_codedeploy_deployment_group":                          TaggingStrategyDefaultTags,
+	"aws_codepipeline":                                         TaggingStrategyDefaultTags,
+	"aws_codepipeline_webhook":                                 TaggingStrategyDefaultTags,
+	"aws_codestarconnections_connection":                       TaggingStrategyDefaultTags,
+	"aws_codestarnotifications_notification_rule":              TaggingStrategyDefaultTags,
+	"aws_cognito_identity_pool":                                TaggingStrategyDefaultTags,
+	"aws_cognito_user_pool":                                    TaggingStrategyDefaultTags,
+	"aws_config_aggregate_authorization":                       TaggingStrategyDefaultTags,
+	"aws_config_config_rule":                                   TaggingStrategyDefaultTags,
+	"aws_config_configuration_aggregator":                      TaggingStrategyDefaultTags,
+	"aws_connect_contact_flow":                                 TaggingStrategyDefaultTags,
+	"aws_customer_gateway":                                     TaggingStrategyDefaultTags,
+	"aws_datapipeline_pipeline":                                TaggingStrategyDefaultTags,
+	"aws_datasync_agent":                                       TaggingStrategyDefaultTags,
+	"aws_datasync_location_efs":                                TaggingStrategyDefaultTags,
+	"aws_datasync_location_fsx_windows_file_system":            TaggingStrategyDefaultTags,
+	"aws_datasync_location_nfs":                                TaggingStrategyDefaultTags,
+	"aws_datasync_location_s3":                                 TaggingStrategyDefaultTags,
+	"aws_datasync_location_smb":                                TaggingStrategyDefaultTags,
+	"aws_datasync_task":                                        TaggingStrategyDefaultTags,
+	"aws_dax_cluster":                                          TaggingStrategyDefaultTags,
+	"aws_db_cluster_snapshot":                                  TaggingStrategyDefaultTags,
+	"aws_db_event_subscription":                                TaggingStrategyDefaultTags,
+	"aws_db_instance":                                          TaggingStrategyDefaultTags,
+	"aws_db_option_group":                                      TaggingStrategyDefaultTags,
+	"aws_db_parameter_group":                                   TaggingStrategyDefaultTags,
+	"aws_db_proxy":                                             TaggingStrategyDefaultTags,
+	"aws_db_proxy_endpoint":                                    TaggingStrategyDefaultTags,
+	"aws_db_security_group":                                    TaggingStrategyDefaultTags,
+	"aws_db_snapshot":                                          TaggingStrategyDefaultTags,
+	"aws_db_subnet_group":                                      TaggingStrategyDefaultTags,
+	"aws_default_network_acl":                                  TaggingStrategyDefaultTags,
+	"aws_default_route_table":                                  TaggingStrategyDefaultTags,
+	"aws_default_security_group":                               TaggingStrategyDefaultTags,
+	"aws_default_subnet":                                       TaggingStrategyDefaultTags,
+	"aws_default_vpc":                                          TaggingStrategyDefaultTags,
+	"aws_default_vpc_dhcp_options":                             TaggingStrategyDefaultTags,
+	"aws_detective_graph":                                      TaggingStrategyDefaultTags,
+	"aws_devicefarm_project":                                   TaggingStrategyDefaultTags,
+	"aws_directory_service_directory":                          TaggingStrategyDefaultTags,
+	"aws_dlm_lifecycle_policy":                                 TaggingStrategyDefaultTags,
+	"aws_dms_certificate":                                      TaggingStrategyDefaultTags,
+	"aws_dms_endpoint":                                         TaggingStrategyDefaultTags,
+	"aws_dms_event_subscription":                               TaggingStrategyDefaultTags,
+	"aws_dms_replication_instance":                             TaggingStrategyDefaultTags,
+	"aws_dms_replication_subnet_group":                         TaggingStrategyDefaultTags,
+	"aws_dms_replication_task":                                 TaggingStrategyDefaultTags,
+	"aws_docdb_cluster":                                        TaggingStrategyDefaultTags,
+	"aws_docdb_cluster_instance":                               TaggingStrategyDefaultTags,
+	"aws_docdb_cluster_parameter_group":                        TaggingStrategyDefaultTags,
+	"aws_docdb_subnet_group":                                   TaggingStrategyDefaultTags,
+	"aws_dx_connection":                                        TaggingStrategyDefaultTags,
+	"aws_dx_hosted_private_virtual_interface_accepter":         TaggingStrategyDefaultTags,
+	"aws_dx_hosted_public_virtual_interface_accepter":          TaggingStrategyDefaultTags,
+	"aws_dx_hosted_transit_virtual_interface_accepter":         TaggingStrategyDefaultTags,
+	"aws_dx_lag":                                               TaggingStrategyDefaultTags,
+	"aws_dx_private_virtual_interface":                         TaggingStrategyDefaultTags,
+	"aws_dx_public_virtual_interface":                          TaggingStrategyDefaultTags,
+	"aws_dx_transit_virtual_interface":                         TaggingStrategyDefaultTags,
+	"aws_dynamodb_table":                                       TaggingStrategyDefaultTags,
+	"aws_ebs_snapshot":                                         TaggingStrategyDefaultTags,
+	"aws_ebs_snapshot_copy":                                    TaggingStrategyDefaultTags,
+	"aws_ebs_snapshot_import":                                  TaggingStrategyDefaultTags,
+	"aws_ebs_volume":                                           TaggingStrategyDefaultTags,
+	"aws_ec2_capacity_reservation":                             TaggingStrategyDefaultTags,
+	"aws_ec2_carrier_gateway":                                  TaggingStrategyDefaultTags,
+	"aws_ec2_client_vpn_endpoint":                              TaggingStrategyDefaultTags,
+	"aws_ec2_fleet":                                            TaggingStrategyDefaultTags,
+	"aws_ec2_host":                                             TaggingStrategyDefaultTags,
+	"aws_ec2_local_gateway_route_table_vpc_association":        TaggingStrategyDefaultTags,
+	"aws_ec2_managed_prefix_list":                              TaggingStrategyDefaultTags,
+	"aws_ec2_traffic_mirror_filter":                            TaggingStrategyDefaultTags,
+	"aws_ec2_traffic_mirror_session":                           TaggingStrategyDefaultTags,
+	"aws_ec2_traffic_mirror_target":                            TaggingStrategyDefaultTags,
+	"aws_ec2_transit_gateway":                                  TaggingStrategyDefaultTags,
+	"aws_ec2_transit_gateway_peering_attachment":               TaggingStrategyDefaultTags,
+	"aws_ec2_transit_gateway_peering_attachment_accepter":      TaggingStrategyDefaultTags,
+	"aws_ec2_transit_gateway_route_table":                      TaggingStrategyDefaultTags,
+	"aws_ec2_transit_gateway_vpc_attachment":                   TaggingStrategyDefaultTags,
+	"aws_ec2_transit_gateway_vpc_attachment_accepter":          TaggingStrategyDefaultTags,
+	"aws_ecr_repository":                                       TaggingStrategyDefaultTags,
+	"aws_ecs_capacity_provider":                                TaggingStrategyDefaultTags,
+	"aws_ecs_cluster":                                          TaggingStrategyDefaultTags,
+	"aws_ecs_service":                                          TaggingStrategyDefaultTags,
+	"aws_ecs_task_definition":                                  TaggingStrategyDefaultTags,
+	"aws_efs_access_point":                                     TaggingStrategyDefaultTags,
+	"aws_efs_file_system":                                      TaggingStrategyDefaultTags,
+	"aws_egress_only_internet_gateway":                         TaggingStrategyDefaultTags,
+	"aws_eip":                                                  TaggingStrategyDefaultTags,
+	"aws_eks_addon":                                            TaggingStrategyDefaultTags,
+	"aws_eks_cluster":                                          TaggingStrategyDefaultTags,
+	"aws_eks_fargate_profile":                                  TaggingStrategyDefaultTags,
+	"aws_eks_identity_provider_config":                         TaggingStrategyDefaultTags,
+	"aws_eks_node_group":                                       TaggingStrategyDefaultTags,
+	"aws_elastic_beanstalk_application":                        TaggingStrategyDefaultTags,
+	"aws_elastic_beanstalk_application_version":                TaggingStrategyDefaultTags,
+	"aws_elastic_beanstalk_environment":                        TaggingStrategyDefaultTags,
+	"aws_elasticache_cluster":                                  TaggingStrategyDefaultTags,
+	"aws_elasticache_parameter_group":                          TaggingStrategyDefaultTags,
+	"aws_elasticache_replication_group":                        TaggingStrategyDefaultTags,
+	"aws_elasticache_subnet_group":                             TaggingStrategyDefaultTags,
+	"aws_elasticache_user":                                     TaggingStrategyDefaultTags,
+	"aws_elasticache_user_group":                               TaggingStrategyDefaultTags,
+	"aws_elasticsearch_domain":                                 TaggingStrategyDefaultTags,
+	"aws_elb":                                                  TaggingStrategyDefaultTags,
+	"aws_emr_cluster":                                          TaggingStrategyDefaultTags,
+	"aws_emrcontainers_virtual_cluster":                        TaggingStrategyDefaultTags,
+	"aws_flow_log":                                             TaggingStrategyDefaultTags,
+	"aws_fsx_backup":                                           TaggingStrategyDefaultTags,
+	"aws_fsx_lustre_file_system":                               TaggingStrategyDefaultTags,
+	"aws_fsx_ontap_file_system":                                TaggingStrategyDefaultTags,
+	"aws_fsx_windows_file_system":                              TaggingStrategyDefaultTags,
+	"aws_gamelift_alias":                                       TaggingStrategyDefaultTags,
+	"aws_gamelift_build":                                       TaggingStrategyDefaultTags,
+	"aws_gamelift_fleet":                                       TaggingStrategyDefaultTags,
+	"aws_gamelift_game_session_queue":                          TaggingStrategyDefaultTags,
+	"aws_glacier_vault":                                        TaggingStrategyDefaultTags,
+	"aws_globalaccelerator_accelerator":                        TaggingStrategyDefaultTags,
+	"aws_glue_connection":                                      TaggingStrategyDefaultTags,
+	"aws_glue_crawler":                                         TaggingStrategyDefaultTags,
+	"aws_glue_dev_endpoint":                                    TaggingStrategyDefaultTags,
+	"aws_glue_job":                                             TaggingStrategyDefaultTags,
+	"aws_glue_ml_transform":                                    TaggingStrategyDefaultTags,
+	"aws_glue_registry":                                        TaggingStrategyDefaultTags,
+	"aws_glue_schema":                                          TaggingStrategyDefaultTags,
+	"aws_glue_trigger":                                         TaggingStrategyDefaultTags,
+	"aws_glue_workflow":                                        TaggingStrategyDefaultTags,
+	"aws_guardduty_detector":                                   TaggingStrategyDefaultTags,
+	"aws_guardduty_filter":                                     TaggingStrategyDefaultTags,
+	"aws_guardduty_ipset":                                      TaggingStrategyDefaultTags,
+	"aws_guardduty_threatintelset":                             TaggingStrategyDefaultTags,
+	"aws_iam_instance_profile":                                 TaggingStrategyDefaultTags,
+	"aws_iam_openid_connect_provider":                          TaggingStrategyDefaultTags,
+	"aws_iam_policy":                                           TaggingStrategyDefaultTags,
+	"aws_iam_role":                                             TaggingStrategyDefaultTags,
+	"aws_iam_saml_provider":                                    TaggingStrategyDefaultTags,
+	"aws_iam_server_certificate":                               TaggingStrategyDefaultTags,
+	"aws_iam_user":                                             TaggingStrategyDefaultTags,
+	"aws_imagebuilder_component":                               TaggingStrategyDefaultTags,
+	"aws_imagebuilder_distribution_configuration":              TaggingStrategyDefaultTags,
+	"aws_imagebuilder_image":                                   TaggingStrategyDefaultTags,
+	"aws_imagebuilder_image_pipeline":                          TaggingStrategyDefaultTags,
+	"aws_imagebuilder_image_recipe":                            TaggingStrategyDefaultTags,
+	"aws_imagebuilder_infrastructure_configuration":            TaggingStrategyDefaultTags,
+	"aws_inspector_assessment_template":                        TaggingStrategyDefaultTags,
+	"aws_instance":                                             TaggingStrategyDefaultTags,
+	"aws_internet_gateway":                                     TaggingStrategyDefaultTags,
+	"aws_iot_topic_rule":                                       TaggingStrategyDefaultTags,
+	"aws_key_pair":                                             TaggingStrategyDefaultTags,
+	"aws_kinesis_analytics_application":                        TaggingStrategyDefaultTags,
+	"aws_kinesis_firehose_delivery_stream":                     TaggingStrategyDefaultTags,
+	"aws_kinesis_stream":                                       TaggingStrategyDefaultTags,
+	"aws_kinesis_video_stream":                                 TaggingStrategyDefaultTags,
+	"aws_kinesisanalyticsv2_application":                       TaggingStrategyDefaultTags,
+	"aws_kms_external_key":                                     TaggingStrategyDefaultTags,
+	"aws_kms_key":                                              TaggingStrategyDefaultTags,
+	"aws_lambda_function":                                      TaggingStrategyDefaultTags,
+	"aws_launch_template":                                      TaggingStrategyDefaultTags,
+	"aws_lb":                                                   TaggingStrategyDefaultTags,
+	"aws_lb_listener":                                          TaggingStrategyDefaultTags,
+	"aws_lb_listener_rule":                                     TaggingStrategyDefaultTags,
+	"aws_lb_target_group":                                      TaggingStrategyDefaultTags,
+	"aws_licensemanager_license_configuration":                 TaggingStrategyDefaultTags,
+	"aws_lightsail_instance":                                   TaggingStrategyDefaultTags,
+	"aws_location_geofence_collection":                         TaggingStrategyDefaultTags,
+	"aws_location_map":                                         TaggingStrategyDefaultTags,
+	"aws_location_place_index":                                 TaggingStrategyDefaultTags,
+	"aws_location_route_calculator":                            TaggingStrategyDefaultTags,
+	"aws_location_tracker":                                     TaggingStrategyDefaultTags,
+	"aws_macie2_classification_job":                            TaggingStrategyDefaultTags,
+	"aws_macie2_custom_data_identifier":                        TaggingStrategyDefaultTags,
+	"aws_macie2_findings_filter":                               TaggingStrategyDefaultTags,
+	"aws_macie2_member":                                        TaggingStrategyDefaultTags,
+	"aws_media_convert_queue":                                  TaggingStrategyDefaultTags,
+	"aws_media_package_channel":                                TaggingStrategyDefaultTags,
+	"aws_media_store_container":                                TaggingStrategyDefaultTags,
+	"aws_memorydb_acl":                                         TaggingStrategyDefaultTags,
+	"aws_memorydb_cluster":                                     TaggingStrategyDefaultTags,
+	"aws_memorydb_parameter_group":                             TaggingStrategyDefaultTags,
+	"aws_memorydb_snapshot":                                    TaggingStrategyDefaultTags,
+	"aws_memorydb_subnet_group":                                TaggingStrategyDefaultTags,
+	"aws_memorydb_user":                                        TaggingStrategyDefaultTags,
+	"aws_mq_broker":                                            TaggingStrategyDefaultTags,
+	"aws_mq_configuration":                                     TaggingStrategyDefaultTags,
+	"aws_msk_cluster":                                          TaggingStrategyDefaultTags,
+	"aws_mwaa_environment":                                     TaggingStrategyDefaultTags,
+	"aws_nat_gateway":                                          TaggingStrategyDefaultTags,
+	"aws_neptune_cluster":                                      TaggingStrategyDefaultTags,
+	"aws_neptune_cluster_endpoint":                             TaggingStrategyDefaultTags,
+	"aws_neptune_cluster_instance":                             TaggingStrategyDefaultTags,
+	"aws_neptune_cluster_parameter_group":                      TaggingStrategyDefaultTags,
+	"aws_neptune_event_subscription":                           TaggingStrategyDefaultTags,
+	"aws_neptune_parameter_group":                              TaggingStrategyDefaultTags,
+	"aws_neptune_subnet_group":                                 TaggingStrategyDefaultTags,
+	"aws_network_acl":                                          TaggingStrategyDefaultTags,
+	"aws_network_interface":                                    TaggingStrategyDefaultTags,
+	"aws_networkfirewall_firewall":                             TaggingStrategyDefaultTags,
+	"aws_networkfirewall_firewall_policy":                      TaggingStrategyDefaultTags,
+	"aws_networkfirewall_rule_group":                           TaggingStrategyDefaultTags,
+	"aws_opsworks_custom_layer":                                TaggingStrategyDefaultTags,
+	"aws_opsworks_ganglia_layer":                               TaggingStrategyDefaultTags,
+	"aws_opsworks_haproxy_layer":                               TaggingStrategyDefaultTags,
+	"aws_opsworks_java_app_layer":                              TaggingStrategyDefaultTags,
+	"aws_opsworks_memcached_layer":                             TaggingStrategyDefaultTags,
+	"aws_opsworks_mysql_layer":                                 TaggingStrategyDefaultTags,
+	"aws_opsworks_nodejs_app_layer":                            TaggingStrategyDefaultTags,
+	"aws_opsworks_php_app_layer":                               TaggingStrategyDefaultTags,
+	"aws_opsworks_rails_app_layer":                             TaggingStrategyDefaultTags,
+	"aws_opsworks_stack":                                       TaggingStrategyDefaultTags,
+	"aws_opsworks_static_web_layer":                            TaggingStrategyDefaultTags,
+	"aws_organizations_account":                                TaggingStrategyDefaultTags,
+	"aws_organizations_organizational_unit":                    TaggingStrategyDefaultTags,
+	"aws_organizations_policy":                                 TaggingStrategyDefaultTags,
+	"aws_pinpoint_app":                                         TaggingStrategyDefaultTags,
+	"aws_placement_group":                                      TaggingStrategyDefaultTags,
+	"aws_qldb_ledger":                                          TaggingStrategyDefaultTags,
+	"aws_quicksight_data_source":                               TaggingStrategyDefaultTags,
+	"aws_ram_resource_share":                                   TaggingStrategyDefaultTags,
+	"aws_rds_cluster":                                          TaggingStrategyDefaultTags,
+	"aws_rds_cluster_endpoint":                                 TaggingStrategyDefaultTags,
+	"aws_rds_cluster_instance":                                 TaggingStrategyDefaultTags,
+	"aws_rds_cluster_parameter_group":                          TaggingStrategyDefaultTags,
+	"aws_redshift_cluster":                                     TaggingStrategyDefaultTags,
+	"aws_redshift_event_subscription":                          TaggingStrategyDefaultTags,
+	"aws_redshift_parameter_group":                             TaggingStrategyDefaultTags,
+	"aws_redshift_snapshot_copy_grant":                         TaggingStrategyDefaultTags,
+	"aws_redshift_snapshot_schedule":                           TaggingStrategyDefaultTags,
+	"aws_redshift_subnet_group":                                TaggingStrategyDefaultTags,
+	"aws_resourcegroups_group":                                 TaggingStrategyDefaultTags,
+	"aws_route53_health_check":                                 TaggingStrategyDefaultTags,
+	"aws_route53_resolver_endpoint":                            TaggingStrategyDefaultTags,
+	"aws_route53_resolver_firewall_domain_list":                TaggingStrategyDefaultTags,
+	"aws_route53_resolver_firewall_rule_group":                 TaggingStrategyDefaultTags,
+	"aws_route53_resolver_firewall_rule_group_association":     TaggingStrategyDefaultTags,
+	"aws_route53_resolver_query_log_config":                    TaggingStrategyDefaultTags,
+	"aws_route53_resolver_rule":                                TaggingStrategyDefaultTags,
+	"aws_route53_zone":                                         TaggingStrategyDefaultTags,
+	"aws_route53domains_registered_domain":                     TaggingStrategyDefaultTags,
+	"aws_route53recoveryreadiness_cell":                        TaggingStrategyDefaultTags,
+	"aws_route53recoveryreadiness_readiness_check":             TaggingStrategyDefaultTags,
+	"aws_route53recoveryreadiness_recovery_group":              TaggingStrategyDefaultTags,
+	"aws_route53recoveryreadiness_resource_set":                TaggingStrategyDefaultTags,
+	"aws_route_table":                                          TaggingStrategyDefaultTags,
+	"aws_s3_bucket":                                            TaggingStrategyDefaultTags,
+	"aws_s3_bucket_object":                                     TaggingStrategyDefaultTags,
+	"aws_s3_object_copy":                                       TaggingStrategyDefaultTags,
+	"aws_s3control_bucket":                                     TaggingStrategyDefaultTags,
+	"aws_sagemaker_app":                                        TaggingStrategyDefaultTags,
+	"aws_sagemaker_app_image_config":                           TaggingStrategyDefaultTags,
+	"aws_sagemaker_device_fleet":                               TaggingStrategyDefaultTags,
+	"aws_sagemaker_domain":                                     TaggingStrategyDefaultTags,
+	"aws_sagemaker_endpoint":                                   TaggingStrategyDefaultTags,
+	"aws_sagemaker_endpoint_configuration":                     TaggingStrategyDefaultTags,
+	"aws_sagemaker_feature_group":                              TaggingStrategyDefaultTags,
+	"aws_sagemaker_flow_definition":                            TaggingStrategyDefaultTags,
+	"aws_sagemaker_human_task_ui":                              TaggingStrategyDefaultTags,
+	"aws_sagemaker_image":                                      TaggingStrategyDefaultTags,
+	"aws_sagemaker_model":                                      TaggingStrategyDefaultTags,
+	"aws_sagemaker_model_package_group":                        TaggingStrategyDefaultTags,
+	"aws_sagemaker_notebook_instance":                          TaggingStrategyDefaultTags,
+	"aws_sagemaker_studio_lifecycle_config":                    TaggingStrategyDefaultTags,
+	"aws_sagemaker_user_profile":                               TaggingStrategyDefaultTags,
+	"aws_sagemaker_workteam":                                   TaggingStrategyDefaultTags,
+	"aws_schemas_discoverer":                                   TaggingStrategyDefaultTags,
+	"aws_schemas_registry":                                     TaggingStrategyDefaultTags,
+	"aws_schemas_schema":                                       TaggingStrategyDefaultTags,
+	"aws_secretsmanager_secret":                                TaggingStrategyDefaultTags,
+	"aws_security_group":                                       TaggingStrategyDefaultTags,
+	"aws_serverlessapplicationrepository_cloudformation_stack": TaggingStrategyDefaultTags,
+	"aws_service_discovery_http_namespace":                     TaggingStrategyDefaultTags,
+	"aws_service_discovery_private_dns_namespace":              TaggingStrategyDefaultTags,
+	"aws_service_discovery_public_dns_namespace":               TaggingStrategyDefaultTags,
+	"aws_service_discovery_service":                            TaggingStrategyDefaultTags,
+	"aws_servicecatalog_portfolio":                             TaggingStrategyDefaultTags,
+	"aws_servicecatalog_product":                               TaggingStrategyDefaultTags,
+	"aws_servicecatalog_provisioned_product":                   TaggingStrategyDefaultTags,
+	"aws_sfn_activity":                                         TaggingStrategyDefaultTags,
+	"aws_sfn_state_machine":                                    TaggingStrategyDefaultTags,
+	"aws_shield_protection":                                    TaggingStrategyDefaultTags,
+	"aws_shield_protection_group":                              TaggingStrategyDefaultTags,
+	"aws_signer_signing_profile":                               TaggingStrategyDefaultTags,
+	"aws_sns_topic":                                            TaggingStrategyDefaultTags,
+	"aws_spot_fleet_request":                                   TaggingStrategyDefaultTags,
+	"aws_spot_instance_request":                                TaggingStrategyDefaultTags,
+	"aws_sqs_queue":                                            TaggingStrategyDefaultTags,
+	"aws_ssm_activation":                                       TaggingStrategyDefaultTags,
+	"aws_ssm_document":                                         TaggingStrategyDefaultTags,
+	"aws_ssm_maintenance_window":                               TaggingStrategyDefaultTags,
+	"aws_ssm_parameter":                                        TaggingStrategyDefaultTags,
+	"aws_ssm_patch_baseline":                                   TaggingStrategyDefaultTags,
+	"aws_ssoadmin_permission_set":                              TaggingStrategyDefaultTags,
+	"aws_storagegateway_cached_iscsi_volume":                   TaggingStrategyDefaultTags,
+	"aws_storagegateway_file_system_association":               TaggingStrategyDefaultTags,
+	"aws_storagegateway_gateway":                               TaggingStrategyDefaultTags,
+	"aws_storagegateway_nfs_file_share":                        TaggingStrategyDefaultTags,
+	"aws_storagegateway_smb_file_share":                        TaggingStrategyDefaultTags,
+	"aws_storagegateway_stored_iscsi_volume":                   TaggingStrategyDefaultTags,
+	"aws_storagegateway_tape_pool":                             TaggingStrategyDefaultTags,
+	"aws_subnet":                                               TaggingStrategyDefaultTags,
+	"aws_swf_domain":                                           TaggingStrategyDefaultTags,
+	"aws_synthetics_canary":                                    TaggingStrategyDefaultTags,
+	"aws_timestreamwrite_database":                             TaggingStrategyDefaultTags,
+	"aws_timestreamwrite_table":                                TaggingStrategyDefaultTags,
+	"aws_transfer_server":                                      TaggingStrategyDefaultTags,
+	"aws_transfer_user":                                        TaggingStrategyDefaultTags,
+	"aws_vpc":                                                  TaggingStrategyDefaultTags,
+	"aws_vpc_dhcp_options":                                     TaggingStrategyDefaultTags,
+	"aws_vpc_endpoint":                                         TaggingStrategyDefaultTags,
+	"aws_vpc_endpoint_service":                                 TaggingStrategyDefaultTags,
+	"aws_vpc_peering_connection":                               TaggingStrategyDefaultTags,
+	"aws_vpc_peering_connection_accepter":                      TaggingStrategyDefaultTags,
+	"aws_vpn_connection":                                       TaggingStrategyDefaultTags,
+	"aws_vpn_gateway":                                          TaggingStrategyDefaultTags,
+	"aws_waf_rate_based_rule":                                  TaggingStrategyDefaultTags,
+	"aws_waf_rule":                                             TaggingStrategyDefaultTags,
+	"aws_waf_rule_group":                                       TaggingStrategyDefaultTags,
+	"aws_waf_web_acl":                                          TaggingStrategyDefaultTags,
+	"aws_wafregional_rate_based_rule":                          TaggingStrategyDefaultTags,
+	"aws_wafregional_rule":                                     TaggingStrategyDefaultTags,
+	"aws_wafregional_rule_group":                               TaggingStrategyDefaultTags,
+	"aws_wafregional_web_acl":                                  TaggingStrategyDefaultTags,
+	"aws_wafv2_ip_set":                                         TaggingStrategyDefaultTags,
+	"aws_wafv2_regex_pattern_set":                              TaggingStrategyDefaultTags,
+	"aws_wafv2_rule_group":                                     TaggingStrategyDefaultTags,
+	"aws_wafv2_web_acl":                                        TaggingStrategyDefaultTags,
+	"aws_workspaces_directory":                                 TaggingStrategyDefaultTags,
+	"aws_workspaces_ip_group":                                  TaggingStrategyDefaultTags,
+	"aws_workspaces_workspace":                                 TaggingStrategyDefaultTags,
+	"aws_xray_group":                                           TaggingStrategyDefaultTags,
+	"aws_xray_sampling_rule":                                   TaggingStrategyDefaultTags,
+}
@@ -0,0 +1,111 @@
+package tfresource
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	consistencyStateNotFound = "NOTFOUND"
+	consistencyStateFound    = "FOUND"
+)
+
+// ConsistencyPolicy describes how long and how often to read a resource after it was written.
+type ConsistencyPolicy struct {
+	// Timeout is the maximum amount of time to wait for the resource to be consistently found.
+	Timeout time.Duration
+	// ConsecutiveReads is the number of consecutive successful reads required. Values below 1 are treated as 1.
+	ConsecutiveReads int
+	// MinTimeout is the smallest time to wait between reads.
+	MinTimeout time.Duration
+	// PollInterval overrides MinTimeout and the exponential backoff between reads.
+	PollInterval time.Duration
+}
+
+// RetryUntilConsistentContext calls the finder function `f` until it has succeeded `policy.ConsecutiveReads` times in a row.
+// A not found error (see NotFound) resets the count and is retried until `policy.Timeout` expires; any other error is returned immediately.
+// If the timeout expires, `f` is called one last time.
+// The output of the last successful read is returned.
+func RetryUntilConsistentContext(ctx context.Context, policy ConsistencyPolicy, f func() (interface{}, error)) (interface{}, error) {
+	// The last error is pulled out of the refresh function; need a mutex to avoid a data race.
+	var lastErr error
+	var lastErrMu sync.Mutex
+
+	consecutiveReads := policy.ConsecutiveReads
+	if consecutiveReads < 1 {
+		consecutiveReads = 1
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{consistencyStateNotFound},
+		Target:  []string{consistencyStateFound},
+		Refresh: func() (interface{}, string, error) {
+			output, err := f()
+
+			lastErrMu.Lock()
+			defer lastErrMu.Unlock()
+
+			lastErr = err
+
+			if NotFound(err) {
+				return struct{}{}, consistencyStateNotFound, nil
+			}
+
+			if err != nil {
+				return nil, "", err
+			}
+
+			return output, consistencyStateFound, nil
+		},
+		Timeout:                   policy.Timeout,
+		MinTimeout:                policy.MinTimeout,
+		PollInterval:              policy.PollInterval,
+		ContinuousTargetOccurence: consecutiveReads,
+	}
+
+	output, err := stateConf.WaitForStateContext(ctx)
+
+	if TimedOut(err) {
+		return f()
+	}
+
+	if err != nil {
+		lastErrMu.Lock()
+		defer lastErrMu.Unlock()
+
+		// The finder's error is more useful than the wait's.
+		if lastErr != nil {
+			return nil, lastErr
+		}
+
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// RetryUntilConsistent calls the finder function `f` until it has succeeded `policy.ConsecutiveReads` times in a row.
+// See RetryUntilConsistentContext.
+func RetryUntilConsistent(policy ConsistencyPolicy, f func() (interface{}, error)) (interface{}, error) {
+	return RetryUntilConsistentContext(context.Background(), policy, f)
+}
+
+// ReadAfterWriteContext calls the finder function `f` according to `policy` when `isNewResource` is true,
+// and once otherwise. It replaces RetryWhenNewResourceNotFoundContext where a resource must be
+// consistently visible after creation rather than found once.
+func ReadAfterWriteContext(ctx context.Context, policy ConsistencyPolicy, f func() (interface{}, error), isNewResource bool) (interface{}, error) {
+	if !isNewResource {
+		return f()
+	}
+
+	return RetryUntilConsistentContext(ctx, policy, f)
+}
+
+// ReadAfterWrite calls the finder function `f` according to `policy` when `isNewResource` is true,
+// and once otherwise. See ReadAfterWriteContext.
+func ReadAfterWrite(policy ConsistencyPolicy, f func() (interface{}, error), isNewResource bool) (interface{}, error) {
+	return ReadAfterWriteContext(context.Background(), policy, f, isNewResource)
+}
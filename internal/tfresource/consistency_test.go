@@ -0,0 +1,134 @@
+package tfresource_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// testConsistencyFinder returns a finder that returns each of the given results in turn, repeating the last,
+// and a pointer to the number of calls made.
+func testConsistencyFinder(results ...error) (func() (interface{}, error), *int) {
+	calls := 0
+
+	return func() (interface{}, error) {
+		err := results[len(results)-1]
+
+		if calls < len(results) {
+			err = results[calls]
+		}
+
+		calls++
+
+		if err != nil {
+			return nil, err
+		}
+
+		return "found", nil
+	}, &calls
+}
+
+func TestRetryUntilConsistent(t *testing.T) {
+	notFound := &resource.NotFoundError{}
+
+	testCases := []struct {
+		Name             string
+		Results          []error
+		ConsecutiveReads int
+		ExpectError      bool
+	}{
+		{
+			Name:    "found",
+			Results: []error{nil},
+		},
+		{
+			Name:    "found after not found",
+			Results: []error{notFound, notFound, nil},
+		},
+		{
+			Name:             "consecutive reads",
+			Results:          []error{nil, notFound, nil, nil, nil},
+			ConsecutiveReads: 3,
+		},
+		{
+			Name:        "never found",
+			Results:     []error{notFound},
+			ExpectError: true,
+		},
+		{
+			Name:        "error",
+			Results:     []error{notFound, errors.New("AccessDenied")},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			f, _ := testConsistencyFinder(testCase.Results...)
+
+			output, err := tfresource.RetryUntilConsistent(tfresource.ConsistencyPolicy{
+				Timeout:          2 * time.Second,
+				ConsecutiveReads: testCase.ConsecutiveReads,
+				PollInterval:     10 * time.Millisecond,
+			}, f)
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error")
+			} else if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !testCase.ExpectError && output != "found" {
+				t.Errorf("got output %v, expected found", output)
+			}
+		})
+	}
+}
+
+func TestRetryUntilConsistent_consecutiveReads(t *testing.T) {
+	f, calls := testConsistencyFinder(nil, tfresource.NewEmptyResultError(nil), nil, nil, nil, nil)
+
+	_, err := tfresource.RetryUntilConsistent(tfresource.ConsistencyPolicy{
+		Timeout:          2 * time.Second,
+		ConsecutiveReads: 3,
+		PollInterval:     10 * time.Millisecond,
+	}, f)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The not found result resets the count, so three more reads are needed after it.
+	if got, expected := *calls, 5; got != expected {
+		t.Errorf("got %d calls, expected %d", got, expected)
+	}
+}
+
+func TestReadAfterWrite(t *testing.T) {
+	f, calls := testConsistencyFinder(&resource.NotFoundError{}, nil)
+
+	_, err := tfresource.ReadAfterWrite(tfresource.ConsistencyPolicy{Timeout: 2 * time.Second}, f, false)
+
+	if !tfresource.NotFound(err) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+
+	if got, expected := *calls, 1; got != expected {
+		t.Errorf("got %d calls, expected %d", got, expected)
+	}
+
+	output, err := tfresource.ReadAfterWrite(tfresource.ConsistencyPolicy{Timeout: 2 * time.Second, PollInterval: 10 * time.Millisecond}, f, true)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if output != "found" {
+		t.Errorf("got output %v, expected found", output)
+	}
+}
@@ -0,0 +1,154 @@
+package waiter
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Finder returns the current API object for a resource.
+// A missing resource is signalled by an error for which tfresource.NotFound returns true,
+// e.g. *resource.NotFoundError or tfresource.EmptyResultError.
+type Finder func(ctx context.Context) (interface{}, error)
+
+// StatusExtractor returns the status of an API object returned by a Finder.
+type StatusExtractor func(output interface{}) string
+
+// Status returns a resource.StateRefreshFunc that calls find and extracts the status of the result.
+// A not found result is reported as (nil, "", nil) so that waiting for a target state
+// fails with *resource.NotFoundError and waiting for deletion succeeds.
+func Status(ctx context.Context, find Finder, status StatusExtractor) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := find(ctx)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil {
+			return nil, "", nil
+		}
+
+		return output, status(output), nil
+	}
+}
+
+// Config configures a wait.
+type Config struct {
+	Pending []string
+	Target  []string
+	Refresh resource.StateRefreshFunc
+
+	// Timeout is capped at the deadline of the context, if any.
+	Timeout                   time.Duration
+	Delay                     time.Duration
+	MinTimeout                time.Duration
+	PollInterval              time.Duration
+	ContinuousTargetOccurence int
+	NotFoundChecks            int
+
+	// Description identifies the resource in progress log messages, e.g. "EC2 VPC (vpc-12345678)".
+	Description string
+
+	// Error, if set, returns a descriptive error from the last API object seen when a wait fails,
+	// e.g. a status reason. It is attached to the wait error with tfresource.SetLastError.
+	Error func(output interface{}) error
+}
+
+// UntilState waits for the resource to reach one of the target states.
+// The last API object seen is returned along with any error.
+func UntilState(ctx context.Context, conf *Config) (interface{}, error) {
+	stateConf := conf.stateChangeConf(ctx, conf.Target)
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if err != nil && outputRaw != nil && conf.Error != nil {
+		if lastErr := conf.Error(outputRaw); lastErr != nil {
+			tfresource.SetLastError(err, lastErr)
+		}
+	}
+
+	return outputRaw, err
+}
+
+// UntilDeleted waits for the resource to no longer be found.
+// Any Target in conf is ignored.
+func UntilDeleted(ctx context.Context, conf *Config) (interface{}, error) {
+	stateConf := conf.stateChangeConf(ctx, []string{})
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if err != nil && outputRaw != nil && conf.Error != nil {
+		if lastErr := conf.Error(outputRaw); lastErr != nil {
+			tfresource.SetLastError(err, lastErr)
+		}
+	}
+
+	return outputRaw, err
+}
+
+func (conf *Config) stateChangeConf(ctx context.Context, target []string) *resource.StateChangeConf {
+	timeout := conf.Timeout
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if remaining := time.Until(deadline); timeout == 0 || remaining < timeout {
+			timeout = remaining
+		}
+	}
+
+	return &resource.StateChangeConf{
+		Pending:                   conf.Pending,
+		Target:                    target,
+		Refresh:                   logProgress(conf.Description, conf.Refresh),
+		Timeout:                   timeout,
+		Delay:                     conf.Delay,
+		MinTimeout:                conf.MinTimeout,
+		PollInterval:              conf.PollInterval,
+		ContinuousTargetOccurence: conf.ContinuousTargetOccurence,
+		NotFoundChecks:            conf.NotFoundChecks,
+	}
+}
+
+// logProgress wraps refresh to log each change of state.
+func logProgress(description string, refresh resource.StateRefreshFunc) resource.StateRefreshFunc {
+	if description == "" {
+		return refresh
+	}
+
+	var mutex sync.Mutex
+	var lastState string
+	first := true
+
+	return func() (interface{}, string, error) {
+		output, state, err := refresh()
+
+		if err != nil {
+			return output, state, err
+		}
+
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		if first || state != lastState {
+			switch {
+			case output == nil:
+				log.Printf("[DEBUG] Waiting for %s: not found", description)
+			default:
+				log.Printf("[DEBUG] Waiting for %s: %s", description, state)
+			}
+
+			first = false
+			lastState = state
+		}
+
+		return output, state, err
+	}
+}
@@ -0,0 +1,201 @@
+package waiter_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/waiter"
+)
+
+type testThing struct {
+	Status string
+}
+
+func testThingStatus(output interface{}) string {
+	return output.(*testThing).Status
+}
+
+// testFinder returns a Finder that returns each of the given results in turn, repeating the last.
+func testFinder(results ...interface{}) waiter.Finder {
+	i := 0
+
+	return func(ctx context.Context) (interface{}, error) {
+		result := results[i]
+
+		if i < len(results)-1 {
+			i++
+		}
+
+		switch v := result.(type) {
+		case error:
+			return nil, v
+		case string:
+			return &testThing{Status: v}, nil
+		}
+
+		return nil, nil
+	}
+}
+
+func TestStatus(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Find          waiter.Finder
+		ExpectOutput  bool
+		ExpectedState string
+		ExpectError   bool
+	}{
+		{
+			Name:          "found",
+			Find:          testFinder("ACTIVE"),
+			ExpectOutput:  true,
+			ExpectedState: "ACTIVE",
+		},
+		{
+			Name: "not found",
+			Find: testFinder(&resource.NotFoundError{}),
+		},
+		{
+			Name: "empty result",
+			Find: testFinder(tfresource.NewEmptyResultError(nil)),
+		},
+		{
+			Name: "nil output",
+			Find: testFinder(nil),
+		},
+		{
+			Name:        "error",
+			Find:        testFinder(errors.New("boom")),
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			output, state, err := waiter.Status(context.Background(), testCase.Find, testThingStatus)()
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error")
+			} else if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := output != nil; got != testCase.ExpectOutput {
+				t.Errorf("got output %v, expected output: %t", output, testCase.ExpectOutput)
+			}
+
+			if state != testCase.ExpectedState {
+				t.Errorf("got state %q, expected %q", state, testCase.ExpectedState)
+			}
+		})
+	}
+}
+
+func TestUntilState(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		Name          string
+		Find          waiter.Finder
+		ExpectError   bool
+		ExpectedError string
+	}{
+		{
+			Name: "reaches target",
+			Find: testFinder("CREATING", "CREATING", "ACTIVE"),
+		},
+		{
+			Name:        "not found",
+			Find:        testFinder(&resource.NotFoundError{}),
+			ExpectError: true,
+		},
+		{
+			Name:          "unexpected state",
+			Find:          testFinder("CREATING", "FAILED"),
+			ExpectError:   true,
+			ExpectedError: "FAILED: quota exceeded",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			output, err := waiter.UntilState(ctx, &waiter.Config{
+				Pending:        []string{"CREATING"},
+				Target:         []string{"ACTIVE"},
+				Refresh:        waiter.Status(ctx, testCase.Find, testThingStatus),
+				Timeout:        5 * time.Second,
+				MinTimeout:     10 * time.Millisecond,
+				NotFoundChecks: 1,
+				Description:    "test thing",
+				Error: func(output interface{}) error {
+					return errors.New(output.(*testThing).Status + ": quota exceeded")
+				},
+			})
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error")
+			} else if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.ExpectedError != "" && !strings.Contains(err.Error(), testCase.ExpectedError) {
+				t.Errorf("got error %q, expected it to contain %q", err, testCase.ExpectedError)
+			}
+
+			if !testCase.ExpectError && testThingStatus(output) != "ACTIVE" {
+				t.Errorf("got output %v, expected ACTIVE", output)
+			}
+		})
+	}
+}
+
+func TestUntilDeleted(t *testing.T) {
+	ctx := context.Background()
+
+	output, err := waiter.UntilDeleted(ctx, &waiter.Config{
+		Pending:    []string{"DELETING"},
+		Refresh:    waiter.Status(ctx, testFinder("DELETING", tfresource.NewEmptyResultError(nil)), testThingStatus),
+		Timeout:    5 * time.Second,
+		MinTimeout: 10 * time.Millisecond,
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if output != nil {
+		t.Errorf("got output %v, expected none", output)
+	}
+}
+
+func TestUntilState_contextDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+
+	_, err := waiter.UntilState(ctx, &waiter.Config{
+		Pending:      []string{"CREATING"},
+		Target:       []string{"ACTIVE"},
+		Refresh:      waiter.Status(ctx, testFinder("CREATING"), testThingStatus),
+		Timeout:      time.Hour,
+		PollInterval: 10 * time.Millisecond,
+	})
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("wait took %s, expected it to be bounded by the context deadline", elapsed)
+	}
+}
@@ -0,0 +1,124 @@
+package AWSR003
+
+import (
+	"go/ast"
+	"strings"
+
+	"github.com/bflad/tfproviderlint/helper/astutils"
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/schema"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/bflad/tfproviderlint/passes/helper/schema/crudfuncinfo"
+	"github.com/bflad/tfproviderlint/passes/helper/schema/resourcedatasetidcallexpr"
+	"github.com/hashicorp/terraform-provider-aws/providerlint/helper/awsprovidertype/tfresource"
+	"golang.org/x/tools/go/analysis"
+)
+
+const Doc = `check for d.SetId("") in Read functions without tfresource.NotFound()
+
+The AWSR003 analyzer reports when a (schema.ResourceData).SetId("") call in a
+resource Read function is not inside an if statement whose condition calls
+tfresource.NotFound(). Removing a resource from state should only happen when
+the finder reports that the resource no longer exists, and not for any other
+error or when the resource was just created.
+`
+
+const analyzerName = "AWSR003"
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		crudfuncinfo.Analyzer,
+		resourcedatasetidcallexpr.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	crudFuncs := pass.ResultOf[crudfuncinfo.Analyzer].([]*schema.CRUDFuncInfo)
+	setIdCallExprs := pass.ResultOf[resourcedatasetidcallexpr.Analyzer].([]*ast.CallExpr)
+
+	emptySetIdCallExprs := make(map[*ast.CallExpr]bool)
+
+	for _, callExpr := range setIdCallExprs {
+		if len(callExpr.Args) != 1 {
+			continue
+		}
+
+		if id := astutils.ExprStringValue(callExpr.Args[0]); id != nil && *id == "" {
+			emptySetIdCallExprs[callExpr] = true
+		}
+	}
+
+	if len(emptySetIdCallExprs) == 0 {
+		return nil, nil
+	}
+
+	for _, crudFunc := range crudFuncs {
+		// Create, Read, Update and Delete functions share a signature, so use the naming convention.
+		if crudFunc.AstFuncDecl == nil || !strings.HasSuffix(crudFunc.AstFuncDecl.Name.Name, "Read") {
+			continue
+		}
+
+		var walk func(node ast.Node, guarded bool)
+
+		walk = func(node ast.Node, guarded bool) {
+			ast.Inspect(node, func(n ast.Node) bool {
+				switch n := n.(type) {
+				case *ast.IfStmt:
+					if n.Init != nil {
+						walk(n.Init, guarded)
+					}
+
+					walk(n.Cond, guarded)
+					walk(n.Body, guarded || hasNotFoundCallExpr(pass, n.Cond))
+
+					if n.Else != nil {
+						walk(n.Else, guarded)
+					}
+
+					return false
+				case *ast.CallExpr:
+					if guarded || !emptySetIdCallExprs[n] {
+						return true
+					}
+
+					if commentIgnorer.ShouldIgnore(analyzerName, n) {
+						return true
+					}
+
+					pass.Reportf(n.Pos(), "%s: d.SetId(\"\") in Read function should be guarded by tfresource.NotFound()", analyzerName)
+				}
+
+				return true
+			})
+		}
+
+		walk(crudFunc.Body, false)
+	}
+
+	return nil, nil
+}
+
+func hasNotFoundCallExpr(pass *analysis.Pass, node ast.Node) bool {
+	var found bool
+
+	ast.Inspect(node, func(n ast.Node) bool {
+		callExpr, ok := n.(*ast.CallExpr)
+
+		if !ok {
+			return true
+		}
+
+		if tfresource.IsFunc(callExpr.Fun, pass.TypesInfo, tfresource.FuncNameNotFound) {
+			found = true
+			return false
+		}
+
+		return true
+	})
+
+	return found
+}
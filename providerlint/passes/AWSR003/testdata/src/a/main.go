@@ -0,0 +1,92 @@
+package a
+
+import (
+	"errors"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func findThing(id string) (interface{}, error) {
+	return nil, errors.New("not found")
+}
+
+/* Passing cases */
+
+func resourceThingPassingRead(d *schema.ResourceData, meta interface{}) error {
+	_, err := findThing(d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Thing (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceThingPassingNestedRead(d *schema.ResourceData, meta interface{}) error {
+	_, err := findThing(d.Id())
+
+	if tfresource.NotFound(err) {
+		if !d.IsNewResource() {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	return err
+}
+
+func resourceThingPassingDelete(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("")
+
+	return nil
+}
+
+/* Comment ignored cases */
+
+func resourceThingIgnoredRead(d *schema.ResourceData, meta interface{}) error {
+	output, err := findThing(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	if output == nil {
+		//lintignore:AWSR003
+		d.SetId("")
+		return nil
+	}
+
+	return nil
+}
+
+/* Failing cases */
+
+func resourceThingFailingRead(d *schema.ResourceData, meta interface{}) error {
+	output, err := findThing(d.Id())
+
+	if err != nil {
+		d.SetId("") // want "d.SetId\\(\"\"\\) in Read function should be guarded by tfresource.NotFound\\(\\)"
+		return nil
+	}
+
+	if output == nil {
+		d.SetId("") // want "d.SetId\\(\"\"\\) in Read function should be guarded by tfresource.NotFound\\(\\)"
+		return nil
+	}
+
+	if tfresource.NotFound(err) {
+		return nil
+	} else {
+		d.SetId("") // want "d.SetId\\(\"\"\\) in Read function should be guarded by tfresource.NotFound\\(\\)"
+	}
+
+	return nil
+}
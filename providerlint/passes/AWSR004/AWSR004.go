@@ -0,0 +1,92 @@
+package AWSR004
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/bflad/tfproviderlint/passes/helper/schema/resourcedatasetcallexpr"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const Doc = `check for d.Set() of complex values with the error ignored
+
+The AWSR004 analyzer reports when the error returned by a (schema.ResourceData).Set()
+call is ignored and the value is a list, map, set or other non-primitive type.
+Setting such values can fail, e.g. when the value does not match the schema,
+and the error should be returned.
+`
+
+const analyzerName = "AWSR004"
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		inspect.Analyzer,
+		resourcedatasetcallexpr.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	callExprs := pass.ResultOf[resourcedatasetcallexpr.Analyzer].([]*ast.CallExpr)
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	setCallExprs := make(map[*ast.CallExpr]bool, len(callExprs))
+
+	for _, callExpr := range callExprs {
+		setCallExprs[callExpr] = true
+	}
+
+	nodeFilter := []ast.Node{
+		(*ast.ExprStmt)(nil),
+	}
+
+	inspect.Preorder(nodeFilter, func(n ast.Node) {
+		exprStmt := n.(*ast.ExprStmt)
+		callExpr, ok := exprStmt.X.(*ast.CallExpr)
+
+		if !ok || !setCallExprs[callExpr] {
+			return
+		}
+
+		if len(callExpr.Args) < 2 {
+			return
+		}
+
+		if !isComplexType(pass.TypesInfo.TypeOf(callExpr.Args[1])) {
+			return
+		}
+
+		if commentIgnorer.ShouldIgnore(analyzerName, callExpr) {
+			return
+		}
+
+		pass.Reportf(callExpr.Pos(), "%s: d.Set() error should be checked for non-primitive values", analyzerName)
+	})
+
+	return nil, nil
+}
+
+// isComplexType returns true for types that d.Set() stores as lists, maps or sets.
+func isComplexType(t types.Type) bool {
+	if t == nil {
+		return false
+	}
+
+	if pointer, ok := t.Underlying().(*types.Pointer); ok {
+		t = pointer.Elem()
+	}
+
+	switch t.Underlying().(type) {
+	case *types.Array, *types.Map, *types.Slice, *types.Struct:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,49 @@
+package a
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func f() error {
+	var d schema.ResourceData
+
+	/* Passing cases */
+
+	d.Set("name", "test")
+
+	d.Set("enabled", true)
+
+	d.Set("count", 1)
+
+	var name *string
+	d.Set("name", name)
+
+	if err := d.Set("list", []interface{}{"test"}); err != nil {
+		return fmt.Errorf("error setting list: %w", err)
+	}
+
+	err := d.Set("map", map[string]interface{}{"key": "value"})
+
+	if err != nil {
+		return err
+	}
+
+	/* Comment ignored cases */
+
+	//lintignore:AWSR004
+	d.Set("list", []interface{}{"test"})
+
+	d.Set("list", []interface{}{"test"}) //lintignore:AWSR004
+
+	/* Failing cases */
+
+	d.Set("list", []interface{}{"test"}) // want "d.Set\\(\\) error should be checked for non-primitive values"
+
+	d.Set("map", map[string]interface{}{"key": "value"}) // want "d.Set\\(\\) error should be checked for non-primitive values"
+
+	d.Set("set", schema.NewSet(schema.HashString, nil)) // want "d.Set\\(\\) error should be checked for non-primitive values"
+
+	return nil
+}
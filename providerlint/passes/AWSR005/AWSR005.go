@@ -0,0 +1,83 @@
+package AWSR005
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"github.com/bflad/tfproviderlint/helper/astutils"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const Doc = `check for fmt.Errorf() calls formatting an error without %w
+
+The AWSR005 analyzer reports when a fmt.Errorf() call has an error argument,
+typically returned by the AWS Go SDK, and its format string does not contain
+the %w verb. Wrapping the error preserves it for errors.As(), tfawserr and
+tfresource.NotFound() checks by callers.
+`
+
+const analyzerName = "AWSR005"
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		inspect.Analyzer,
+	},
+	Run: run,
+}
+
+var errorType = types.Universe.Lookup("error").Type().Underlying().(*types.Interface)
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	nodeFilter := []ast.Node{
+		(*ast.CallExpr)(nil),
+	}
+
+	inspect.Preorder(nodeFilter, func(n ast.Node) {
+		callExpr := n.(*ast.CallExpr)
+
+		if !astutils.IsStdlibPackageFunc(callExpr.Fun, pass.TypesInfo, "fmt", "Errorf") {
+			return
+		}
+
+		if len(callExpr.Args) < 2 {
+			return
+		}
+
+		formatString := astutils.ExprStringValue(callExpr.Args[0])
+
+		if formatString == nil || strings.Contains(*formatString, "%w") {
+			return
+		}
+
+		var errorArgFound bool
+
+		for _, arg := range callExpr.Args[1:] {
+			if t := pass.TypesInfo.TypeOf(arg); t != nil && types.Implements(t, errorType) {
+				errorArgFound = true
+				break
+			}
+		}
+
+		if !errorArgFound {
+			return
+		}
+
+		if commentIgnorer.ShouldIgnore(analyzerName, callExpr) {
+			return
+		}
+
+		pass.Reportf(callExpr.Pos(), "%s: prefer %%w verb for error arguments in fmt.Errorf()", analyzerName)
+	})
+
+	return nil, nil
+}
@@ -0,0 +1,36 @@
+package a
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+func f() {
+	err := errors.New("test")
+	awsErr := awserr.New("ResourceNotFoundException", "test", nil)
+
+	/* Passing cases */
+
+	_ = fmt.Errorf("error reading Thing (%s): %w", "test", err)
+
+	_ = fmt.Errorf("error reading Thing (%s): %w", "test", awsErr)
+
+	_ = fmt.Errorf("error reading Thing (%s): not found", "test")
+
+	_ = fmt.Errorf("error reading Thing: %s", err.Error())
+
+	/* Comment ignored cases */
+
+	//lintignore:AWSR005
+	_ = fmt.Errorf("error reading Thing: %s", err)
+
+	_ = fmt.Errorf("error reading Thing: %s", err) //lintignore:AWSR005
+
+	/* Failing cases */
+
+	_ = fmt.Errorf("error reading Thing: %s", err) // want "prefer %w verb for error arguments in fmt.Errorf\\(\\)"
+
+	_ = fmt.Errorf("error reading Thing (%s): %v", "test", awsErr) // want "prefer %w verb for error arguments in fmt.Errorf\\(\\)"
+}
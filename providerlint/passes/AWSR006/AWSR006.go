@@ -0,0 +1,73 @@
+package AWSR006
+
+import (
+	"go/ast"
+
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/schema"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/bflad/tfproviderlint/passes/helper/schema/crudfuncinfo"
+	"github.com/bflad/tfproviderlint/passes/stdlib/timesleepcallexpr"
+	"golang.org/x/tools/go/analysis"
+)
+
+const Doc = `check for time.Sleep() calls in resource CRUD functions
+
+The AWSR006 analyzer reports time.Sleep() calls in resource Create, Read,
+Update and Delete functions. Fixed sleeps slow down every operation and do
+not guarantee eventual consistency; wait for the expected state with a
+resource.StateChangeConf waiter or retry with tfresource.RetryWhen() instead.
+`
+
+const analyzerName = "AWSR006"
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		crudfuncinfo.Analyzer,
+		timesleepcallexpr.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	crudFuncs := pass.ResultOf[crudfuncinfo.Analyzer].([]*schema.CRUDFuncInfo)
+	sleepCallExprs := pass.ResultOf[timesleepcallexpr.Analyzer].([]*ast.CallExpr)
+
+	if len(sleepCallExprs) == 0 {
+		return nil, nil
+	}
+
+	sleeps := make(map[*ast.CallExpr]bool, len(sleepCallExprs))
+
+	for _, callExpr := range sleepCallExprs {
+		sleeps[callExpr] = true
+	}
+
+	// CRUD function literals may be nested within other CRUD functions.
+	reported := make(map[*ast.CallExpr]bool)
+
+	for _, crudFunc := range crudFuncs {
+		ast.Inspect(crudFunc.Body, func(n ast.Node) bool {
+			callExpr, ok := n.(*ast.CallExpr)
+
+			if !ok || !sleeps[callExpr] || reported[callExpr] {
+				return true
+			}
+
+			reported[callExpr] = true
+
+			if commentIgnorer.ShouldIgnore(analyzerName, callExpr) {
+				return true
+			}
+
+			pass.Reportf(callExpr.Pos(), "%s: prefer resource.StateChangeConf or tfresource.RetryWhen() over time.Sleep() in CRUD functions", analyzerName)
+
+			return true
+		})
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,48 @@
+package a
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/* Passing cases */
+
+func waitThingCreated() {
+	time.Sleep(1 * time.Second)
+}
+
+func resourceThingPassingCreate(d *schema.ResourceData, meta interface{}) error {
+	waitThingCreated()
+
+	return nil
+}
+
+/* Comment ignored cases */
+
+func resourceThingIgnoredCreate(d *schema.ResourceData, meta interface{}) error {
+	//lintignore:AWSR006
+	time.Sleep(1 * time.Second)
+
+	time.Sleep(1 * time.Second) //lintignore:AWSR006
+
+	return nil
+}
+
+/* Failing cases */
+
+func resourceThingFailingCreate(d *schema.ResourceData, meta interface{}) error {
+	time.Sleep(1 * time.Second) // want "prefer resource.StateChangeConf or tfresource.RetryWhen\\(\\) over time.Sleep\\(\\) in CRUD functions"
+
+	return nil
+}
+
+func resourceThingFailingDelete(d *schema.ResourceData, meta interface{}) error {
+	f := func() {
+		time.Sleep(1 * time.Second) // want "prefer resource.StateChangeConf or tfresource.RetryWhen\\(\\) over time.Sleep\\(\\) in CRUD functions"
+	}
+
+	f()
+
+	return nil
+}